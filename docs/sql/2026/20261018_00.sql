@@ -0,0 +1,30 @@
+DROP TABLE IF EXISTS "pay_components";
+CREATE TABLE IF NOT EXISTS "pay_components"
+(
+    "id"               SERIAL PRIMARY KEY,
+    "code"             VARCHAR(50)       NOT NULL,
+    "name"             VARCHAR(255)      NOT NULL,
+    "item_type"        payslip_item_type NOT NULL,
+    "evaluation_order" INT               NOT NULL,
+    "config"           TEXT,
+
+    -- Utility columns
+    "status"           SMALLINT          NOT NULL DEFAULT 1,
+    "flag"             INT               NOT NULL DEFAULT 0,
+    "meta"             VARCHAR(255),
+    "created_at"       TIMESTAMPTZ       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"       INT,
+    "updated_at"       TIMESTAMPTZ       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"       INT,
+    "deleted_at"       TIMESTAMPTZ,
+    "deleted_by"       INT,
+
+    CONSTRAINT unique_pay_component_code UNIQUE ("code")
+);
+
+-- Seeder
+
+INSERT INTO pay_components (code, name, item_type, evaluation_order, config)
+VALUES ('BASE_PAY', 'Base Pay', 'EARNING_BASE_PAY', 1, NULL),
+       ('OVERTIME', 'Overtime Pay', 'EARNING_OVERTIME', 2, '{"hoursPerDay": 8}'),
+       ('REIMBURSEMENT', 'Reimbursement', 'REIMBURSEMENT', 3, NULL);
@@ -0,0 +1,24 @@
+ALTER TABLE payslip_details
+    ADD COLUMN IF NOT EXISTS "rate" DECIMAL(5, 2);
+
+UPDATE pay_components
+SET config = '{
+  "hoursPerDay": 8,
+  "rates": {
+    "WORKDAY": [
+      {"fromHour": 0, "toHour": 1, "multiplier": 1.5},
+      {"fromHour": 1, "multiplier": 2}
+    ],
+    "REST_DAY": [
+      {"fromHour": 0, "toHour": 8, "multiplier": 2},
+      {"fromHour": 8, "toHour": 9, "multiplier": 3},
+      {"fromHour": 9, "multiplier": 4}
+    ],
+    "PUBLIC_HOLIDAY": [
+      {"fromHour": 0, "toHour": 8, "multiplier": 2},
+      {"fromHour": 8, "toHour": 9, "multiplier": 3},
+      {"fromHour": 9, "multiplier": 4}
+    ]
+  }
+}'
+WHERE code = 'OVERTIME';
@@ -0,0 +1,44 @@
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'DEDUCTION_TAX';
+
+ALTER TABLE payslips
+    ADD COLUMN IF NOT EXISTS "deduction_component" DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS "tax_marital_status" VARCHAR(20) NOT NULL DEFAULT 'SINGLE',
+    ADD COLUMN IF NOT EXISTS "tax_dependants"     INT         NOT NULL DEFAULT 0;
+
+DROP TABLE IF EXISTS "tax_brackets";
+CREATE TABLE IF NOT EXISTS "tax_brackets"
+(
+    "id"             SERIAL PRIMARY KEY,
+    "effective_date" DATE           NOT NULL,
+    "lower_bound"    DECIMAL(15, 2) NOT NULL,
+    "upper_bound"    DECIMAL(15, 2),
+    "rate"           DECIMAL(5, 4)  NOT NULL,
+
+    -- Utility columns
+    "status"         SMALLINT       NOT NULL DEFAULT 1,
+    "flag"           INT            NOT NULL DEFAULT 0,
+    "meta"           VARCHAR(255),
+    "created_at"     TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"     INT,
+    "updated_at"     TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"     INT,
+    "deleted_at"     TIMESTAMPTZ,
+    "deleted_by"     INT,
+
+    CONSTRAINT unique_tax_bracket_effective_date_lower_bound UNIQUE ("effective_date", "lower_bound")
+);
+
+-- Seeder
+
+INSERT INTO tax_brackets (effective_date, lower_bound, upper_bound, rate)
+VALUES ('2022-01-01', 0, 60000000, 0.05),
+       ('2022-01-01', 60000000, 250000000, 0.15),
+       ('2022-01-01', 250000000, 500000000, 0.25),
+       ('2022-01-01', 500000000, 5000000000, 0.30),
+       ('2022-01-01', 5000000000, NULL, 0.35);
+
+INSERT INTO pay_components (code, name, item_type, evaluation_order, config)
+VALUES ('TAX', 'Income Tax', 'DEDUCTION_TAX', 4,
+        '{"periodsPerYear": 12, "personalRelief": 54000000, "marriedRelief": 4500000, "dependantRelief": 4500000, "maxDependants": 3}');
@@ -0,0 +1,17 @@
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'DEDUCTION_CONTRIBUTION';
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'EMPLOYER_CONTRIBUTION';
+
+ALTER TABLE payslips
+    ADD COLUMN IF NOT EXISTS "employer_contribution" DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+-- contribution rates need more precision than overtime multipliers
+ALTER TABLE payslip_details
+    ALTER COLUMN "rate" TYPE DECIMAL(7, 4);
+
+-- Seeder
+
+INSERT INTO pay_components (code, name, item_type, evaluation_order, config)
+VALUES ('PENSION', 'Pension', 'DEDUCTION_CONTRIBUTION', 5,
+        '{"employeeRate": 0.02, "employerRate": 0.037, "salaryFloor": 0, "salaryCap": 0}'),
+       ('HEALTH_INSURANCE', 'Health Insurance', 'DEDUCTION_CONTRIBUTION', 6,
+        '{"employeeRate": 0.01, "employerRate": 0.04, "salaryFloor": 0, "salaryCap": 12000000}');
@@ -0,0 +1,24 @@
+DROP TYPE IF EXISTS payroll_preview_status_enum;
+CREATE TYPE payroll_preview_status_enum AS ENUM ('PENDING', 'COMPLETED', 'FAILED');
+
+DROP TABLE IF EXISTS "payroll_previews";
+CREATE TABLE IF NOT EXISTS "payroll_previews"
+(
+    "id"                      SERIAL PRIMARY KEY,
+    "fk_attendance_period_id" INT                         NOT NULL,
+    "preview_status"          payroll_preview_status_enum NOT NULL DEFAULT 'PENDING',
+    "total_employee"          INT                         NOT NULL DEFAULT 0,
+    "result"                  TEXT,
+    "preview_error"           VARCHAR(255),
+
+    -- Utility columns
+    "status"                  SMALLINT                    NOT NULL DEFAULT 1,
+    "flag"                    INT                         NOT NULL DEFAULT 0,
+    "meta"                    VARCHAR(255),
+    "created_at"              TIMESTAMPTZ                 NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"              INT,
+    "updated_at"              TIMESTAMPTZ                 NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"              INT,
+    "deleted_at"              TIMESTAMPTZ,
+    "deleted_by"              INT
+);
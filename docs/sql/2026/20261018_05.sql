@@ -0,0 +1,51 @@
+DROP TYPE IF EXISTS payroll_run_status_enum;
+CREATE TYPE payroll_run_status_enum AS ENUM ('PROCESSING', 'PROCESSED', 'PROCESS_ERROR', 'VOIDED');
+
+DROP TABLE IF EXISTS "payroll_runs";
+CREATE TABLE IF NOT EXISTS "payroll_runs"
+(
+    "id"                      SERIAL PRIMARY KEY,
+    "fk_attendance_period_id" INT                     NOT NULL,
+    "version"                 INT                     NOT NULL,
+    "run_status"              payroll_run_status_enum NOT NULL DEFAULT 'PROCESSING',
+    "process_error"           VARCHAR(255),
+    "void_reason"             VARCHAR(255),
+    "voided_at"               TIMESTAMPTZ,
+    "voided_by"               INT,
+
+    -- Utility columns
+    "status"                  SMALLINT                NOT NULL DEFAULT 1,
+    "flag"                    INT                     NOT NULL DEFAULT 0,
+    "meta"                    VARCHAR(255),
+    "created_at"              TIMESTAMPTZ             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"              INT,
+    "updated_at"              TIMESTAMPTZ             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"              INT,
+    "deleted_at"              TIMESTAMPTZ,
+    "deleted_by"              INT,
+
+    CONSTRAINT unique_payroll_run_version UNIQUE ("fk_attendance_period_id", "version")
+);
+
+ALTER TABLE payslips
+    ADD COLUMN IF NOT EXISTS "fk_payroll_run_id" INT;
+
+-- every payroll processed before payroll runs existed becomes the first version of its period
+INSERT INTO payroll_runs (fk_attendance_period_id, version, run_status)
+SELECT DISTINCT fk_attendance_period_id, 1, 'PROCESSED'
+FROM payslips;
+
+UPDATE payslips
+SET fk_payroll_run_id = payroll_runs.id
+FROM payroll_runs
+WHERE payroll_runs.fk_attendance_period_id = payslips.fk_attendance_period_id;
+
+ALTER TABLE payslips
+    ALTER COLUMN "fk_payroll_run_id" SET NOT NULL;
+
+-- a user now has one payslip per payroll run instead of one per attendance period
+ALTER TABLE payslips
+    DROP CONSTRAINT IF EXISTS "unique_user_payslip";
+
+ALTER TABLE payslips
+    ADD CONSTRAINT "unique_user_payroll_run_payslip" UNIQUE ("fk_user_id", "fk_payroll_run_id");
@@ -0,0 +1,31 @@
+DROP TYPE IF EXISTS payroll_run_employee_status_enum;
+CREATE TYPE payroll_run_employee_status_enum AS ENUM ('SUCCESS', 'FAILED');
+
+DROP TABLE IF EXISTS "payroll_run_employees";
+CREATE TABLE IF NOT EXISTS "payroll_run_employees"
+(
+    "id"                SERIAL PRIMARY KEY,
+    "fk_payroll_run_id" INT                              NOT NULL,
+    "fk_user_id"        INT                              NOT NULL,
+    "fk_payslip_id"     INT,
+    "employee_status"   payroll_run_employee_status_enum NOT NULL,
+    "process_error"     VARCHAR(255),
+
+    -- Utility columns
+    "status"            SMALLINT                         NOT NULL DEFAULT 1,
+    "flag"              INT                              NOT NULL DEFAULT 0,
+    "meta"              VARCHAR(255),
+    "created_at"        TIMESTAMPTZ                      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"        INT,
+    "updated_at"        TIMESTAMPTZ                      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"        INT,
+    "deleted_at"        TIMESTAMPTZ,
+    "deleted_by"        INT,
+
+    CONSTRAINT unique_payroll_run_employee UNIQUE ("fk_payroll_run_id", "fk_user_id")
+);
+
+-- payslips created before per employee results were recorded all succeeded
+INSERT INTO payroll_run_employees (fk_payroll_run_id, fk_user_id, fk_payslip_id, employee_status)
+SELECT fk_payroll_run_id, fk_user_id, id, 'SUCCESS'
+FROM payslips;
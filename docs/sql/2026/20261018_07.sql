@@ -0,0 +1,21 @@
+ALTER TABLE payroll_runs
+    ADD COLUMN IF NOT EXISTS "total_employee"     INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "processed_employee" INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "failed_employee"    INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "started_at"         TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS "finished_at"        TIMESTAMPTZ;
+
+-- runs finished before progress was reported count the employees recorded for them
+UPDATE payroll_runs
+SET total_employee     = counts.total,
+    processed_employee = counts.total,
+    failed_employee    = counts.failed,
+    started_at         = payroll_runs.created_at,
+    finished_at        = payroll_runs.updated_at
+FROM (SELECT fk_payroll_run_id,
+             COUNT(*)                                         AS total,
+             COUNT(*) FILTER (WHERE employee_status = 'FAILED') AS failed
+      FROM payroll_run_employees
+      GROUP BY fk_payroll_run_id) AS counts
+WHERE counts.fk_payroll_run_id = payroll_runs.id
+  AND payroll_runs.run_status <> 'PROCESSING';
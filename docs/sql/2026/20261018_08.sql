@@ -0,0 +1,78 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS "currency" VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE reimbursements
+    ADD COLUMN IF NOT EXISTS "currency" VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE payslip_details
+    ADD COLUMN IF NOT EXISTS "currency" VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE attendance_periods
+    ADD COLUMN IF NOT EXISTS "exchange_rate_fixing_date" DATE;
+
+DROP TABLE IF EXISTS "exchange_rates";
+CREATE TABLE IF NOT EXISTS "exchange_rates"
+(
+    "id"          SERIAL PRIMARY KEY,
+    "currency"    VARCHAR(3)     NOT NULL,
+    "rate"        DECIMAL(18, 6) NOT NULL,
+    "fixing_date" DATE           NOT NULL,
+
+    -- Utility columns
+    "status"      SMALLINT       NOT NULL DEFAULT 1,
+    "flag"        INT            NOT NULL DEFAULT 0,
+    "meta"        VARCHAR(255),
+    "created_at"  TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"  INT,
+    "updated_at"  TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"  INT,
+    "deleted_at"  TIMESTAMPTZ,
+    "deleted_by"  INT,
+
+    CONSTRAINT unique_exchange_rate UNIQUE ("currency", "fixing_date")
+);
+
+DROP TABLE IF EXISTS "payslip_currency_totals";
+CREATE TABLE IF NOT EXISTS "payslip_currency_totals"
+(
+    "id"                      SERIAL PRIMARY KEY,
+    "fk_payslip_id"           INT            NOT NULL,
+    "fk_payroll_run_id"       INT            NOT NULL,
+    "currency"                VARCHAR(3)     NOT NULL,
+    "exchange_rate"           DECIMAL(18, 6) NOT NULL,
+    "base_pay_component"      DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "overtime_component"      DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "reimbursement_component" DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "deduction_component"     DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "employer_contribution"   DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "total_take_home_pay"     DECIMAL(15, 2) NOT NULL DEFAULT 0,
+
+    -- Utility columns
+    "status"                  SMALLINT       NOT NULL DEFAULT 1,
+    "flag"                    INT            NOT NULL DEFAULT 0,
+    "meta"                    VARCHAR(255),
+    "created_at"              TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"              INT,
+    "updated_at"              TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"              INT,
+    "deleted_at"              TIMESTAMPTZ,
+    "deleted_by"              INT,
+
+    CONSTRAINT unique_payslip_currency_total UNIQUE ("fk_payslip_id", "currency")
+);
+
+-- payslips generated before currencies were introduced were paid in the reporting currency
+INSERT INTO payslip_currency_totals (fk_payslip_id, fk_payroll_run_id, currency, exchange_rate, base_pay_component,
+                                     overtime_component, reimbursement_component, deduction_component,
+                                     employer_contribution, total_take_home_pay)
+SELECT id,
+       fk_payroll_run_id,
+       'IDR',
+       1,
+       base_pay_component,
+       overtime_component,
+       reimbursement_component,
+       deduction_component,
+       employer_contribution,
+       total_take_home_pay
+FROM payslips;
@@ -0,0 +1,65 @@
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'EARNING_ALLOWANCE';
+
+ALTER TABLE payslips
+    ADD COLUMN IF NOT EXISTS "allowance_component" DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE payslip_currency_totals
+    ADD COLUMN IF NOT EXISTS "allowance_component" DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+DROP TABLE IF EXISTS "allowance_types";
+CREATE TABLE IF NOT EXISTS "allowance_types"
+(
+    "id"          SERIAL PRIMARY KEY,
+    "code"        VARCHAR(50)  NOT NULL,
+    "name"        VARCHAR(255) NOT NULL,
+    "is_prorated" BOOLEAN      NOT NULL DEFAULT FALSE,
+
+    -- Utility columns
+    "status"      SMALLINT     NOT NULL DEFAULT 1,
+    "flag"        INT          NOT NULL DEFAULT 0,
+    "meta"        VARCHAR(255),
+    "created_at"  TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"  INT,
+    "updated_at"  TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"  INT,
+    "deleted_at"  TIMESTAMPTZ,
+    "deleted_by"  INT,
+
+    CONSTRAINT unique_allowance_type UNIQUE ("code")
+);
+
+DROP TABLE IF EXISTS "user_allowances";
+CREATE TABLE IF NOT EXISTS "user_allowances"
+(
+    "id"                   SERIAL PRIMARY KEY,
+    "fk_user_id"           INT            NOT NULL,
+    "fk_allowance_type_id" INT            NOT NULL,
+    "amount"               DECIMAL(15, 2) NOT NULL,
+    "is_prorated"          BOOLEAN        NOT NULL DEFAULT FALSE,
+    "effective_date"       DATE           NOT NULL,
+    "end_date"             DATE,
+
+    -- Utility columns
+    "status"               SMALLINT       NOT NULL DEFAULT 1,
+    "flag"                 INT            NOT NULL DEFAULT 0,
+    "meta"                 VARCHAR(255),
+    "created_at"           TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"           INT,
+    "updated_at"           TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"           INT,
+    "deleted_at"           TIMESTAMPTZ,
+    "deleted_by"           INT
+);
+
+INSERT INTO allowance_types (code, name, is_prorated)
+VALUES ('TRANSPORT', 'Transport Allowance', TRUE),
+       ('MEAL', 'Meal Allowance', TRUE),
+       ('POSITION', 'Position Allowance', FALSE);
+
+-- allowances are earnings, they are evaluated before the tax and contributions that depend on them
+UPDATE pay_components
+SET evaluation_order = evaluation_order + 1
+WHERE evaluation_order >= 3;
+
+INSERT INTO pay_components (code, name, item_type, evaluation_order, config)
+VALUES ('ALLOWANCE', 'Allowance', 'EARNING_ALLOWANCE', 3, NULL);
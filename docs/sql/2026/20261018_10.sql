@@ -0,0 +1,40 @@
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'EARNING_ADJUSTMENT';
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'DEDUCTION_ADJUSTMENT';
+
+ALTER TABLE payslips
+    ADD COLUMN IF NOT EXISTS "adjustment_component" DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE payslip_currency_totals
+    ADD COLUMN IF NOT EXISTS "adjustment_component" DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+DROP TABLE IF EXISTS "payroll_adjustments";
+CREATE TABLE IF NOT EXISTS "payroll_adjustments"
+(
+    "id"                      SERIAL PRIMARY KEY,
+    "fk_attendance_period_id" INT            NOT NULL,
+    "fk_user_id"              INT            NOT NULL,
+    "adjustment_type"         VARCHAR(20)    NOT NULL,
+    "amount"                  DECIMAL(15, 2) NOT NULL,
+    "currency"                VARCHAR(3)     NOT NULL DEFAULT 'IDR',
+    "reason"                  VARCHAR(255)   NOT NULL,
+
+    -- Utility columns
+    "status"                  SMALLINT       NOT NULL DEFAULT 1,
+    "flag"                    INT            NOT NULL DEFAULT 0,
+    "meta"                    VARCHAR(255),
+    "created_at"              TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"              INT,
+    "updated_at"              TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"              INT,
+    "deleted_at"              TIMESTAMPTZ,
+    "deleted_by"              INT
+);
+
+-- earning adjustments are taxed with the other earnings, deduction adjustments are taken from what is left
+UPDATE pay_components
+SET evaluation_order = evaluation_order + 1
+WHERE evaluation_order >= 4;
+
+INSERT INTO pay_components (code, name, item_type, evaluation_order, config)
+VALUES ('EARNING_ADJUSTMENT', 'Earning Adjustment', 'EARNING_ADJUSTMENT', 4, NULL),
+       ('DEDUCTION_ADJUSTMENT', 'Deduction Adjustment', 'DEDUCTION_ADJUSTMENT', 9, NULL);
@@ -0,0 +1,63 @@
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'DEDUCTION_LOAN';
+
+DROP TYPE IF EXISTS loan_status_enum;
+CREATE TYPE loan_status_enum AS ENUM ('ACTIVE', 'PAID_OFF');
+
+DROP TYPE IF EXISTS loan_repayment_status_enum;
+CREATE TYPE loan_repayment_status_enum AS ENUM ('PAID', 'REVERSED');
+
+DROP TABLE IF EXISTS "loans";
+CREATE TABLE IF NOT EXISTS "loans"
+(
+    "id"                SERIAL PRIMARY KEY,
+    "fk_user_id"        INT              NOT NULL,
+    "description"       VARCHAR(255)     NOT NULL,
+    "principal"         DECIMAL(15, 2)   NOT NULL,
+    "instalment_count"  INT              NOT NULL,
+    "instalment_amount" DECIMAL(15, 2)   NOT NULL,
+    "remaining_balance" DECIMAL(15, 2)   NOT NULL,
+    "currency"          VARCHAR(3)       NOT NULL DEFAULT 'IDR',
+    "start_date"        DATE             NOT NULL,
+    "loan_status"       loan_status_enum NOT NULL DEFAULT 'ACTIVE',
+
+    -- Utility columns
+    "status"            SMALLINT         NOT NULL DEFAULT 1,
+    "flag"              INT              NOT NULL DEFAULT 0,
+    "meta"              VARCHAR(255),
+    "created_at"        TIMESTAMPTZ      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"        INT,
+    "updated_at"        TIMESTAMPTZ      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"        INT,
+    "deleted_at"        TIMESTAMPTZ,
+    "deleted_by"        INT
+);
+
+DROP TABLE IF EXISTS "loan_repayments";
+CREATE TABLE IF NOT EXISTS "loan_repayments"
+(
+    "id"                SERIAL PRIMARY KEY,
+    "fk_loan_id"        INT                        NOT NULL,
+    "fk_user_id"        INT                        NOT NULL,
+    "fk_payroll_run_id" INT                        NOT NULL,
+    "fk_payslip_id"     INT                        NOT NULL,
+    "amount"            DECIMAL(15, 2)             NOT NULL,
+    "currency"          VARCHAR(3)                 NOT NULL DEFAULT 'IDR',
+    "repayment_status"  loan_repayment_status_enum NOT NULL DEFAULT 'PAID',
+
+    -- Utility columns
+    "status"            SMALLINT                   NOT NULL DEFAULT 1,
+    "flag"              INT                        NOT NULL DEFAULT 0,
+    "meta"              VARCHAR(255),
+    "created_at"        TIMESTAMPTZ                NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"        INT,
+    "updated_at"        TIMESTAMPTZ                NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"        INT,
+    "deleted_at"        TIMESTAMPTZ,
+    "deleted_by"        INT,
+
+    CONSTRAINT unique_loan_repayment UNIQUE ("fk_loan_id", "fk_payroll_run_id")
+);
+
+-- instalments are withheld last, from what is left after tax, contributions and deduction adjustments
+INSERT INTO pay_components (code, name, item_type, evaluation_order, config)
+VALUES ('LOAN_REPAYMENT', 'Loan Repayment', 'DEDUCTION_LOAN', 10, NULL);
@@ -0,0 +1,25 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS "region" VARCHAR(50) NOT NULL DEFAULT '';
+
+DROP TABLE IF EXISTS "holidays";
+CREATE TABLE IF NOT EXISTS "holidays"
+(
+    "id"           SERIAL PRIMARY KEY,
+    "holiday_date" DATE         NOT NULL,
+    "name"         VARCHAR(255) NOT NULL,
+    "region"       VARCHAR(50)  NOT NULL DEFAULT '',
+
+    -- Utility columns
+    "status"       SMALLINT     NOT NULL DEFAULT 1,
+    "flag"         INT          NOT NULL DEFAULT 0,
+    "meta"         VARCHAR(255),
+    "created_at"   TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"   INT,
+    "updated_at"   TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"   INT,
+    "deleted_at"   TIMESTAMPTZ,
+    "deleted_by"   INT
+);
+
+-- a deleted holiday can be imported again, so only the active holidays are unique per date and region
+CREATE UNIQUE INDEX IF NOT EXISTS unique_holiday ON holidays ("holiday_date", "region") WHERE status = 1;
@@ -0,0 +1,41 @@
+DROP TABLE IF EXISTS "work_schedules";
+CREATE TABLE IF NOT EXISTS "work_schedules"
+(
+    "id"         SERIAL PRIMARY KEY,
+    "name"       VARCHAR(255) NOT NULL,
+    "work_days"  VARCHAR(50)  NOT NULL,
+
+    -- Utility columns
+    "status"     SMALLINT     NOT NULL DEFAULT 1,
+    "flag"       INT          NOT NULL DEFAULT 0,
+    "meta"       VARCHAR(255),
+    "created_at" TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" INT,
+    "updated_at" TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by" INT,
+    "deleted_at" TIMESTAMPTZ,
+    "deleted_by" INT
+);
+
+DROP TABLE IF EXISTS "user_work_schedules";
+CREATE TABLE IF NOT EXISTS "user_work_schedules"
+(
+    "id"                  SERIAL PRIMARY KEY,
+    "fk_user_id"          INT         NOT NULL,
+    "fk_work_schedule_id" INT         NOT NULL,
+    "effective_date"      DATE        NOT NULL,
+
+    -- Utility columns
+    "status"              SMALLINT    NOT NULL DEFAULT 1,
+    "flag"                INT         NOT NULL DEFAULT 0,
+    "meta"                VARCHAR(255),
+    "created_at"          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"          INT,
+    "updated_at"          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"          INT,
+    "deleted_at"          TIMESTAMPTZ,
+    "deleted_by"          INT
+);
+
+-- an employee follows a single schedule on any day, so only one active assignment may start on a date
+CREATE UNIQUE INDEX IF NOT EXISTS unique_user_work_schedule ON user_work_schedules ("fk_user_id", "effective_date") WHERE status = 1;
@@ -0,0 +1,6 @@
+-- employees without a hire date are paid from the start of every period, as they were before
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS "hire_date" DATE;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS "termination_date" DATE;
@@ -0,0 +1,31 @@
+DROP TABLE IF EXISTS "user_salaries";
+CREATE TABLE IF NOT EXISTS "user_salaries"
+(
+    "id"             SERIAL PRIMARY KEY,
+    "fk_user_id"     INT            NOT NULL,
+    "base_salary"    DECIMAL(15, 2) NOT NULL,
+    "effective_date" DATE           NOT NULL,
+
+    -- Utility columns
+    "status"         SMALLINT       NOT NULL DEFAULT 1,
+    "flag"           INT            NOT NULL DEFAULT 0,
+    "meta"           VARCHAR(255),
+    "created_at"     TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"     INT,
+    "updated_at"     TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"     INT,
+    "deleted_at"     TIMESTAMPTZ,
+    "deleted_by"     INT
+);
+
+-- an employee is paid a single salary on any day, so only one active salary may start on a date
+CREATE UNIQUE INDEX IF NOT EXISTS unique_user_salary ON user_salaries ("fk_user_id", "effective_date") WHERE status = 1;
+
+-- the current salaries start the history, effective since the hire date or for every period before
+INSERT INTO user_salaries (fk_user_id, base_salary, effective_date)
+SELECT id,
+       base_salary,
+       COALESCE(hire_date, DATE '1970-01-01')
+FROM users
+WHERE fk_role_id = 2
+  AND base_salary > 0;
@@ -0,0 +1,67 @@
+ALTER TABLE payslips
+    ADD COLUMN IF NOT EXISTS "tax_deduction" DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "employee_contribution" DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+-- the tax and employee contributions of existing payslips are rebuilt from their details, in the reporting currency
+UPDATE payslips
+SET tax_deduction         = deductions.tax_deduction,
+    employee_contribution = deductions.employee_contribution
+FROM (SELECT payslip_details.fk_payslip_id,
+             ROUND(SUM(CASE WHEN payslip_details.item_type = 'DEDUCTION_TAX'
+                                THEN payslip_details.amount * COALESCE(payslip_currency_totals.exchange_rate, 1)
+                            ELSE 0 END), 2) AS tax_deduction,
+             ROUND(SUM(CASE WHEN payslip_details.item_type = 'DEDUCTION_CONTRIBUTION'
+                                THEN payslip_details.amount * COALESCE(payslip_currency_totals.exchange_rate, 1)
+                            ELSE 0 END), 2) AS employee_contribution
+      FROM payslip_details
+               LEFT JOIN payslip_currency_totals
+                         ON payslip_currency_totals.fk_payslip_id = payslip_details.fk_payslip_id
+                             AND payslip_currency_totals.currency = payslip_details.currency
+      WHERE payslip_details.status = 1
+      GROUP BY payslip_details.fk_payslip_id) AS deductions
+WHERE payslips.id = deductions.fk_payslip_id;
+
+DROP TABLE IF EXISTS "year_to_date_totals";
+CREATE TABLE IF NOT EXISTS "year_to_date_totals"
+(
+    "id"                    SERIAL PRIMARY KEY,
+    "fk_user_id"            INT            NOT NULL,
+    "year"                  INT            NOT NULL,
+    "gross_earnings"        DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "tax_deduction"         DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "employee_contribution" DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "employer_contribution" DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "total_take_home_pay"   DECIMAL(15, 2) NOT NULL DEFAULT 0,
+
+    -- Utility columns
+    "status"                SMALLINT       NOT NULL DEFAULT 1,
+    "flag"                  INT            NOT NULL DEFAULT 0,
+    "meta"                  VARCHAR(255),
+    "created_at"            TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"            INT,
+    "updated_at"            TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"            INT,
+    "deleted_at"            TIMESTAMPTZ,
+    "deleted_by"            INT
+);
+
+-- an employee has a single running total per year
+CREATE UNIQUE INDEX IF NOT EXISTS unique_year_to_date_total ON year_to_date_totals ("fk_user_id", "year") WHERE status = 1;
+
+-- the totals start from the payslips of the payroll runs that were not voided, by the year their period ends
+INSERT INTO year_to_date_totals (fk_user_id, year, gross_earnings, tax_deduction, employee_contribution,
+                                 employer_contribution, total_take_home_pay)
+SELECT payslips.fk_user_id,
+       EXTRACT(YEAR FROM attendance_periods.end_date),
+       SUM(payslips.base_pay_component + payslips.overtime_component + payslips.allowance_component +
+           payslips.adjustment_component),
+       SUM(payslips.tax_deduction),
+       SUM(payslips.employee_contribution),
+       SUM(payslips.employer_contribution),
+       SUM(payslips.total_take_home_pay)
+FROM payslips
+         JOIN payroll_runs ON payroll_runs.id = payslips.fk_payroll_run_id
+         JOIN attendance_periods ON attendance_periods.id = payslips.fk_attendance_period_id
+WHERE payslips.status = 1
+  AND payroll_runs.run_status != 'VOIDED'
+GROUP BY payslips.fk_user_id, EXTRACT(YEAR FROM attendance_periods.end_date);
@@ -0,0 +1,13 @@
+ALTER TABLE work_schedules
+    ADD COLUMN IF NOT EXISTS "shift_start" VARCHAR(5) NOT NULL DEFAULT '09:00',
+    ADD COLUMN IF NOT EXISTS "shift_end" VARCHAR(5) NOT NULL DEFAULT '17:00';
+
+ALTER TABLE attendances
+    ADD COLUMN IF NOT EXISTS "check_in_at" TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS "check_out_at" TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS "worked_minutes" INT NOT NULL DEFAULT 0;
+
+-- attendances submitted before check-outs existed were checked in when they were submitted
+UPDATE attendances
+SET check_in_at = created_at
+WHERE check_in_at IS NULL;
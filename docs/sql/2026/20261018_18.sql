@@ -0,0 +1,48 @@
+DROP TABLE IF EXISTS "office_locations";
+CREATE TABLE IF NOT EXISTS "office_locations"
+(
+    "id"            SERIAL PRIMARY KEY,
+    "name"          VARCHAR(255)  NOT NULL,
+    "latitude"      DECIMAL(9, 6) NOT NULL,
+    "longitude"     DECIMAL(9, 6) NOT NULL,
+    "radius_meters" INT           NOT NULL,
+
+    -- Utility columns
+    "status"        SMALLINT      NOT NULL DEFAULT 1,
+    "flag"          INT           NOT NULL DEFAULT 0,
+    "meta"          VARCHAR(255),
+    "created_at"    TIMESTAMPTZ   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"    INT,
+    "updated_at"    TIMESTAMPTZ   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"    INT,
+    "deleted_at"    TIMESTAMPTZ,
+    "deleted_by"    INT
+);
+
+DROP TABLE IF EXISTS "user_office_locations";
+CREATE TABLE IF NOT EXISTS "user_office_locations"
+(
+    "id"                    SERIAL PRIMARY KEY,
+    "fk_user_id"            INT         NOT NULL,
+    "fk_office_location_id" INT         NOT NULL,
+
+    -- Utility columns
+    "status"                SMALLINT    NOT NULL DEFAULT 1,
+    "flag"                  INT         NOT NULL DEFAULT 0,
+    "meta"                  VARCHAR(255),
+    "created_at"            TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"            INT,
+    "updated_at"            TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"            INT,
+    "deleted_at"            TIMESTAMPTZ,
+    "deleted_by"            INT
+);
+
+-- an employee is allowed at an office location once
+CREATE UNIQUE INDEX IF NOT EXISTS unique_user_office_location ON user_office_locations ("fk_user_id", "fk_office_location_id") WHERE status = 1;
+
+-- the office location matched at check-in and the coordinates the employee checked in from, kept for audit
+ALTER TABLE attendances
+    ADD COLUMN IF NOT EXISTS "fk_office_location_id" INT,
+    ADD COLUMN IF NOT EXISTS "check_in_latitude" DECIMAL(9, 6),
+    ADD COLUMN IF NOT EXISTS "check_in_longitude" DECIMAL(9, 6);
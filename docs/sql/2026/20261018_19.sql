@@ -0,0 +1,15 @@
+ALTER TYPE payslip_item_type ADD VALUE IF NOT EXISTS 'DEDUCTION_ATTENDANCE_PENALTY';
+
+ALTER TABLE work_schedules
+    ADD COLUMN IF NOT EXISTS "grace_minutes" INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "penalty_type" VARCHAR(20) NOT NULL DEFAULT 'NONE',
+    ADD COLUMN IF NOT EXISTS "penalty_per_minute" DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "half_day_after_lates" INT NOT NULL DEFAULT 0;
+
+-- attendance penalties are withheld after the tax and contributions, before the deduction adjustments and loan instalments
+UPDATE pay_components
+SET evaluation_order = evaluation_order + 1
+WHERE evaluation_order >= 9;
+
+INSERT INTO pay_components (code, name, item_type, evaluation_order, config)
+VALUES ('ATTENDANCE_PENALTY', 'Attendance Penalty', 'DEDUCTION_ATTENDANCE_PENALTY', 9, NULL);
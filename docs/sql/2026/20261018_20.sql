@@ -0,0 +1,30 @@
+DROP TABLE IF EXISTS "attendance_corrections";
+CREATE TABLE IF NOT EXISTS "attendance_corrections"
+(
+    "id"                      SERIAL PRIMARY KEY,
+    "fk_attendance_period_id" INT          NOT NULL,
+    "fk_user_id"              INT          NOT NULL,
+    "attendance_date"         DATE         NOT NULL,
+    "check_in_at"             TIMESTAMPTZ  NOT NULL,
+    "check_out_at"            TIMESTAMPTZ,
+    "reason"                  VARCHAR(255) NOT NULL,
+    "correction_status"       VARCHAR(20)  NOT NULL DEFAULT 'PENDING',
+    "review_note"             VARCHAR(255),
+    "reviewed_at"             TIMESTAMPTZ,
+    "reviewed_by"             INT,
+    "fk_attendance_id"        INT,
+
+    -- Utility columns
+    "status"                  SMALLINT     NOT NULL DEFAULT 1,
+    "flag"                    INT          NOT NULL DEFAULT 0,
+    "meta"                    VARCHAR(255),
+    "created_at"              TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"              INT,
+    "updated_at"              TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"              INT,
+    "deleted_at"              TIMESTAMPTZ,
+    "deleted_by"              INT
+);
+
+-- an employee can only have one pending correction per workday
+CREATE UNIQUE INDEX IF NOT EXISTS unique_pending_attendance_correction ON attendance_corrections ("fk_user_id", "attendance_date") WHERE status = 1 AND correction_status = 'PENDING';
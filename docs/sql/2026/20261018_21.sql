@@ -0,0 +1,6 @@
+-- the user ID an employee is known by on the biometric devices, their device logs are imported against it
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS "device_user_id" VARCHAR(10);
+
+-- a device user ID identifies a single employee
+CREATE UNIQUE INDEX IF NOT EXISTS unique_user_device_user_id ON users ("device_user_id") WHERE status = 1 AND device_user_id IS NOT NULL;
@@ -0,0 +1,37 @@
+DROP TABLE IF EXISTS "attendance_types";
+CREATE TABLE IF NOT EXISTS "attendance_types"
+(
+    "id"                  SERIAL PRIMARY KEY,
+    "code"                VARCHAR(20)    NOT NULL,
+    "name"                VARCHAR(255)   NOT NULL,
+    "daily_allowance"     DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    "max_days_per_period" INT            NOT NULL DEFAULT 0,
+
+    -- Utility columns
+    "status"              SMALLINT       NOT NULL DEFAULT 1,
+    "flag"                INT            NOT NULL DEFAULT 0,
+    "meta"                VARCHAR(255),
+    "created_at"          TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by"          INT,
+    "updated_at"          TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_by"          INT,
+    "deleted_at"          TIMESTAMPTZ,
+    "deleted_by"          INT,
+
+    CONSTRAINT unique_attendance_type UNIQUE ("code")
+);
+
+-- every attendance recorded so far was at the office
+ALTER TABLE attendances
+    ADD COLUMN IF NOT EXISTS "attendance_type" VARCHAR(20) NOT NULL DEFAULT 'OFFICE';
+
+ALTER TABLE attendance_corrections
+    ADD COLUMN IF NOT EXISTS "attendance_type" VARCHAR(20) NOT NULL DEFAULT 'OFFICE';
+
+-- Seeder
+
+INSERT INTO attendance_types (code, name, daily_allowance, max_days_per_period)
+VALUES ('OFFICE', 'Office', 0, 0),
+       ('WFH', 'Work From Home', 0, 8),
+       ('FIELD_VISIT', 'Field Visit', 150000, 0),
+       ('BUSINESS_TRAVEL', 'Business Travel', 300000, 0);
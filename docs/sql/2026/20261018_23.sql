@@ -0,0 +1,3 @@
+-- the shifts of the work schedules are at the offices, the timestamps of the check-ins are compared to them there
+ALTER TABLE work_schedules
+    ADD COLUMN IF NOT EXISTS "time_zone" VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
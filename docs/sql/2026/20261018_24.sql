@@ -0,0 +1,35 @@
+-- a failed payroll run is resumed rather than replaced, but a failed run that a later version already replaced paid
+-- its employees again. The year to date totals counted the payslips of both, so they are rebuilt without the failed
+-- runs replaced by a later version
+UPDATE year_to_date_totals
+SET gross_earnings        = COALESCE(totals.gross_earnings, 0),
+    tax_deduction         = COALESCE(totals.tax_deduction, 0),
+    employee_contribution = COALESCE(totals.employee_contribution, 0),
+    employer_contribution = COALESCE(totals.employer_contribution, 0),
+    total_take_home_pay   = COALESCE(totals.total_take_home_pay, 0),
+    updated_at            = CURRENT_TIMESTAMP
+FROM year_to_date_totals AS current_totals
+         LEFT JOIN (SELECT payslips.fk_user_id,
+                           EXTRACT(YEAR FROM attendance_periods.end_date) AS year,
+                           SUM(payslips.base_pay_component + payslips.overtime_component +
+                               payslips.allowance_component + payslips.adjustment_component) AS gross_earnings,
+                           SUM(payslips.tax_deduction)                                       AS tax_deduction,
+                           SUM(payslips.employee_contribution)                               AS employee_contribution,
+                           SUM(payslips.employer_contribution)                               AS employer_contribution,
+                           SUM(payslips.total_take_home_pay)                                 AS total_take_home_pay
+                    FROM payslips
+                             JOIN payroll_runs ON payroll_runs.id = payslips.fk_payroll_run_id
+                             JOIN attendance_periods ON attendance_periods.id = payslips.fk_attendance_period_id
+                    WHERE payslips.status = 1
+                      AND payroll_runs.run_status != 'VOIDED'
+                      AND NOT (payroll_runs.run_status = 'PROCESS_ERROR' AND
+                               EXISTS (SELECT 1
+                                       FROM payroll_runs AS later_runs
+                                       WHERE later_runs.fk_attendance_period_id = payroll_runs.fk_attendance_period_id
+                                         AND later_runs.version > payroll_runs.version
+                                         AND later_runs.status = 1))
+                    GROUP BY payslips.fk_user_id, EXTRACT(YEAR FROM attendance_periods.end_date)) AS totals
+                   ON totals.fk_user_id = current_totals.fk_user_id
+                       AND totals.year = current_totals.year
+WHERE year_to_date_totals.id = current_totals.id
+  AND year_to_date_totals.status = 1;
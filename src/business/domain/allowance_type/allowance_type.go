@@ -0,0 +1,145 @@
+package allowance_type
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.AllowanceTypeParam) (entity.AllowanceType, error)
+	GetList(ctx context.Context, param entity.AllowanceTypeParam) ([]entity.AllowanceType, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.AllowanceTypeInputParam) (entity.AllowanceType, error)
+	CreateMany(ctx context.Context, inputParams []entity.AllowanceTypeInputParam) error
+	Update(ctx context.Context, updateParam entity.AllowanceTypeUpdateParam, selectParam entity.AllowanceTypeParam) error
+}
+
+type allowanceType struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &allowanceType{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (a *allowanceType) Get(ctx context.Context, param entity.AllowanceTypeParam) (entity.AllowanceType, error) {
+	allowanceType := entity.AllowanceType{}
+
+	marshalledParam, err := a.json.Marshal(param)
+	if err != nil {
+		return allowanceType, err
+	}
+
+	if !param.BypassCache {
+		allowanceType, err = a.getCache(ctx, fmt.Sprintf(getAllowanceTypeByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return allowanceType, nil
+		}
+	}
+
+	allowanceType, err = a.getSQL(ctx, param)
+	if err != nil {
+		return allowanceType, err
+	}
+
+	err = a.upsertCache(ctx, fmt.Sprintf(getAllowanceTypeByKey, string(marshalledParam)), allowanceType, a.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return allowanceType, nil
+}
+
+func (a *allowanceType) GetList(ctx context.Context, param entity.AllowanceTypeParam) ([]entity.AllowanceType, *entity.Pagination, error) {
+	if !param.BypassCache {
+		allowanceTypeList, pg, err := a.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return allowanceTypeList, &pg, nil
+		}
+	}
+
+	allowanceTypeList, pg, err := a.getListSQL(ctx, param)
+	if err != nil {
+		return allowanceTypeList, pg, err
+	}
+
+	err = a.upsertCacheList(ctx, param, allowanceTypeList, *pg, a.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return allowanceTypeList, pg, nil
+}
+
+func (a *allowanceType) Create(ctx context.Context, param entity.AllowanceTypeInputParam) (entity.AllowanceType, error) {
+	allowanceType, err := a.createSQL(ctx, param)
+	if err != nil {
+		return allowanceType, err
+	}
+
+	err = a.deleteCache(ctx, deleteAllowanceTypeKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return allowanceType, nil
+}
+
+func (a *allowanceType) CreateMany(ctx context.Context, inputParams []entity.AllowanceTypeInputParam) error {
+	err := a.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteCache(ctx, deleteAllowanceTypeKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (a *allowanceType) Update(ctx context.Context, updateParam entity.AllowanceTypeUpdateParam, selectParam entity.AllowanceTypeParam) error {
+	err := a.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteCache(ctx, deleteAllowanceTypeKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package allowance_type
+
+const (
+	insertAllowanceType = `
+		INSERT INTO allowance_types (
+			code,
+			name,
+			is_prorated,
+			created_at,
+			created_by
+		) VALUES (
+			:code,
+			:name,
+			:is_prorated,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyAllowanceType = `
+		INSERT INTO allowance_types (
+			code,
+			name,
+			is_prorated,
+			created_at,
+			created_by
+		) VALUES (
+			:code,
+			:name,
+			:is_prorated,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readAllowanceType = `
+		SELECT
+			id,
+			code,
+			name,
+			is_prorated,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			allowance_types
+	`
+
+	countAllowanceType = `
+		SELECT
+			COUNT(*)
+		FROM
+			allowance_types
+	`
+
+	updateAllowanceType = `
+		UPDATE
+			allowance_types
+	`
+)
@@ -0,0 +1,123 @@
+package allowance_type
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getAllowanceTypeByKey           = "employeePayroll:allowanceType:get:%s"
+	getAllowanceTypeByQueryKey      = "employeePayroll:allowanceType:get:q:%s"
+	getAllowanceTypeByPaginationKey = "employeePayroll:allowanceType:get:p:%s"
+	deleteAllowanceTypeKeysPattern  = "employeePayroll:allowanceType*"
+)
+
+func (a *allowanceType) upsertCache(ctx context.Context, key string, allowanceType entity.AllowanceType, ttl time.Duration) error {
+	marshalledAllowanceType, err := a.json.Marshal(allowanceType)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = a.redis.SetEX(ctx, key, string(marshalledAllowanceType), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (a *allowanceType) getCache(ctx context.Context, key string) (entity.AllowanceType, error) {
+	allowanceType := entity.AllowanceType{}
+
+	marshalledAllowanceType, err := a.redis.Get(ctx, key)
+	if err != nil {
+		return allowanceType, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledAllowanceType), &allowanceType)
+	if err != nil {
+		return allowanceType, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return allowanceType, nil
+}
+
+func (a *allowanceType) upsertCacheList(ctx context.Context, param entity.AllowanceTypeParam, allowanceTypeList []entity.AllowanceType, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := a.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set allowanceType list to cache
+	marshalledAllowanceTypeList, err := a.json.Marshal(allowanceTypeList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = a.redis.SetEX(ctx, fmt.Sprintf(getAllowanceTypeByQueryKey, string(keyValue)), string(marshalledAllowanceTypeList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := a.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = a.redis.SetEX(ctx, fmt.Sprintf(getAllowanceTypeByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (a *allowanceType) getCacheList(ctx context.Context, param entity.AllowanceTypeParam) ([]entity.AllowanceType, entity.Pagination, error) {
+	var (
+		allowanceTypeList = []entity.AllowanceType{}
+		pg                = entity.Pagination{}
+	)
+
+	keyValue, err := a.json.Marshal(param)
+	if err != nil {
+		return allowanceTypeList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get allowanceType list from redis
+	marshalledAllowanceTypeList, err := a.redis.Get(ctx, fmt.Sprintf(getAllowanceTypeByQueryKey, string(keyValue)))
+	if err != nil {
+		return allowanceTypeList, pg, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledAllowanceTypeList), &allowanceTypeList)
+	if err != nil {
+		return allowanceTypeList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := a.redis.Get(ctx, fmt.Sprintf(getAllowanceTypeByPaginationKey, string(keyValue)))
+	if err != nil {
+		return allowanceTypeList, pg, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return allowanceTypeList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return allowanceTypeList, pg, nil
+}
+
+func (a *allowanceType) deleteCache(ctx context.Context, key string) error {
+	err := a.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
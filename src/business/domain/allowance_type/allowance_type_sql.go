@@ -0,0 +1,169 @@
+package allowance_type
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (a *allowanceType) getSQL(ctx context.Context, param entity.AllowanceTypeParam) (entity.AllowanceType, error) {
+	allowanceType := entity.AllowanceType{}
+
+	a.log.Debug(ctx, fmt.Sprintf("get allowance type with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return allowanceType, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := a.db.QueryRow(ctx, "rAllowanceType", readAllowanceType+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return allowanceType, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&allowanceType); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return allowanceType, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return allowanceType, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success get allowance type with body: %v", param))
+
+	return allowanceType, nil
+}
+
+func (a *allowanceType) getListSQL(ctx context.Context, param entity.AllowanceTypeParam) ([]entity.AllowanceType, *entity.Pagination, error) {
+	allowanceTypeList := []entity.AllowanceType{}
+	pg := entity.Pagination{}
+
+	a.log.Debug(ctx, fmt.Sprintf("get allowance type list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return allowanceTypeList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := a.db.Query(ctx, "rAllowanceTypeList", readAllowanceType+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return allowanceTypeList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		allowanceType := entity.AllowanceType{}
+		err := rows.StructScan(&allowanceType)
+		if err != nil {
+			a.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		allowanceTypeList = append(allowanceTypeList, allowanceType)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(allowanceTypeList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(allowanceTypeList) > 0 {
+		err := a.db.Get(ctx, "cAllowanceTypeList", countAllowanceType+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return allowanceTypeList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	a.log.Debug(ctx, fmt.Sprintf("success get allowance type list with body: %v", param))
+
+	return allowanceTypeList, &pg, nil
+}
+
+func (a *allowanceType) createSQL(ctx context.Context, inputParam entity.AllowanceTypeInputParam) (entity.AllowanceType, error) {
+	allowanceType := entity.AllowanceType{}
+
+	a.log.Debug(ctx, fmt.Sprintf("create allowance type with body: %v", inputParam))
+
+	stmt, err := a.db.PrepareNamed(ctx, "iNewAllowanceType", insertAllowanceType)
+	if err != nil {
+		return allowanceType, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&allowanceType, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return allowanceType, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return allowanceType, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success create allowance type with body: %v", inputParam))
+
+	return allowanceType, nil
+}
+
+func (a *allowanceType) createManySQL(ctx context.Context, inputParams []entity.AllowanceTypeInputParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("create many allowance type with body: %v", inputParams))
+
+	res, err := a.db.NamedExec(ctx, "iManyAllowanceType", insertManyAllowanceType, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no allowance type created")
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success create many allowance type with body: %v", inputParams))
+
+	return nil
+}
+
+func (a *allowanceType) updateSQL(ctx context.Context, updateParam entity.AllowanceTypeUpdateParam, selectParam entity.AllowanceTypeParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("update allowance type with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := a.db.Exec(ctx, "uAllowanceType", updateAllowanceType+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no allowance type updated")
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success update allowance type with body: %v", updateParam))
+
+	return nil
+}
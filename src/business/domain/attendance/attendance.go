@@ -17,6 +17,7 @@ type Interface interface {
 	GetList(ctx context.Context, param entity.AttendanceParam) ([]entity.Attendance, *entity.Pagination, error)
 	CountUserAttendance(ctx context.Context, attendancePeriodID int64) (entity.UserAttendanceCount, error)
 	Create(ctx context.Context, param entity.AttendanceInputParam) (entity.Attendance, error)
+	CreateMany(ctx context.Context, inputParams []entity.AttendanceInputParam) error
 	Update(ctx context.Context, updateParam entity.AttendanceUpdateParam, selectParam entity.AttendanceParam) error
 }
 
@@ -116,6 +117,20 @@ func (a *attendance) Create(ctx context.Context, param entity.AttendanceInputPar
 	return attendance, nil
 }
 
+func (a *attendance) CreateMany(ctx context.Context, inputParams []entity.AttendanceInputParam) error {
+	err := a.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteCache(ctx, deleteAttendanceKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
 func (a *attendance) Update(ctx context.Context, updateParam entity.AttendanceUpdateParam, selectParam entity.AttendanceParam) error {
 	err := a.updateSQL(ctx, updateParam, selectParam)
 	if err != nil {
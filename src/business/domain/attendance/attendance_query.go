@@ -6,23 +6,74 @@ const (
 			fk_attendance_period_id,
 			fk_user_id,
 			attendance_date,
+			attendance_type,
+			check_in_at,
+			check_out_at,
+			worked_minutes,
+			fk_office_location_id,
+			check_in_latitude,
+			check_in_longitude,
 			created_at,
 			created_by
 		) VALUES (
 			:fk_attendance_period_id,
 			:fk_user_id,
 			:attendance_date,
+			:attendance_type,
+			:check_in_at,
+			:check_out_at,
+			:worked_minutes,
+			:fk_office_location_id,
+			:check_in_latitude,
+			:check_in_longitude,
 			:created_at,
 			:created_by
 		) RETURNING *
 	`
 
+	insertManyAttendance = `
+		INSERT INTO attendances (
+			fk_attendance_period_id,
+			fk_user_id,
+			attendance_date,
+			attendance_type,
+			check_in_at,
+			check_out_at,
+			worked_minutes,
+			fk_office_location_id,
+			check_in_latitude,
+			check_in_longitude,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:fk_user_id,
+			:attendance_date,
+			:attendance_type,
+			:check_in_at,
+			:check_out_at,
+			:worked_minutes,
+			:fk_office_location_id,
+			:check_in_latitude,
+			:check_in_longitude,
+			:created_at,
+			:created_by
+		)
+	`
+
 	readAttendance = `
 		SELECT
 			id,
 			fk_attendance_period_id,
 			fk_user_id,
 			attendance_date,
+			attendance_type,
+			check_in_at,
+			check_out_at,
+			worked_minutes,
+			fk_office_location_id,
+			check_in_latitude,
+			check_in_longitude,
 			status,
 			flag,
 			meta,
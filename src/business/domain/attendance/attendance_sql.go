@@ -115,6 +115,30 @@ func (a *attendance) createSQL(ctx context.Context, inputParam entity.Attendance
 	return attendance, nil
 }
 
+func (a *attendance) createManySQL(ctx context.Context, inputParams []entity.AttendanceInputParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("create many attendance with body: %v", inputParams))
+
+	res, err := a.db.NamedExec(ctx, "iManyAttendance", insertManyAttendance, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no attendance created")
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success create many attendance with body: %v", inputParams))
+
+	return nil
+}
+
 func (a *attendance) updateSQL(ctx context.Context, updateParam entity.AttendanceUpdateParam, selectParam entity.AttendanceParam) error {
 	a.log.Debug(ctx, fmt.Sprintf("update attendance with body: %v", updateParam))
 
@@ -0,0 +1,145 @@
+package attendance_correction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.AttendanceCorrectionParam) (entity.AttendanceCorrection, error)
+	GetList(ctx context.Context, param entity.AttendanceCorrectionParam) ([]entity.AttendanceCorrection, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.AttendanceCorrectionInputParam) (entity.AttendanceCorrection, error)
+	CreateMany(ctx context.Context, inputParams []entity.AttendanceCorrectionInputParam) error
+	Update(ctx context.Context, updateParam entity.AttendanceCorrectionUpdateParam, selectParam entity.AttendanceCorrectionParam) error
+}
+
+type attendanceCorrection struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &attendanceCorrection{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (a *attendanceCorrection) Get(ctx context.Context, param entity.AttendanceCorrectionParam) (entity.AttendanceCorrection, error) {
+	attendanceCorrection := entity.AttendanceCorrection{}
+
+	marshalledParam, err := a.json.Marshal(param)
+	if err != nil {
+		return attendanceCorrection, err
+	}
+
+	if !param.BypassCache {
+		attendanceCorrection, err = a.getCache(ctx, fmt.Sprintf(getAttendanceCorrectionByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return attendanceCorrection, nil
+		}
+	}
+
+	attendanceCorrection, err = a.getSQL(ctx, param)
+	if err != nil {
+		return attendanceCorrection, err
+	}
+
+	err = a.upsertCache(ctx, fmt.Sprintf(getAttendanceCorrectionByKey, string(marshalledParam)), attendanceCorrection, a.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return attendanceCorrection, nil
+}
+
+func (a *attendanceCorrection) GetList(ctx context.Context, param entity.AttendanceCorrectionParam) ([]entity.AttendanceCorrection, *entity.Pagination, error) {
+	if !param.BypassCache {
+		attendanceCorrectionList, pg, err := a.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return attendanceCorrectionList, &pg, nil
+		}
+	}
+
+	attendanceCorrectionList, pg, err := a.getListSQL(ctx, param)
+	if err != nil {
+		return attendanceCorrectionList, pg, err
+	}
+
+	err = a.upsertCacheList(ctx, param, attendanceCorrectionList, *pg, a.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return attendanceCorrectionList, pg, nil
+}
+
+func (a *attendanceCorrection) Create(ctx context.Context, param entity.AttendanceCorrectionInputParam) (entity.AttendanceCorrection, error) {
+	attendanceCorrection, err := a.createSQL(ctx, param)
+	if err != nil {
+		return attendanceCorrection, err
+	}
+
+	err = a.deleteCache(ctx, deleteAttendanceCorrectionKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return attendanceCorrection, nil
+}
+
+func (a *attendanceCorrection) CreateMany(ctx context.Context, inputParams []entity.AttendanceCorrectionInputParam) error {
+	err := a.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteCache(ctx, deleteAttendanceCorrectionKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (a *attendanceCorrection) Update(ctx context.Context, updateParam entity.AttendanceCorrectionUpdateParam, selectParam entity.AttendanceCorrectionParam) error {
+	err := a.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteCache(ctx, deleteAttendanceCorrectionKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
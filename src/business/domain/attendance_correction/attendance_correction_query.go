@@ -0,0 +1,95 @@
+package attendance_correction
+
+const (
+	insertAttendanceCorrection = `
+		INSERT INTO attendance_corrections (
+			fk_attendance_period_id,
+			fk_user_id,
+			attendance_date,
+			attendance_type,
+			check_in_at,
+			check_out_at,
+			reason,
+			correction_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:fk_user_id,
+			:attendance_date,
+			:attendance_type,
+			:check_in_at,
+			:check_out_at,
+			:reason,
+			:correction_status,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyAttendanceCorrection = `
+		INSERT INTO attendance_corrections (
+			fk_attendance_period_id,
+			fk_user_id,
+			attendance_date,
+			attendance_type,
+			check_in_at,
+			check_out_at,
+			reason,
+			correction_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:fk_user_id,
+			:attendance_date,
+			:attendance_type,
+			:check_in_at,
+			:check_out_at,
+			:reason,
+			:correction_status,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readAttendanceCorrection = `
+		SELECT
+			id,
+			fk_attendance_period_id,
+			fk_user_id,
+			attendance_date,
+			attendance_type,
+			check_in_at,
+			check_out_at,
+			reason,
+			correction_status,
+			review_note,
+			reviewed_at,
+			reviewed_by,
+			fk_attendance_id,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			attendance_corrections
+	`
+
+	countAttendanceCorrection = `
+		SELECT
+			COUNT(*)
+		FROM
+			attendance_corrections
+	`
+
+	updateAttendanceCorrection = `
+		UPDATE
+			attendance_corrections
+	`
+)
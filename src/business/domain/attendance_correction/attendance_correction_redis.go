@@ -0,0 +1,123 @@
+package attendance_correction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getAttendanceCorrectionByKey           = "employeePayroll:attendanceCorrection:get:%s"
+	getAttendanceCorrectionByQueryKey      = "employeePayroll:attendanceCorrection:get:q:%s"
+	getAttendanceCorrectionByPaginationKey = "employeePayroll:attendanceCorrection:get:p:%s"
+	deleteAttendanceCorrectionKeysPattern  = "employeePayroll:attendanceCorrection*"
+)
+
+func (a *attendanceCorrection) upsertCache(ctx context.Context, key string, attendanceCorrection entity.AttendanceCorrection, ttl time.Duration) error {
+	marshalledAttendanceCorrection, err := a.json.Marshal(attendanceCorrection)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = a.redis.SetEX(ctx, key, string(marshalledAttendanceCorrection), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (a *attendanceCorrection) getCache(ctx context.Context, key string) (entity.AttendanceCorrection, error) {
+	attendanceCorrection := entity.AttendanceCorrection{}
+
+	marshalledAttendanceCorrection, err := a.redis.Get(ctx, key)
+	if err != nil {
+		return attendanceCorrection, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledAttendanceCorrection), &attendanceCorrection)
+	if err != nil {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return attendanceCorrection, nil
+}
+
+func (a *attendanceCorrection) upsertCacheList(ctx context.Context, param entity.AttendanceCorrectionParam, attendanceCorrectionList []entity.AttendanceCorrection, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := a.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set attendanceCorrection list to cache
+	marshalledAttendanceCorrectionList, err := a.json.Marshal(attendanceCorrectionList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = a.redis.SetEX(ctx, fmt.Sprintf(getAttendanceCorrectionByQueryKey, string(keyValue)), string(marshalledAttendanceCorrectionList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := a.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = a.redis.SetEX(ctx, fmt.Sprintf(getAttendanceCorrectionByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (a *attendanceCorrection) getCacheList(ctx context.Context, param entity.AttendanceCorrectionParam) ([]entity.AttendanceCorrection, entity.Pagination, error) {
+	var (
+		attendanceCorrectionList = []entity.AttendanceCorrection{}
+		pg                       = entity.Pagination{}
+	)
+
+	keyValue, err := a.json.Marshal(param)
+	if err != nil {
+		return attendanceCorrectionList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get attendanceCorrection list from redis
+	marshalledAttendanceCorrectionList, err := a.redis.Get(ctx, fmt.Sprintf(getAttendanceCorrectionByQueryKey, string(keyValue)))
+	if err != nil {
+		return attendanceCorrectionList, pg, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledAttendanceCorrectionList), &attendanceCorrectionList)
+	if err != nil {
+		return attendanceCorrectionList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := a.redis.Get(ctx, fmt.Sprintf(getAttendanceCorrectionByPaginationKey, string(keyValue)))
+	if err != nil {
+		return attendanceCorrectionList, pg, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return attendanceCorrectionList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return attendanceCorrectionList, pg, nil
+}
+
+func (a *attendanceCorrection) deleteCache(ctx context.Context, key string) error {
+	err := a.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
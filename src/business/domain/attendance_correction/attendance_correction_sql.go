@@ -0,0 +1,169 @@
+package attendance_correction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (a *attendanceCorrection) getSQL(ctx context.Context, param entity.AttendanceCorrectionParam) (entity.AttendanceCorrection, error) {
+	attendanceCorrection := entity.AttendanceCorrection{}
+
+	a.log.Debug(ctx, fmt.Sprintf("get attendance correction with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := a.db.QueryRow(ctx, "rAttendanceCorrection", readAttendanceCorrection+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&attendanceCorrection); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success get attendance correction with body: %v", param))
+
+	return attendanceCorrection, nil
+}
+
+func (a *attendanceCorrection) getListSQL(ctx context.Context, param entity.AttendanceCorrectionParam) ([]entity.AttendanceCorrection, *entity.Pagination, error) {
+	attendanceCorrectionList := []entity.AttendanceCorrection{}
+	pg := entity.Pagination{}
+
+	a.log.Debug(ctx, fmt.Sprintf("get attendance correction list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return attendanceCorrectionList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := a.db.Query(ctx, "rAttendanceCorrectionList", readAttendanceCorrection+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return attendanceCorrectionList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		attendanceCorrection := entity.AttendanceCorrection{}
+		err := rows.StructScan(&attendanceCorrection)
+		if err != nil {
+			a.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		attendanceCorrectionList = append(attendanceCorrectionList, attendanceCorrection)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(attendanceCorrectionList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(attendanceCorrectionList) > 0 {
+		err := a.db.Get(ctx, "cAttendanceCorrectionList", countAttendanceCorrection+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return attendanceCorrectionList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	a.log.Debug(ctx, fmt.Sprintf("success get attendance correction list with body: %v", param))
+
+	return attendanceCorrectionList, &pg, nil
+}
+
+func (a *attendanceCorrection) createSQL(ctx context.Context, inputParam entity.AttendanceCorrectionInputParam) (entity.AttendanceCorrection, error) {
+	attendanceCorrection := entity.AttendanceCorrection{}
+
+	a.log.Debug(ctx, fmt.Sprintf("create attendance correction with body: %v", inputParam))
+
+	stmt, err := a.db.PrepareNamed(ctx, "iNewAttendanceCorrection", insertAttendanceCorrection)
+	if err != nil {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&attendanceCorrection, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return attendanceCorrection, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success create attendance correction with body: %v", inputParam))
+
+	return attendanceCorrection, nil
+}
+
+func (a *attendanceCorrection) createManySQL(ctx context.Context, inputParams []entity.AttendanceCorrectionInputParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("create many attendance correction with body: %v", inputParams))
+
+	res, err := a.db.NamedExec(ctx, "iManyAttendanceCorrection", insertManyAttendanceCorrection, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no attendance correction created")
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success create many attendance correction with body: %v", inputParams))
+
+	return nil
+}
+
+func (a *attendanceCorrection) updateSQL(ctx context.Context, updateParam entity.AttendanceCorrectionUpdateParam, selectParam entity.AttendanceCorrectionParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("update attendance correction with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := a.db.Exec(ctx, "uAttendanceCorrection", updateAttendanceCorrection+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no attendance correction updated")
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success update attendance correction with body: %v", updateParam))
+
+	return nil
+}
@@ -6,12 +6,14 @@ const (
 			start_date,
 			end_date,
 			period_status,
+			exchange_rate_fixing_date,
 			created_at,
 			created_by
 		) VALUES (
 			:start_date,
 			:end_date,
 			:period_status,
+			:exchange_rate_fixing_date,
 			:created_at,
 			:created_by
 		) RETURNING *
@@ -23,6 +25,7 @@ const (
 			start_date,
 			end_date,
 			period_status,
+			exchange_rate_fixing_date,
 			status,
 			flag,
 			meta,
@@ -0,0 +1,145 @@
+package attendance_type
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.AttendanceTypeParam) (entity.AttendanceType, error)
+	GetList(ctx context.Context, param entity.AttendanceTypeParam) ([]entity.AttendanceType, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.AttendanceTypeInputParam) (entity.AttendanceType, error)
+	CreateMany(ctx context.Context, inputParams []entity.AttendanceTypeInputParam) error
+	Update(ctx context.Context, updateParam entity.AttendanceTypeUpdateParam, selectParam entity.AttendanceTypeParam) error
+}
+
+type attendanceType struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &attendanceType{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (a *attendanceType) Get(ctx context.Context, param entity.AttendanceTypeParam) (entity.AttendanceType, error) {
+	attendanceType := entity.AttendanceType{}
+
+	marshalledParam, err := a.json.Marshal(param)
+	if err != nil {
+		return attendanceType, err
+	}
+
+	if !param.BypassCache {
+		attendanceType, err = a.getCache(ctx, fmt.Sprintf(getAttendanceTypeByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return attendanceType, nil
+		}
+	}
+
+	attendanceType, err = a.getSQL(ctx, param)
+	if err != nil {
+		return attendanceType, err
+	}
+
+	err = a.upsertCache(ctx, fmt.Sprintf(getAttendanceTypeByKey, string(marshalledParam)), attendanceType, a.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return attendanceType, nil
+}
+
+func (a *attendanceType) GetList(ctx context.Context, param entity.AttendanceTypeParam) ([]entity.AttendanceType, *entity.Pagination, error) {
+	if !param.BypassCache {
+		attendanceTypeList, pg, err := a.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			a.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return attendanceTypeList, &pg, nil
+		}
+	}
+
+	attendanceTypeList, pg, err := a.getListSQL(ctx, param)
+	if err != nil {
+		return attendanceTypeList, pg, err
+	}
+
+	err = a.upsertCacheList(ctx, param, attendanceTypeList, *pg, a.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return attendanceTypeList, pg, nil
+}
+
+func (a *attendanceType) Create(ctx context.Context, param entity.AttendanceTypeInputParam) (entity.AttendanceType, error) {
+	attendanceType, err := a.createSQL(ctx, param)
+	if err != nil {
+		return attendanceType, err
+	}
+
+	err = a.deleteCache(ctx, deleteAttendanceTypeKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return attendanceType, nil
+}
+
+func (a *attendanceType) CreateMany(ctx context.Context, inputParams []entity.AttendanceTypeInputParam) error {
+	err := a.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteCache(ctx, deleteAttendanceTypeKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (a *attendanceType) Update(ctx context.Context, updateParam entity.AttendanceTypeUpdateParam, selectParam entity.AttendanceTypeParam) error {
+	err := a.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteCache(ctx, deleteAttendanceTypeKeysPattern)
+	if err != nil {
+		a.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package attendance_type
+
+const (
+	insertAttendanceType = `
+		INSERT INTO attendance_types (
+			code,
+			name,
+			daily_allowance,
+			max_days_per_period,
+			created_at,
+			created_by
+		) VALUES (
+			:code,
+			:name,
+			:daily_allowance,
+			:max_days_per_period,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyAttendanceType = `
+		INSERT INTO attendance_types (
+			code,
+			name,
+			daily_allowance,
+			max_days_per_period,
+			created_at,
+			created_by
+		) VALUES (
+			:code,
+			:name,
+			:daily_allowance,
+			:max_days_per_period,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readAttendanceType = `
+		SELECT
+			id,
+			code,
+			name,
+			daily_allowance,
+			max_days_per_period,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			attendance_types
+	`
+
+	countAttendanceType = `
+		SELECT
+			COUNT(*)
+		FROM
+			attendance_types
+	`
+
+	updateAttendanceType = `
+		UPDATE
+			attendance_types
+	`
+)
@@ -0,0 +1,123 @@
+package attendance_type
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getAttendanceTypeByKey           = "employeePayroll:attendanceType:get:%s"
+	getAttendanceTypeByQueryKey      = "employeePayroll:attendanceType:get:q:%s"
+	getAttendanceTypeByPaginationKey = "employeePayroll:attendanceType:get:p:%s"
+	deleteAttendanceTypeKeysPattern  = "employeePayroll:attendanceType*"
+)
+
+func (a *attendanceType) upsertCache(ctx context.Context, key string, attendanceType entity.AttendanceType, ttl time.Duration) error {
+	marshalledAttendanceType, err := a.json.Marshal(attendanceType)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = a.redis.SetEX(ctx, key, string(marshalledAttendanceType), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (a *attendanceType) getCache(ctx context.Context, key string) (entity.AttendanceType, error) {
+	attendanceType := entity.AttendanceType{}
+
+	marshalledAttendanceType, err := a.redis.Get(ctx, key)
+	if err != nil {
+		return attendanceType, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledAttendanceType), &attendanceType)
+	if err != nil {
+		return attendanceType, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return attendanceType, nil
+}
+
+func (a *attendanceType) upsertCacheList(ctx context.Context, param entity.AttendanceTypeParam, attendanceTypeList []entity.AttendanceType, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := a.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set attendanceType list to cache
+	marshalledAttendanceTypeList, err := a.json.Marshal(attendanceTypeList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = a.redis.SetEX(ctx, fmt.Sprintf(getAttendanceTypeByQueryKey, string(keyValue)), string(marshalledAttendanceTypeList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := a.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = a.redis.SetEX(ctx, fmt.Sprintf(getAttendanceTypeByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (a *attendanceType) getCacheList(ctx context.Context, param entity.AttendanceTypeParam) ([]entity.AttendanceType, entity.Pagination, error) {
+	var (
+		attendanceTypeList = []entity.AttendanceType{}
+		pg                 = entity.Pagination{}
+	)
+
+	keyValue, err := a.json.Marshal(param)
+	if err != nil {
+		return attendanceTypeList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get attendanceType list from redis
+	marshalledAttendanceTypeList, err := a.redis.Get(ctx, fmt.Sprintf(getAttendanceTypeByQueryKey, string(keyValue)))
+	if err != nil {
+		return attendanceTypeList, pg, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledAttendanceTypeList), &attendanceTypeList)
+	if err != nil {
+		return attendanceTypeList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := a.redis.Get(ctx, fmt.Sprintf(getAttendanceTypeByPaginationKey, string(keyValue)))
+	if err != nil {
+		return attendanceTypeList, pg, err
+	}
+
+	err = a.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return attendanceTypeList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return attendanceTypeList, pg, nil
+}
+
+func (a *attendanceType) deleteCache(ctx context.Context, key string) error {
+	err := a.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package attendance_type
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (a *attendanceType) getSQL(ctx context.Context, param entity.AttendanceTypeParam) (entity.AttendanceType, error) {
+	attendanceType := entity.AttendanceType{}
+
+	a.log.Debug(ctx, fmt.Sprintf("get attendance type with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return attendanceType, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := a.db.QueryRow(ctx, "rAttendanceType", readAttendanceType+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return attendanceType, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&attendanceType); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return attendanceType, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return attendanceType, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success get attendance type with body: %v", param))
+
+	return attendanceType, nil
+}
+
+func (a *attendanceType) getListSQL(ctx context.Context, param entity.AttendanceTypeParam) ([]entity.AttendanceType, *entity.Pagination, error) {
+	attendanceTypeList := []entity.AttendanceType{}
+	pg := entity.Pagination{}
+
+	a.log.Debug(ctx, fmt.Sprintf("get attendance type list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return attendanceTypeList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := a.db.Query(ctx, "rAttendanceTypeList", readAttendanceType+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return attendanceTypeList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		attendanceType := entity.AttendanceType{}
+		err := rows.StructScan(&attendanceType)
+		if err != nil {
+			a.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		attendanceTypeList = append(attendanceTypeList, attendanceType)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(attendanceTypeList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(attendanceTypeList) > 0 {
+		err := a.db.Get(ctx, "cAttendanceTypeList", countAttendanceType+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return attendanceTypeList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	a.log.Debug(ctx, fmt.Sprintf("success get attendance type list with body: %v", param))
+
+	return attendanceTypeList, &pg, nil
+}
+
+func (a *attendanceType) createSQL(ctx context.Context, inputParam entity.AttendanceTypeInputParam) (entity.AttendanceType, error) {
+	attendanceType := entity.AttendanceType{}
+
+	a.log.Debug(ctx, fmt.Sprintf("create attendance type with body: %v", inputParam))
+
+	stmt, err := a.db.PrepareNamed(ctx, "iNewAttendanceType", insertAttendanceType)
+	if err != nil {
+		return attendanceType, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&attendanceType, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return attendanceType, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return attendanceType, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success create attendance type with body: %v", inputParam))
+
+	return attendanceType, nil
+}
+
+func (a *attendanceType) createManySQL(ctx context.Context, inputParams []entity.AttendanceTypeInputParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("create many attendance type with body: %v", inputParams))
+
+	res, err := a.db.NamedExec(ctx, "iManyAttendanceType", insertManyAttendanceType, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no attendance type created")
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success create many attendance type with body: %v", inputParams))
+
+	return nil
+}
+
+func (a *attendanceType) updateSQL(ctx context.Context, updateParam entity.AttendanceTypeUpdateParam, selectParam entity.AttendanceTypeParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("update attendance type with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(entity.SQLUpdateParam(updateParam), &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := a.db.Exec(ctx, "uAttendanceType", updateAttendanceType+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no attendance type updated")
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("success update attendance type with body: %v", updateParam))
+
+	return nil
+}
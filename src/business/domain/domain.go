@@ -5,25 +5,67 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/parser"
 	"github.com/reyhanmichiels/go-pkg/v2/redis"
 	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/allowance_type"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_correction"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_period"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_type"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/exchange_rate"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/holiday"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/loan"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/loan_repayment"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/office_location"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/overtime"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/pay_component"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_adjustment"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_preview"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_run"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_run_employee"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payslip"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payslip_currency_total"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payslip_detail"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/reimbursement"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/tax_bracket"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/transactor"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_allowance"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_office_location"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_salary"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/year_to_date_total"
 )
 
 type Domains struct {
-	User             user.Interface
-	Attendance       attendance.Interface
-	AttendancePeriod attendance_period.Interface
-	Overtime         overtime.Interface
-	Reimbursement    reimbursement.Interface
-	Transactor       transactor.Interface
-	Payslip          payslip.Interface
-	PayslipDetail    payslip_detail.Interface
+	User                 user.Interface
+	Attendance           attendance.Interface
+	AttendancePeriod     attendance_period.Interface
+	Overtime             overtime.Interface
+	Reimbursement        reimbursement.Interface
+	Transactor           transactor.Interface
+	Payslip              payslip.Interface
+	PayslipDetail        payslip_detail.Interface
+	PayComponent         pay_component.Interface
+	TaxBracket           tax_bracket.Interface
+	PayrollPreview       payroll_preview.Interface
+	PayrollRun           payroll_run.Interface
+	PayrollRunEmployee   payroll_run_employee.Interface
+	ExchangeRate         exchange_rate.Interface
+	PayslipCurrencyTotal payslip_currency_total.Interface
+	AllowanceType        allowance_type.Interface
+	UserAllowance        user_allowance.Interface
+	PayrollAdjustment    payroll_adjustment.Interface
+	Loan                 loan.Interface
+	LoanRepayment        loan_repayment.Interface
+	Holiday              holiday.Interface
+	WorkSchedule         work_schedule.Interface
+	UserWorkSchedule     user_work_schedule.Interface
+	UserSalary           user_salary.Interface
+	YearToDateTotal      year_to_date_total.Interface
+	OfficeLocation       office_location.Interface
+	UserOfficeLocation   user_office_location.Interface
+	AttendanceCorrection attendance_correction.Interface
+	AttendanceType       attendance_type.Interface
 }
 
 type InitParam struct {
@@ -36,13 +78,34 @@ type InitParam struct {
 
 func Init(param InitParam) *Domains {
 	return &Domains{
-		User:             user.Init(user.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
-		Attendance:       attendance.Init(attendance.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
-		AttendancePeriod: attendance_period.Init(attendance_period.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
-		Overtime:         overtime.Init(overtime.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
-		Reimbursement:    reimbursement.Init(reimbursement.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
-		Transactor:       transactor.Init(param.Db),
-		Payslip:          payslip.Init(payslip.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
-		PayslipDetail:    payslip_detail.Init(payslip_detail.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		User:                 user.Init(user.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		Attendance:           attendance.Init(attendance.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		AttendancePeriod:     attendance_period.Init(attendance_period.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		Overtime:             overtime.Init(overtime.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		Reimbursement:        reimbursement.Init(reimbursement.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		Transactor:           transactor.Init(param.Db),
+		Payslip:              payslip.Init(payslip.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		PayslipDetail:        payslip_detail.Init(payslip_detail.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		PayComponent:         pay_component.Init(pay_component.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		TaxBracket:           tax_bracket.Init(tax_bracket.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		PayrollPreview:       payroll_preview.Init(payroll_preview.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		PayrollRun:           payroll_run.Init(payroll_run.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		PayrollRunEmployee:   payroll_run_employee.Init(payroll_run_employee.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		ExchangeRate:         exchange_rate.Init(exchange_rate.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		PayslipCurrencyTotal: payslip_currency_total.Init(payslip_currency_total.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		AllowanceType:        allowance_type.Init(allowance_type.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		UserAllowance:        user_allowance.Init(user_allowance.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		PayrollAdjustment:    payroll_adjustment.Init(payroll_adjustment.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		Loan:                 loan.Init(loan.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		LoanRepayment:        loan_repayment.Init(loan_repayment.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		Holiday:              holiday.Init(holiday.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		WorkSchedule:         work_schedule.Init(work_schedule.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		UserWorkSchedule:     user_work_schedule.Init(user_work_schedule.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		UserSalary:           user_salary.Init(user_salary.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		YearToDateTotal:      year_to_date_total.Init(year_to_date_total.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		OfficeLocation:       office_location.Init(office_location.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		UserOfficeLocation:   user_office_location.Init(user_office_location.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		AttendanceCorrection: attendance_correction.Init(attendance_correction.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
+		AttendanceType:       attendance_type.Init(attendance_type.InitParam{Db: param.Db, Log: param.Log, Redis: param.Redis, Json: param.Json}),
 	}
 }
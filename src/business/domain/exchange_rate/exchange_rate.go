@@ -0,0 +1,145 @@
+package exchange_rate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.ExchangeRateParam) (entity.ExchangeRate, error)
+	GetList(ctx context.Context, param entity.ExchangeRateParam) ([]entity.ExchangeRate, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.ExchangeRateInputParam) (entity.ExchangeRate, error)
+	CreateMany(ctx context.Context, inputParams []entity.ExchangeRateInputParam) error
+	Update(ctx context.Context, updateParam entity.ExchangeRateUpdateParam, selectParam entity.ExchangeRateParam) error
+}
+
+type exchangeRate struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &exchangeRate{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (e *exchangeRate) Get(ctx context.Context, param entity.ExchangeRateParam) (entity.ExchangeRate, error) {
+	exchangeRate := entity.ExchangeRate{}
+
+	marshalledParam, err := e.json.Marshal(param)
+	if err != nil {
+		return exchangeRate, err
+	}
+
+	if !param.BypassCache {
+		exchangeRate, err = e.getCache(ctx, fmt.Sprintf(getExchangeRateByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			e.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			e.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return exchangeRate, nil
+		}
+	}
+
+	exchangeRate, err = e.getSQL(ctx, param)
+	if err != nil {
+		return exchangeRate, err
+	}
+
+	err = e.upsertCache(ctx, fmt.Sprintf(getExchangeRateByKey, string(marshalledParam)), exchangeRate, e.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		e.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return exchangeRate, nil
+}
+
+func (e *exchangeRate) GetList(ctx context.Context, param entity.ExchangeRateParam) ([]entity.ExchangeRate, *entity.Pagination, error) {
+	if !param.BypassCache {
+		exchangeRateList, pg, err := e.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			e.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			e.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return exchangeRateList, &pg, nil
+		}
+	}
+
+	exchangeRateList, pg, err := e.getListSQL(ctx, param)
+	if err != nil {
+		return exchangeRateList, pg, err
+	}
+
+	err = e.upsertCacheList(ctx, param, exchangeRateList, *pg, e.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		e.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return exchangeRateList, pg, nil
+}
+
+func (e *exchangeRate) Create(ctx context.Context, param entity.ExchangeRateInputParam) (entity.ExchangeRate, error) {
+	exchangeRate, err := e.createSQL(ctx, param)
+	if err != nil {
+		return exchangeRate, err
+	}
+
+	err = e.deleteCache(ctx, deleteExchangeRateKeysPattern)
+	if err != nil {
+		e.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return exchangeRate, nil
+}
+
+func (e *exchangeRate) CreateMany(ctx context.Context, inputParams []entity.ExchangeRateInputParam) error {
+	err := e.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = e.deleteCache(ctx, deleteExchangeRateKeysPattern)
+	if err != nil {
+		e.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (e *exchangeRate) Update(ctx context.Context, updateParam entity.ExchangeRateUpdateParam, selectParam entity.ExchangeRateParam) error {
+	err := e.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = e.deleteCache(ctx, deleteExchangeRateKeysPattern)
+	if err != nil {
+		e.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package exchange_rate
+
+const (
+	insertExchangeRate = `
+		INSERT INTO exchange_rates (
+			currency,
+			rate,
+			fixing_date,
+			created_at,
+			created_by
+		) VALUES (
+			:currency,
+			:rate,
+			:fixing_date,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyExchangeRate = `
+		INSERT INTO exchange_rates (
+			currency,
+			rate,
+			fixing_date,
+			created_at,
+			created_by
+		) VALUES (
+			:currency,
+			:rate,
+			:fixing_date,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readExchangeRate = `
+		SELECT
+			id,
+			currency,
+			rate,
+			fixing_date,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			exchange_rates
+	`
+
+	countExchangeRate = `
+		SELECT
+			COUNT(*)
+		FROM
+			exchange_rates
+	`
+
+	updateExchangeRate = `
+		UPDATE
+			exchange_rates
+	`
+)
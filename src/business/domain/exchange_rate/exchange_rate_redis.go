@@ -0,0 +1,123 @@
+package exchange_rate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getExchangeRateByKey           = "employeePayroll:exchangeRate:get:%s"
+	getExchangeRateByQueryKey      = "employeePayroll:exchangeRate:get:q:%s"
+	getExchangeRateByPaginationKey = "employeePayroll:exchangeRate:get:p:%s"
+	deleteExchangeRateKeysPattern  = "employeePayroll:exchangeRate*"
+)
+
+func (e *exchangeRate) upsertCache(ctx context.Context, key string, exchangeRate entity.ExchangeRate, ttl time.Duration) error {
+	marshalledExchangeRate, err := e.json.Marshal(exchangeRate)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = e.redis.SetEX(ctx, key, string(marshalledExchangeRate), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (e *exchangeRate) getCache(ctx context.Context, key string) (entity.ExchangeRate, error) {
+	exchangeRate := entity.ExchangeRate{}
+
+	marshalledExchangeRate, err := e.redis.Get(ctx, key)
+	if err != nil {
+		return exchangeRate, err
+	}
+
+	err = e.json.Unmarshal([]byte(marshalledExchangeRate), &exchangeRate)
+	if err != nil {
+		return exchangeRate, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return exchangeRate, nil
+}
+
+func (e *exchangeRate) upsertCacheList(ctx context.Context, param entity.ExchangeRateParam, exchangeRateList []entity.ExchangeRate, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := e.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set exchangeRate list to cache
+	marshalledExchangeRateList, err := e.json.Marshal(exchangeRateList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = e.redis.SetEX(ctx, fmt.Sprintf(getExchangeRateByQueryKey, string(keyValue)), string(marshalledExchangeRateList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := e.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = e.redis.SetEX(ctx, fmt.Sprintf(getExchangeRateByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (e *exchangeRate) getCacheList(ctx context.Context, param entity.ExchangeRateParam) ([]entity.ExchangeRate, entity.Pagination, error) {
+	var (
+		exchangeRateList = []entity.ExchangeRate{}
+		pg               = entity.Pagination{}
+	)
+
+	keyValue, err := e.json.Marshal(param)
+	if err != nil {
+		return exchangeRateList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get exchangeRate list from redis
+	marshalledExchangeRateList, err := e.redis.Get(ctx, fmt.Sprintf(getExchangeRateByQueryKey, string(keyValue)))
+	if err != nil {
+		return exchangeRateList, pg, err
+	}
+
+	err = e.json.Unmarshal([]byte(marshalledExchangeRateList), &exchangeRateList)
+	if err != nil {
+		return exchangeRateList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := e.redis.Get(ctx, fmt.Sprintf(getExchangeRateByPaginationKey, string(keyValue)))
+	if err != nil {
+		return exchangeRateList, pg, err
+	}
+
+	err = e.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return exchangeRateList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return exchangeRateList, pg, nil
+}
+
+func (e *exchangeRate) deleteCache(ctx context.Context, key string) error {
+	err := e.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package exchange_rate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (e *exchangeRate) getSQL(ctx context.Context, param entity.ExchangeRateParam) (entity.ExchangeRate, error) {
+	exchangeRate := entity.ExchangeRate{}
+
+	e.log.Debug(ctx, fmt.Sprintf("get exchange rate with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(e.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return exchangeRate, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := e.db.QueryRow(ctx, "rExchangeRate", readExchangeRate+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return exchangeRate, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&exchangeRate); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return exchangeRate, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return exchangeRate, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	e.log.Debug(ctx, fmt.Sprintf("success get exchange rate with body: %v", param))
+
+	return exchangeRate, nil
+}
+
+func (e *exchangeRate) getListSQL(ctx context.Context, param entity.ExchangeRateParam) ([]entity.ExchangeRate, *entity.Pagination, error) {
+	exchangeRateList := []entity.ExchangeRate{}
+	pg := entity.Pagination{}
+
+	e.log.Debug(ctx, fmt.Sprintf("get exchange rate list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(e.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return exchangeRateList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := e.db.Query(ctx, "rExchangeRateList", readExchangeRate+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return exchangeRateList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		exchangeRate := entity.ExchangeRate{}
+		err := rows.StructScan(&exchangeRate)
+		if err != nil {
+			e.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		exchangeRateList = append(exchangeRateList, exchangeRate)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(exchangeRateList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(exchangeRateList) > 0 {
+		err := e.db.Get(ctx, "cExchangeRateList", countExchangeRate+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return exchangeRateList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	e.log.Debug(ctx, fmt.Sprintf("success get exchange rate list with body: %v", param))
+
+	return exchangeRateList, &pg, nil
+}
+
+func (e *exchangeRate) createSQL(ctx context.Context, inputParam entity.ExchangeRateInputParam) (entity.ExchangeRate, error) {
+	exchangeRate := entity.ExchangeRate{}
+
+	e.log.Debug(ctx, fmt.Sprintf("create exchange rate with body: %v", inputParam))
+
+	stmt, err := e.db.PrepareNamed(ctx, "iNewExchangeRate", insertExchangeRate)
+	if err != nil {
+		return exchangeRate, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&exchangeRate, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return exchangeRate, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return exchangeRate, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	e.log.Debug(ctx, fmt.Sprintf("success create exchange rate with body: %v", inputParam))
+
+	return exchangeRate, nil
+}
+
+func (e *exchangeRate) createManySQL(ctx context.Context, inputParams []entity.ExchangeRateInputParam) error {
+	e.log.Debug(ctx, fmt.Sprintf("create many exchange rate with body: %v", inputParams))
+
+	res, err := e.db.NamedExec(ctx, "iManyExchangeRate", insertManyExchangeRate, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no exchange rate created")
+	}
+
+	e.log.Debug(ctx, fmt.Sprintf("success create many exchange rate with body: %v", inputParams))
+
+	return nil
+}
+
+func (e *exchangeRate) updateSQL(ctx context.Context, updateParam entity.ExchangeRateUpdateParam, selectParam entity.ExchangeRateParam) error {
+	e.log.Debug(ctx, fmt.Sprintf("update exchange rate with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(e.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := e.db.Exec(ctx, "uExchangeRate", updateExchangeRate+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no exchange rate updated")
+	}
+
+	e.log.Debug(ctx, fmt.Sprintf("success update exchange rate with body: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package holiday
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.HolidayParam) (entity.Holiday, error)
+	GetList(ctx context.Context, param entity.HolidayParam) ([]entity.Holiday, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.HolidayInputParam) (entity.Holiday, error)
+	CreateMany(ctx context.Context, inputParams []entity.HolidayInputParam) error
+	Update(ctx context.Context, updateParam entity.HolidayUpdateParam, selectParam entity.HolidayParam) error
+}
+
+type holiday struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &holiday{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (h *holiday) Get(ctx context.Context, param entity.HolidayParam) (entity.Holiday, error) {
+	holiday := entity.Holiday{}
+
+	marshalledParam, err := h.json.Marshal(param)
+	if err != nil {
+		return holiday, err
+	}
+
+	if !param.BypassCache {
+		holiday, err = h.getCache(ctx, fmt.Sprintf(getHolidayByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			h.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			h.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return holiday, nil
+		}
+	}
+
+	holiday, err = h.getSQL(ctx, param)
+	if err != nil {
+		return holiday, err
+	}
+
+	err = h.upsertCache(ctx, fmt.Sprintf(getHolidayByKey, string(marshalledParam)), holiday, h.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		h.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return holiday, nil
+}
+
+func (h *holiday) GetList(ctx context.Context, param entity.HolidayParam) ([]entity.Holiday, *entity.Pagination, error) {
+	if !param.BypassCache {
+		holidayList, pg, err := h.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			h.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			h.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return holidayList, &pg, nil
+		}
+	}
+
+	holidayList, pg, err := h.getListSQL(ctx, param)
+	if err != nil {
+		return holidayList, pg, err
+	}
+
+	err = h.upsertCacheList(ctx, param, holidayList, *pg, h.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		h.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return holidayList, pg, nil
+}
+
+func (h *holiday) Create(ctx context.Context, param entity.HolidayInputParam) (entity.Holiday, error) {
+	holiday, err := h.createSQL(ctx, param)
+	if err != nil {
+		return holiday, err
+	}
+
+	err = h.deleteCache(ctx, deleteHolidayKeysPattern)
+	if err != nil {
+		h.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return holiday, nil
+}
+
+func (h *holiday) CreateMany(ctx context.Context, inputParams []entity.HolidayInputParam) error {
+	err := h.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = h.deleteCache(ctx, deleteHolidayKeysPattern)
+	if err != nil {
+		h.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (h *holiday) Update(ctx context.Context, updateParam entity.HolidayUpdateParam, selectParam entity.HolidayParam) error {
+	err := h.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = h.deleteCache(ctx, deleteHolidayKeysPattern)
+	if err != nil {
+		h.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
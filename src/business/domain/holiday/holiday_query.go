@@ -0,0 +1,66 @@
+package holiday
+
+const (
+	insertHoliday = `
+		INSERT INTO holidays (
+			holiday_date,
+			name,
+			region,
+			created_at,
+			created_by
+		) VALUES (
+			:holiday_date,
+			:name,
+			:region,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyHoliday = `
+		INSERT INTO holidays (
+			holiday_date,
+			name,
+			region,
+			created_at,
+			created_by
+		) VALUES (
+			:holiday_date,
+			:name,
+			:region,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readHoliday = `
+		SELECT
+			id,
+			holiday_date,
+			name,
+			region,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			holidays
+	`
+
+	countHoliday = `
+		SELECT
+			COUNT(*)
+		FROM
+			holidays
+	`
+
+	updateHoliday = `
+		UPDATE
+			holidays
+	`
+)
@@ -0,0 +1,123 @@
+package holiday
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getHolidayByKey           = "employeePayroll:holiday:get:%s"
+	getHolidayByQueryKey      = "employeePayroll:holiday:get:q:%s"
+	getHolidayByPaginationKey = "employeePayroll:holiday:get:p:%s"
+	deleteHolidayKeysPattern  = "employeePayroll:holiday*"
+)
+
+func (h *holiday) upsertCache(ctx context.Context, key string, holiday entity.Holiday, ttl time.Duration) error {
+	marshalledHoliday, err := h.json.Marshal(holiday)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = h.redis.SetEX(ctx, key, string(marshalledHoliday), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (h *holiday) getCache(ctx context.Context, key string) (entity.Holiday, error) {
+	holiday := entity.Holiday{}
+
+	marshalledHoliday, err := h.redis.Get(ctx, key)
+	if err != nil {
+		return holiday, err
+	}
+
+	err = h.json.Unmarshal([]byte(marshalledHoliday), &holiday)
+	if err != nil {
+		return holiday, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return holiday, nil
+}
+
+func (h *holiday) upsertCacheList(ctx context.Context, param entity.HolidayParam, holidayList []entity.Holiday, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := h.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set holiday list to cache
+	marshalledHolidayList, err := h.json.Marshal(holidayList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = h.redis.SetEX(ctx, fmt.Sprintf(getHolidayByQueryKey, string(keyValue)), string(marshalledHolidayList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := h.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = h.redis.SetEX(ctx, fmt.Sprintf(getHolidayByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (h *holiday) getCacheList(ctx context.Context, param entity.HolidayParam) ([]entity.Holiday, entity.Pagination, error) {
+	var (
+		holidayList = []entity.Holiday{}
+		pg          = entity.Pagination{}
+	)
+
+	keyValue, err := h.json.Marshal(param)
+	if err != nil {
+		return holidayList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get holiday list from redis
+	marshalledHolidayList, err := h.redis.Get(ctx, fmt.Sprintf(getHolidayByQueryKey, string(keyValue)))
+	if err != nil {
+		return holidayList, pg, err
+	}
+
+	err = h.json.Unmarshal([]byte(marshalledHolidayList), &holidayList)
+	if err != nil {
+		return holidayList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := h.redis.Get(ctx, fmt.Sprintf(getHolidayByPaginationKey, string(keyValue)))
+	if err != nil {
+		return holidayList, pg, err
+	}
+
+	err = h.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return holidayList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return holidayList, pg, nil
+}
+
+func (h *holiday) deleteCache(ctx context.Context, key string) error {
+	err := h.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package holiday
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (h *holiday) getSQL(ctx context.Context, param entity.HolidayParam) (entity.Holiday, error) {
+	holiday := entity.Holiday{}
+
+	h.log.Debug(ctx, fmt.Sprintf("get holiday with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(h.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return holiday, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := h.db.QueryRow(ctx, "rHoliday", readHoliday+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return holiday, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&holiday); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return holiday, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return holiday, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	h.log.Debug(ctx, fmt.Sprintf("success get holiday with body: %v", param))
+
+	return holiday, nil
+}
+
+func (h *holiday) getListSQL(ctx context.Context, param entity.HolidayParam) ([]entity.Holiday, *entity.Pagination, error) {
+	holidayList := []entity.Holiday{}
+	pg := entity.Pagination{}
+
+	h.log.Debug(ctx, fmt.Sprintf("get holiday list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(h.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return holidayList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := h.db.Query(ctx, "rHolidayList", readHoliday+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return holidayList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		holiday := entity.Holiday{}
+		err := rows.StructScan(&holiday)
+		if err != nil {
+			h.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		holidayList = append(holidayList, holiday)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(holidayList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(holidayList) > 0 {
+		err := h.db.Get(ctx, "cHolidayList", countHoliday+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return holidayList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	h.log.Debug(ctx, fmt.Sprintf("success get holiday list with body: %v", param))
+
+	return holidayList, &pg, nil
+}
+
+func (h *holiday) createSQL(ctx context.Context, inputParam entity.HolidayInputParam) (entity.Holiday, error) {
+	holiday := entity.Holiday{}
+
+	h.log.Debug(ctx, fmt.Sprintf("create holiday with body: %v", inputParam))
+
+	stmt, err := h.db.PrepareNamed(ctx, "iNewHoliday", insertHoliday)
+	if err != nil {
+		return holiday, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&holiday, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return holiday, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return holiday, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	h.log.Debug(ctx, fmt.Sprintf("success create holiday with body: %v", inputParam))
+
+	return holiday, nil
+}
+
+func (h *holiday) createManySQL(ctx context.Context, inputParams []entity.HolidayInputParam) error {
+	h.log.Debug(ctx, fmt.Sprintf("create many holiday with body: %v", inputParams))
+
+	res, err := h.db.NamedExec(ctx, "iManyHoliday", insertManyHoliday, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no holiday created")
+	}
+
+	h.log.Debug(ctx, fmt.Sprintf("success create many holiday with body: %v", inputParams))
+
+	return nil
+}
+
+func (h *holiday) updateSQL(ctx context.Context, updateParam entity.HolidayUpdateParam, selectParam entity.HolidayParam) error {
+	h.log.Debug(ctx, fmt.Sprintf("update holiday with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(h.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := h.db.Exec(ctx, "uHoliday", updateHoliday+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no holiday updated")
+	}
+
+	h.log.Debug(ctx, fmt.Sprintf("success update holiday with body: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.LoanParam) (entity.Loan, error)
+	GetList(ctx context.Context, param entity.LoanParam) ([]entity.Loan, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.LoanInputParam) (entity.Loan, error)
+	CreateMany(ctx context.Context, inputParams []entity.LoanInputParam) error
+	Update(ctx context.Context, updateParam entity.LoanUpdateParam, selectParam entity.LoanParam) error
+}
+
+type loan struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &loan{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (l *loan) Get(ctx context.Context, param entity.LoanParam) (entity.Loan, error) {
+	loan := entity.Loan{}
+
+	marshalledParam, err := l.json.Marshal(param)
+	if err != nil {
+		return loan, err
+	}
+
+	if !param.BypassCache {
+		loan, err = l.getCache(ctx, fmt.Sprintf(getLoanByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return loan, nil
+		}
+	}
+
+	loan, err = l.getSQL(ctx, param)
+	if err != nil {
+		return loan, err
+	}
+
+	err = l.upsertCache(ctx, fmt.Sprintf(getLoanByKey, string(marshalledParam)), loan, l.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return loan, nil
+}
+
+func (l *loan) GetList(ctx context.Context, param entity.LoanParam) ([]entity.Loan, *entity.Pagination, error) {
+	if !param.BypassCache {
+		loanList, pg, err := l.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return loanList, &pg, nil
+		}
+	}
+
+	loanList, pg, err := l.getListSQL(ctx, param)
+	if err != nil {
+		return loanList, pg, err
+	}
+
+	err = l.upsertCacheList(ctx, param, loanList, *pg, l.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return loanList, pg, nil
+}
+
+func (l *loan) Create(ctx context.Context, param entity.LoanInputParam) (entity.Loan, error) {
+	loan, err := l.createSQL(ctx, param)
+	if err != nil {
+		return loan, err
+	}
+
+	err = l.deleteCache(ctx, deleteLoanKeysPattern)
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return loan, nil
+}
+
+func (l *loan) CreateMany(ctx context.Context, inputParams []entity.LoanInputParam) error {
+	err := l.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = l.deleteCache(ctx, deleteLoanKeysPattern)
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (l *loan) Update(ctx context.Context, updateParam entity.LoanUpdateParam, selectParam entity.LoanParam) error {
+	err := l.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = l.deleteCache(ctx, deleteLoanKeysPattern)
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package loan
+
+const (
+	insertLoan = `
+		INSERT INTO loans (
+			fk_user_id,
+			description,
+			principal,
+			instalment_count,
+			instalment_amount,
+			remaining_balance,
+			currency,
+			start_date,
+			loan_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:description,
+			:principal,
+			:instalment_count,
+			:instalment_amount,
+			:remaining_balance,
+			:currency,
+			:start_date,
+			:loan_status,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyLoan = `
+		INSERT INTO loans (
+			fk_user_id,
+			description,
+			principal,
+			instalment_count,
+			instalment_amount,
+			remaining_balance,
+			currency,
+			start_date,
+			loan_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:description,
+			:principal,
+			:instalment_count,
+			:instalment_amount,
+			:remaining_balance,
+			:currency,
+			:start_date,
+			:loan_status,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readLoan = `
+		SELECT
+			id,
+			fk_user_id,
+			description,
+			principal,
+			instalment_count,
+			instalment_amount,
+			remaining_balance,
+			currency,
+			start_date,
+			loan_status,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			loans
+	`
+
+	countLoan = `
+		SELECT
+			COUNT(*)
+		FROM
+			loans
+	`
+
+	updateLoan = `
+		UPDATE
+			loans
+	`
+)
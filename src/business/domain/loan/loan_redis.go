@@ -0,0 +1,123 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getLoanByKey           = "employeePayroll:loan:get:%s"
+	getLoanByQueryKey      = "employeePayroll:loan:get:q:%s"
+	getLoanByPaginationKey = "employeePayroll:loan:get:p:%s"
+	deleteLoanKeysPattern  = "employeePayroll:loan*"
+)
+
+func (l *loan) upsertCache(ctx context.Context, key string, loan entity.Loan, ttl time.Duration) error {
+	marshalledLoan, err := l.json.Marshal(loan)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = l.redis.SetEX(ctx, key, string(marshalledLoan), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (l *loan) getCache(ctx context.Context, key string) (entity.Loan, error) {
+	loan := entity.Loan{}
+
+	marshalledLoan, err := l.redis.Get(ctx, key)
+	if err != nil {
+		return loan, err
+	}
+
+	err = l.json.Unmarshal([]byte(marshalledLoan), &loan)
+	if err != nil {
+		return loan, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return loan, nil
+}
+
+func (l *loan) upsertCacheList(ctx context.Context, param entity.LoanParam, loanList []entity.Loan, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := l.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set loan list to cache
+	marshalledLoanList, err := l.json.Marshal(loanList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = l.redis.SetEX(ctx, fmt.Sprintf(getLoanByQueryKey, string(keyValue)), string(marshalledLoanList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := l.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = l.redis.SetEX(ctx, fmt.Sprintf(getLoanByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (l *loan) getCacheList(ctx context.Context, param entity.LoanParam) ([]entity.Loan, entity.Pagination, error) {
+	var (
+		loanList = []entity.Loan{}
+		pg       = entity.Pagination{}
+	)
+
+	keyValue, err := l.json.Marshal(param)
+	if err != nil {
+		return loanList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get loan list from redis
+	marshalledLoanList, err := l.redis.Get(ctx, fmt.Sprintf(getLoanByQueryKey, string(keyValue)))
+	if err != nil {
+		return loanList, pg, err
+	}
+
+	err = l.json.Unmarshal([]byte(marshalledLoanList), &loanList)
+	if err != nil {
+		return loanList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := l.redis.Get(ctx, fmt.Sprintf(getLoanByPaginationKey, string(keyValue)))
+	if err != nil {
+		return loanList, pg, err
+	}
+
+	err = l.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return loanList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return loanList, pg, nil
+}
+
+func (l *loan) deleteCache(ctx context.Context, key string) error {
+	err := l.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
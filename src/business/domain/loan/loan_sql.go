@@ -0,0 +1,169 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (l *loan) getSQL(ctx context.Context, param entity.LoanParam) (entity.Loan, error) {
+	loan := entity.Loan{}
+
+	l.log.Debug(ctx, fmt.Sprintf("get loan with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(l.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return loan, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := l.db.QueryRow(ctx, "rLoan", readLoan+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return loan, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&loan); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return loan, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return loan, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success get loan with body: %v", param))
+
+	return loan, nil
+}
+
+func (l *loan) getListSQL(ctx context.Context, param entity.LoanParam) ([]entity.Loan, *entity.Pagination, error) {
+	loanList := []entity.Loan{}
+	pg := entity.Pagination{}
+
+	l.log.Debug(ctx, fmt.Sprintf("get loan list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(l.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return loanList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := l.db.Query(ctx, "rLoanList", readLoan+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return loanList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		loan := entity.Loan{}
+		err := rows.StructScan(&loan)
+		if err != nil {
+			l.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		loanList = append(loanList, loan)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(loanList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(loanList) > 0 {
+		err := l.db.Get(ctx, "cLoanList", countLoan+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return loanList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	l.log.Debug(ctx, fmt.Sprintf("success get loan list with body: %v", param))
+
+	return loanList, &pg, nil
+}
+
+func (l *loan) createSQL(ctx context.Context, inputParam entity.LoanInputParam) (entity.Loan, error) {
+	loan := entity.Loan{}
+
+	l.log.Debug(ctx, fmt.Sprintf("create loan with body: %v", inputParam))
+
+	stmt, err := l.db.PrepareNamed(ctx, "iNewLoan", insertLoan)
+	if err != nil {
+		return loan, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&loan, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return loan, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return loan, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success create loan with body: %v", inputParam))
+
+	return loan, nil
+}
+
+func (l *loan) createManySQL(ctx context.Context, inputParams []entity.LoanInputParam) error {
+	l.log.Debug(ctx, fmt.Sprintf("create many loan with body: %v", inputParams))
+
+	res, err := l.db.NamedExec(ctx, "iManyLoan", insertManyLoan, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no loan created")
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success create many loan with body: %v", inputParams))
+
+	return nil
+}
+
+func (l *loan) updateSQL(ctx context.Context, updateParam entity.LoanUpdateParam, selectParam entity.LoanParam) error {
+	l.log.Debug(ctx, fmt.Sprintf("update loan with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(l.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(entity.SQLUpdateParam(updateParam), &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := l.db.Exec(ctx, "uLoan", updateLoan+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no loan updated")
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success update loan with body: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package loan
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	libsql "github.com/reyhanmichiels/go-pkg/v2/sql"
+	mock_log "github.com/reyhanmichiels/go-pkg/v2/tests/mock/log"
+	mock_parser "github.com/reyhanmichiels/go-pkg/v2/tests/mock/parser"
+	mock_redis "github.com/reyhanmichiels/go-pkg/v2/tests/mock/redis"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_loan_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mock_log.NewMockInterface(ctrl)
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRedis := mock_redis.NewMockInterface(ctrl)
+	mockJson := mock_parser.NewMockJSONInterface(ctrl)
+
+	ctx := context.Background()
+	mockTime := time.Now()
+
+	// the last instalment pays the loan off, the zero balance has to be written too
+	mockUpdateParam := entity.LoanUpdateParam{
+		RemainingBalance: entity.NullMoneyFrom(0),
+		LoanStatus:       entity.LoanStatusPaidOff,
+		UpdatedAt:        null.TimeFrom(mockTime),
+		UpdatedBy:        null.Int64From(1),
+	}
+
+	mockSelectParam := entity.LoanParam{
+		ID: 3,
+	}
+
+	query := regexp.QuoteMeta(updateLoan + " SET remaining_balance=?, loan_status=?, updated_at=?, updated_by=? WHERE 1=1 AND id=?;")
+	mockArgs := []driver.Value{"0.00", entity.LoanStatusPaidOff, mockTime, int64(1), int64(3)}
+
+	tests := []struct {
+		name        string
+		updateParam entity.LoanUpdateParam
+		prepSqlMock func() (*sql.DB, error)
+		mockFunc    func()
+		wantErr     bool
+	}{
+		{
+			name:        "success",
+			updateParam: mockUpdateParam,
+			prepSqlMock: func() (*sql.DB, error) {
+				sqlServer, sqlMock, err := sqlmock.New()
+
+				sqlMock.ExpectExec(query).WithArgs(mockArgs...).WillReturnResult(driver.RowsAffected(1))
+
+				return sqlServer, err
+			},
+			mockFunc: func() {
+				mockRedis.EXPECT().Del(ctx, deleteLoanKeysPattern).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success without remaining balance",
+			updateParam: entity.LoanUpdateParam{
+				LoanStatus: entity.LoanStatusActive,
+				UpdatedAt:  null.TimeFrom(mockTime),
+				UpdatedBy:  null.Int64From(1),
+			},
+			prepSqlMock: func() (*sql.DB, error) {
+				sqlServer, sqlMock, err := sqlmock.New()
+
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateLoan+" SET loan_status=?, updated_at=?, updated_by=? WHERE 1=1 AND id=?;")).
+					WithArgs(entity.LoanStatusActive, mockTime, int64(1), int64(3)).
+					WillReturnResult(driver.RowsAffected(1))
+
+				return sqlServer, err
+			},
+			mockFunc: func() {
+				mockRedis.EXPECT().Del(ctx, deleteLoanKeysPattern).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "failed to exec",
+			updateParam: mockUpdateParam,
+			prepSqlMock: func() (*sql.DB, error) {
+				sqlServer, sqlMock, err := sqlmock.New()
+
+				sqlMock.ExpectExec(query).WithArgs(mockArgs...).WillReturnError(assert.AnError)
+
+				return sqlServer, err
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:        "no rows affected",
+			updateParam: mockUpdateParam,
+			prepSqlMock: func() (*sql.DB, error) {
+				sqlServer, sqlMock, err := sqlmock.New()
+
+				sqlMock.ExpectExec(query).WithArgs(mockArgs...).WillReturnResult(driver.RowsAffected(0))
+
+				return sqlServer, err
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+			sqlServer, err := tt.prepSqlMock()
+			if err != nil {
+				t.Error(err)
+			}
+			defer sqlServer.Close()
+
+			sqlClient := libsql.Init(libsql.Config{
+				Driver: "sqlmock",
+				Leader: libsql.ConnConfig{
+					MockDB: sqlServer,
+				},
+				Follower: libsql.ConnConfig{
+					MockDB: sqlServer,
+				},
+			}, logger)
+
+			d := Init(InitParam{Db: sqlClient, Log: logger, Redis: mockRedis, Json: mockJson})
+			err = d.Update(ctx, tt.updateParam, mockSelectParam)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Loan.Update() err %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package loan_repayment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.LoanRepaymentParam) (entity.LoanRepayment, error)
+	GetList(ctx context.Context, param entity.LoanRepaymentParam) ([]entity.LoanRepayment, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.LoanRepaymentInputParam) (entity.LoanRepayment, error)
+	CreateMany(ctx context.Context, inputParams []entity.LoanRepaymentInputParam) error
+	Update(ctx context.Context, updateParam entity.LoanRepaymentUpdateParam, selectParam entity.LoanRepaymentParam) error
+}
+
+type loanRepayment struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &loanRepayment{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (l *loanRepayment) Get(ctx context.Context, param entity.LoanRepaymentParam) (entity.LoanRepayment, error) {
+	loanRepayment := entity.LoanRepayment{}
+
+	marshalledParam, err := l.json.Marshal(param)
+	if err != nil {
+		return loanRepayment, err
+	}
+
+	if !param.BypassCache {
+		loanRepayment, err = l.getCache(ctx, fmt.Sprintf(getLoanRepaymentByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return loanRepayment, nil
+		}
+	}
+
+	loanRepayment, err = l.getSQL(ctx, param)
+	if err != nil {
+		return loanRepayment, err
+	}
+
+	err = l.upsertCache(ctx, fmt.Sprintf(getLoanRepaymentByKey, string(marshalledParam)), loanRepayment, l.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return loanRepayment, nil
+}
+
+func (l *loanRepayment) GetList(ctx context.Context, param entity.LoanRepaymentParam) ([]entity.LoanRepayment, *entity.Pagination, error) {
+	if !param.BypassCache {
+		loanRepaymentList, pg, err := l.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			l.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return loanRepaymentList, &pg, nil
+		}
+	}
+
+	loanRepaymentList, pg, err := l.getListSQL(ctx, param)
+	if err != nil {
+		return loanRepaymentList, pg, err
+	}
+
+	err = l.upsertCacheList(ctx, param, loanRepaymentList, *pg, l.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return loanRepaymentList, pg, nil
+}
+
+func (l *loanRepayment) Create(ctx context.Context, param entity.LoanRepaymentInputParam) (entity.LoanRepayment, error) {
+	loanRepayment, err := l.createSQL(ctx, param)
+	if err != nil {
+		return loanRepayment, err
+	}
+
+	err = l.deleteCache(ctx, deleteLoanRepaymentKeysPattern)
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return loanRepayment, nil
+}
+
+func (l *loanRepayment) CreateMany(ctx context.Context, inputParams []entity.LoanRepaymentInputParam) error {
+	err := l.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = l.deleteCache(ctx, deleteLoanRepaymentKeysPattern)
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (l *loanRepayment) Update(ctx context.Context, updateParam entity.LoanRepaymentUpdateParam, selectParam entity.LoanRepaymentParam) error {
+	err := l.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = l.deleteCache(ctx, deleteLoanRepaymentKeysPattern)
+	if err != nil {
+		l.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
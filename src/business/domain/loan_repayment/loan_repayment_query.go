@@ -0,0 +1,86 @@
+package loan_repayment
+
+const (
+	insertLoanRepayment = `
+		INSERT INTO loan_repayments (
+			fk_loan_id,
+			fk_user_id,
+			fk_payroll_run_id,
+			fk_payslip_id,
+			amount,
+			currency,
+			repayment_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_loan_id,
+			:fk_user_id,
+			:fk_payroll_run_id,
+			:fk_payslip_id,
+			:amount,
+			:currency,
+			:repayment_status,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyLoanRepayment = `
+		INSERT INTO loan_repayments (
+			fk_loan_id,
+			fk_user_id,
+			fk_payroll_run_id,
+			fk_payslip_id,
+			amount,
+			currency,
+			repayment_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_loan_id,
+			:fk_user_id,
+			:fk_payroll_run_id,
+			:fk_payslip_id,
+			:amount,
+			:currency,
+			:repayment_status,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readLoanRepayment = `
+		SELECT
+			id,
+			fk_loan_id,
+			fk_user_id,
+			fk_payroll_run_id,
+			fk_payslip_id,
+			amount,
+			currency,
+			repayment_status,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			loan_repayments
+	`
+
+	countLoanRepayment = `
+		SELECT
+			COUNT(*)
+		FROM
+			loan_repayments
+	`
+
+	updateLoanRepayment = `
+		UPDATE
+			loan_repayments
+	`
+)
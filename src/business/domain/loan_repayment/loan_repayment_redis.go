@@ -0,0 +1,123 @@
+package loan_repayment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getLoanRepaymentByKey           = "employeePayroll:loanRepayment:get:%s"
+	getLoanRepaymentByQueryKey      = "employeePayroll:loanRepayment:get:q:%s"
+	getLoanRepaymentByPaginationKey = "employeePayroll:loanRepayment:get:p:%s"
+	deleteLoanRepaymentKeysPattern  = "employeePayroll:loanRepayment*"
+)
+
+func (l *loanRepayment) upsertCache(ctx context.Context, key string, loanRepayment entity.LoanRepayment, ttl time.Duration) error {
+	marshalledLoanRepayment, err := l.json.Marshal(loanRepayment)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = l.redis.SetEX(ctx, key, string(marshalledLoanRepayment), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (l *loanRepayment) getCache(ctx context.Context, key string) (entity.LoanRepayment, error) {
+	loanRepayment := entity.LoanRepayment{}
+
+	marshalledLoanRepayment, err := l.redis.Get(ctx, key)
+	if err != nil {
+		return loanRepayment, err
+	}
+
+	err = l.json.Unmarshal([]byte(marshalledLoanRepayment), &loanRepayment)
+	if err != nil {
+		return loanRepayment, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return loanRepayment, nil
+}
+
+func (l *loanRepayment) upsertCacheList(ctx context.Context, param entity.LoanRepaymentParam, loanRepaymentList []entity.LoanRepayment, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := l.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set loanRepayment list to cache
+	marshalledLoanRepaymentList, err := l.json.Marshal(loanRepaymentList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = l.redis.SetEX(ctx, fmt.Sprintf(getLoanRepaymentByQueryKey, string(keyValue)), string(marshalledLoanRepaymentList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := l.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = l.redis.SetEX(ctx, fmt.Sprintf(getLoanRepaymentByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (l *loanRepayment) getCacheList(ctx context.Context, param entity.LoanRepaymentParam) ([]entity.LoanRepayment, entity.Pagination, error) {
+	var (
+		loanRepaymentList = []entity.LoanRepayment{}
+		pg                = entity.Pagination{}
+	)
+
+	keyValue, err := l.json.Marshal(param)
+	if err != nil {
+		return loanRepaymentList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get loanRepayment list from redis
+	marshalledLoanRepaymentList, err := l.redis.Get(ctx, fmt.Sprintf(getLoanRepaymentByQueryKey, string(keyValue)))
+	if err != nil {
+		return loanRepaymentList, pg, err
+	}
+
+	err = l.json.Unmarshal([]byte(marshalledLoanRepaymentList), &loanRepaymentList)
+	if err != nil {
+		return loanRepaymentList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := l.redis.Get(ctx, fmt.Sprintf(getLoanRepaymentByPaginationKey, string(keyValue)))
+	if err != nil {
+		return loanRepaymentList, pg, err
+	}
+
+	err = l.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return loanRepaymentList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return loanRepaymentList, pg, nil
+}
+
+func (l *loanRepayment) deleteCache(ctx context.Context, key string) error {
+	err := l.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
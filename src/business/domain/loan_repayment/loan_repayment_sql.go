@@ -0,0 +1,169 @@
+package loan_repayment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (l *loanRepayment) getSQL(ctx context.Context, param entity.LoanRepaymentParam) (entity.LoanRepayment, error) {
+	loanRepayment := entity.LoanRepayment{}
+
+	l.log.Debug(ctx, fmt.Sprintf("get loan repayment with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(l.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return loanRepayment, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := l.db.QueryRow(ctx, "rLoanRepayment", readLoanRepayment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return loanRepayment, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&loanRepayment); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return loanRepayment, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return loanRepayment, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success get loan repayment with body: %v", param))
+
+	return loanRepayment, nil
+}
+
+func (l *loanRepayment) getListSQL(ctx context.Context, param entity.LoanRepaymentParam) ([]entity.LoanRepayment, *entity.Pagination, error) {
+	loanRepaymentList := []entity.LoanRepayment{}
+	pg := entity.Pagination{}
+
+	l.log.Debug(ctx, fmt.Sprintf("get loan repayment list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(l.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return loanRepaymentList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := l.db.Query(ctx, "rLoanRepaymentList", readLoanRepayment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return loanRepaymentList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		loanRepayment := entity.LoanRepayment{}
+		err := rows.StructScan(&loanRepayment)
+		if err != nil {
+			l.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		loanRepaymentList = append(loanRepaymentList, loanRepayment)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(loanRepaymentList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(loanRepaymentList) > 0 {
+		err := l.db.Get(ctx, "cLoanRepaymentList", countLoanRepayment+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return loanRepaymentList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	l.log.Debug(ctx, fmt.Sprintf("success get loan repayment list with body: %v", param))
+
+	return loanRepaymentList, &pg, nil
+}
+
+func (l *loanRepayment) createSQL(ctx context.Context, inputParam entity.LoanRepaymentInputParam) (entity.LoanRepayment, error) {
+	loanRepayment := entity.LoanRepayment{}
+
+	l.log.Debug(ctx, fmt.Sprintf("create loan repayment with body: %v", inputParam))
+
+	stmt, err := l.db.PrepareNamed(ctx, "iNewLoanRepayment", insertLoanRepayment)
+	if err != nil {
+		return loanRepayment, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&loanRepayment, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return loanRepayment, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return loanRepayment, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success create loan repayment with body: %v", inputParam))
+
+	return loanRepayment, nil
+}
+
+func (l *loanRepayment) createManySQL(ctx context.Context, inputParams []entity.LoanRepaymentInputParam) error {
+	l.log.Debug(ctx, fmt.Sprintf("create many loan repayment with body: %v", inputParams))
+
+	res, err := l.db.NamedExec(ctx, "iManyLoanRepayment", insertManyLoanRepayment, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no loan repayment created")
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success create many loan repayment with body: %v", inputParams))
+
+	return nil
+}
+
+func (l *loanRepayment) updateSQL(ctx context.Context, updateParam entity.LoanRepaymentUpdateParam, selectParam entity.LoanRepaymentParam) error {
+	l.log.Debug(ctx, fmt.Sprintf("update loan repayment with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(l.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := l.db.Exec(ctx, "uLoanRepayment", updateLoanRepayment+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no loan repayment updated")
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("success update loan repayment with body: %v", updateParam))
+
+	return nil
+}
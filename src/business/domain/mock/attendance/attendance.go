@@ -70,6 +70,20 @@ func (mr *MockInterfaceMockRecorder) Create(ctx, param any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInterface)(nil).Create), ctx, param)
 }
 
+// CreateMany mocks base method.
+func (m *MockInterface) CreateMany(ctx context.Context, inputParams []entity.AttendanceInputParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", ctx, inputParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockInterfaceMockRecorder) CreateMany(ctx, inputParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockInterface)(nil).CreateMany), ctx, inputParams)
+}
+
 // Get mocks base method.
 func (m *MockInterface) Get(ctx context.Context, param entity.AttendanceParam) (entity.Attendance, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,145 @@
+package office_location
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.OfficeLocationParam) (entity.OfficeLocation, error)
+	GetList(ctx context.Context, param entity.OfficeLocationParam) ([]entity.OfficeLocation, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.OfficeLocationInputParam) (entity.OfficeLocation, error)
+	CreateMany(ctx context.Context, inputParams []entity.OfficeLocationInputParam) error
+	Update(ctx context.Context, updateParam entity.OfficeLocationUpdateParam, selectParam entity.OfficeLocationParam) error
+}
+
+type officeLocation struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &officeLocation{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (o *officeLocation) Get(ctx context.Context, param entity.OfficeLocationParam) (entity.OfficeLocation, error) {
+	officeLocation := entity.OfficeLocation{}
+
+	marshalledParam, err := o.json.Marshal(param)
+	if err != nil {
+		return officeLocation, err
+	}
+
+	if !param.BypassCache {
+		officeLocation, err = o.getCache(ctx, fmt.Sprintf(getOfficeLocationByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			o.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			o.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return officeLocation, nil
+		}
+	}
+
+	officeLocation, err = o.getSQL(ctx, param)
+	if err != nil {
+		return officeLocation, err
+	}
+
+	err = o.upsertCache(ctx, fmt.Sprintf(getOfficeLocationByKey, string(marshalledParam)), officeLocation, o.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		o.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return officeLocation, nil
+}
+
+func (o *officeLocation) GetList(ctx context.Context, param entity.OfficeLocationParam) ([]entity.OfficeLocation, *entity.Pagination, error) {
+	if !param.BypassCache {
+		officeLocationList, pg, err := o.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			o.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			o.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return officeLocationList, &pg, nil
+		}
+	}
+
+	officeLocationList, pg, err := o.getListSQL(ctx, param)
+	if err != nil {
+		return officeLocationList, pg, err
+	}
+
+	err = o.upsertCacheList(ctx, param, officeLocationList, *pg, o.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		o.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return officeLocationList, pg, nil
+}
+
+func (o *officeLocation) Create(ctx context.Context, param entity.OfficeLocationInputParam) (entity.OfficeLocation, error) {
+	officeLocation, err := o.createSQL(ctx, param)
+	if err != nil {
+		return officeLocation, err
+	}
+
+	err = o.deleteCache(ctx, deleteOfficeLocationKeysPattern)
+	if err != nil {
+		o.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return officeLocation, nil
+}
+
+func (o *officeLocation) CreateMany(ctx context.Context, inputParams []entity.OfficeLocationInputParam) error {
+	err := o.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = o.deleteCache(ctx, deleteOfficeLocationKeysPattern)
+	if err != nil {
+		o.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (o *officeLocation) Update(ctx context.Context, updateParam entity.OfficeLocationUpdateParam, selectParam entity.OfficeLocationParam) error {
+	err := o.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = o.deleteCache(ctx, deleteOfficeLocationKeysPattern)
+	if err != nil {
+		o.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
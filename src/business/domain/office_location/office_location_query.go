@@ -0,0 +1,71 @@
+package office_location
+
+const (
+	insertOfficeLocation = `
+		INSERT INTO office_locations (
+			name,
+			latitude,
+			longitude,
+			radius_meters,
+			created_at,
+			created_by
+		) VALUES (
+			:name,
+			:latitude,
+			:longitude,
+			:radius_meters,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyOfficeLocation = `
+		INSERT INTO office_locations (
+			name,
+			latitude,
+			longitude,
+			radius_meters,
+			created_at,
+			created_by
+		) VALUES (
+			:name,
+			:latitude,
+			:longitude,
+			:radius_meters,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readOfficeLocation = `
+		SELECT
+			id,
+			name,
+			latitude,
+			longitude,
+			radius_meters,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			office_locations
+	`
+
+	countOfficeLocation = `
+		SELECT
+			COUNT(*)
+		FROM
+			office_locations
+	`
+
+	updateOfficeLocation = `
+		UPDATE
+			office_locations
+	`
+)
@@ -0,0 +1,123 @@
+package office_location
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getOfficeLocationByKey           = "employeePayroll:officeLocation:get:%s"
+	getOfficeLocationByQueryKey      = "employeePayroll:officeLocation:get:q:%s"
+	getOfficeLocationByPaginationKey = "employeePayroll:officeLocation:get:p:%s"
+	deleteOfficeLocationKeysPattern  = "employeePayroll:officeLocation*"
+)
+
+func (o *officeLocation) upsertCache(ctx context.Context, key string, officeLocation entity.OfficeLocation, ttl time.Duration) error {
+	marshalledOfficeLocation, err := o.json.Marshal(officeLocation)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = o.redis.SetEX(ctx, key, string(marshalledOfficeLocation), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (o *officeLocation) getCache(ctx context.Context, key string) (entity.OfficeLocation, error) {
+	officeLocation := entity.OfficeLocation{}
+
+	marshalledOfficeLocation, err := o.redis.Get(ctx, key)
+	if err != nil {
+		return officeLocation, err
+	}
+
+	err = o.json.Unmarshal([]byte(marshalledOfficeLocation), &officeLocation)
+	if err != nil {
+		return officeLocation, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return officeLocation, nil
+}
+
+func (o *officeLocation) upsertCacheList(ctx context.Context, param entity.OfficeLocationParam, officeLocationList []entity.OfficeLocation, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := o.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set officeLocation list to cache
+	marshalledOfficeLocationList, err := o.json.Marshal(officeLocationList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = o.redis.SetEX(ctx, fmt.Sprintf(getOfficeLocationByQueryKey, string(keyValue)), string(marshalledOfficeLocationList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := o.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = o.redis.SetEX(ctx, fmt.Sprintf(getOfficeLocationByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (o *officeLocation) getCacheList(ctx context.Context, param entity.OfficeLocationParam) ([]entity.OfficeLocation, entity.Pagination, error) {
+	var (
+		officeLocationList = []entity.OfficeLocation{}
+		pg                 = entity.Pagination{}
+	)
+
+	keyValue, err := o.json.Marshal(param)
+	if err != nil {
+		return officeLocationList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get officeLocation list from redis
+	marshalledOfficeLocationList, err := o.redis.Get(ctx, fmt.Sprintf(getOfficeLocationByQueryKey, string(keyValue)))
+	if err != nil {
+		return officeLocationList, pg, err
+	}
+
+	err = o.json.Unmarshal([]byte(marshalledOfficeLocationList), &officeLocationList)
+	if err != nil {
+		return officeLocationList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := o.redis.Get(ctx, fmt.Sprintf(getOfficeLocationByPaginationKey, string(keyValue)))
+	if err != nil {
+		return officeLocationList, pg, err
+	}
+
+	err = o.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return officeLocationList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return officeLocationList, pg, nil
+}
+
+func (o *officeLocation) deleteCache(ctx context.Context, key string) error {
+	err := o.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
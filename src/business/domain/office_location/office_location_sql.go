@@ -0,0 +1,169 @@
+package office_location
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (o *officeLocation) getSQL(ctx context.Context, param entity.OfficeLocationParam) (entity.OfficeLocation, error) {
+	officeLocation := entity.OfficeLocation{}
+
+	o.log.Debug(ctx, fmt.Sprintf("get office location with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(o.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return officeLocation, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := o.db.QueryRow(ctx, "rOfficeLocation", readOfficeLocation+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return officeLocation, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&officeLocation); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return officeLocation, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return officeLocation, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	o.log.Debug(ctx, fmt.Sprintf("success get office location with body: %v", param))
+
+	return officeLocation, nil
+}
+
+func (o *officeLocation) getListSQL(ctx context.Context, param entity.OfficeLocationParam) ([]entity.OfficeLocation, *entity.Pagination, error) {
+	officeLocationList := []entity.OfficeLocation{}
+	pg := entity.Pagination{}
+
+	o.log.Debug(ctx, fmt.Sprintf("get office location list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(o.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return officeLocationList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := o.db.Query(ctx, "rOfficeLocationList", readOfficeLocation+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return officeLocationList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		officeLocation := entity.OfficeLocation{}
+		err := rows.StructScan(&officeLocation)
+		if err != nil {
+			o.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		officeLocationList = append(officeLocationList, officeLocation)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(officeLocationList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(officeLocationList) > 0 {
+		err := o.db.Get(ctx, "cOfficeLocationList", countOfficeLocation+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return officeLocationList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	o.log.Debug(ctx, fmt.Sprintf("success get office location list with body: %v", param))
+
+	return officeLocationList, &pg, nil
+}
+
+func (o *officeLocation) createSQL(ctx context.Context, inputParam entity.OfficeLocationInputParam) (entity.OfficeLocation, error) {
+	officeLocation := entity.OfficeLocation{}
+
+	o.log.Debug(ctx, fmt.Sprintf("create office location with body: %v", inputParam))
+
+	stmt, err := o.db.PrepareNamed(ctx, "iNewOfficeLocation", insertOfficeLocation)
+	if err != nil {
+		return officeLocation, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&officeLocation, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return officeLocation, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return officeLocation, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	o.log.Debug(ctx, fmt.Sprintf("success create office location with body: %v", inputParam))
+
+	return officeLocation, nil
+}
+
+func (o *officeLocation) createManySQL(ctx context.Context, inputParams []entity.OfficeLocationInputParam) error {
+	o.log.Debug(ctx, fmt.Sprintf("create many office location with body: %v", inputParams))
+
+	res, err := o.db.NamedExec(ctx, "iManyOfficeLocation", insertManyOfficeLocation, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no office location created")
+	}
+
+	o.log.Debug(ctx, fmt.Sprintf("success create many office location with body: %v", inputParams))
+
+	return nil
+}
+
+func (o *officeLocation) updateSQL(ctx context.Context, updateParam entity.OfficeLocationUpdateParam, selectParam entity.OfficeLocationParam) error {
+	o.log.Debug(ctx, fmt.Sprintf("update office location with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(o.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := o.db.Exec(ctx, "uOfficeLocation", updateOfficeLocation+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no office location updated")
+	}
+
+	o.log.Debug(ctx, fmt.Sprintf("success update office location with body: %v", updateParam))
+
+	return nil
+}
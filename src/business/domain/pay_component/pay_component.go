@@ -0,0 +1,145 @@
+package pay_component
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.PayComponentParam) (entity.PayComponent, error)
+	GetList(ctx context.Context, param entity.PayComponentParam) ([]entity.PayComponent, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.PayComponentInputParam) (entity.PayComponent, error)
+	CreateMany(ctx context.Context, inputParams []entity.PayComponentInputParam) error
+	Update(ctx context.Context, updateParam entity.PayComponentUpdateParam, selectParam entity.PayComponentParam) error
+}
+
+type payComponent struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &payComponent{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (p *payComponent) Get(ctx context.Context, param entity.PayComponentParam) (entity.PayComponent, error) {
+	payComponent := entity.PayComponent{}
+
+	marshalledParam, err := p.json.Marshal(param)
+	if err != nil {
+		return payComponent, err
+	}
+
+	if !param.BypassCache {
+		payComponent, err = p.getCache(ctx, fmt.Sprintf(getPayComponentByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payComponent, nil
+		}
+	}
+
+	payComponent, err = p.getSQL(ctx, param)
+	if err != nil {
+		return payComponent, err
+	}
+
+	err = p.upsertCache(ctx, fmt.Sprintf(getPayComponentByKey, string(marshalledParam)), payComponent, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payComponent, nil
+}
+
+func (p *payComponent) GetList(ctx context.Context, param entity.PayComponentParam) ([]entity.PayComponent, *entity.Pagination, error) {
+	if !param.BypassCache {
+		payComponentList, pg, err := p.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payComponentList, &pg, nil
+		}
+	}
+
+	payComponentList, pg, err := p.getListSQL(ctx, param)
+	if err != nil {
+		return payComponentList, pg, err
+	}
+
+	err = p.upsertCacheList(ctx, param, payComponentList, *pg, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payComponentList, pg, nil
+}
+
+func (p *payComponent) Create(ctx context.Context, param entity.PayComponentInputParam) (entity.PayComponent, error) {
+	payComponent, err := p.createSQL(ctx, param)
+	if err != nil {
+		return payComponent, err
+	}
+
+	err = p.deleteCache(ctx, deletePayComponentKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payComponent, nil
+}
+
+func (p *payComponent) CreateMany(ctx context.Context, inputParams []entity.PayComponentInputParam) error {
+	err := p.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayComponentKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (p *payComponent) Update(ctx context.Context, updateParam entity.PayComponentUpdateParam, selectParam entity.PayComponentParam) error {
+	err := p.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayComponentKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
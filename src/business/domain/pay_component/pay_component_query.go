@@ -0,0 +1,76 @@
+package pay_component
+
+const (
+	insertPayComponent = `
+		INSERT INTO pay_components (
+			code,
+			name,
+			item_type,
+			evaluation_order,
+			config,
+			created_at,
+			created_by
+		) VALUES (
+			:code,
+			:name,
+			:item_type,
+			:evaluation_order,
+			:config,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyPayComponent = `
+		INSERT INTO pay_components (
+			code,
+			name,
+			item_type,
+			evaluation_order,
+			config,
+			created_at,
+			created_by
+		) VALUES (
+			:code,
+			:name,
+			:item_type,
+			:evaluation_order,
+			:config,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readPayComponent = `
+		SELECT
+			id,
+			code,
+			name,
+			item_type,
+			evaluation_order,
+			config,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			pay_components
+	`
+
+	countPayComponent = `
+		SELECT
+			COUNT(*)
+		FROM
+			pay_components
+	`
+
+	updatePayComponent = `
+		UPDATE
+			pay_components
+	`
+)
@@ -0,0 +1,123 @@
+package pay_component
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getPayComponentByKey           = "employeePayroll:payComponent:get:%s"
+	getPayComponentByQueryKey      = "employeePayroll:payComponent:get:q:%s"
+	getPayComponentByPaginationKey = "employeePayroll:payComponent:get:p:%s"
+	deletePayComponentKeysPattern  = "employeePayroll:payComponent*"
+)
+
+func (p *payComponent) upsertCache(ctx context.Context, key string, payComponent entity.PayComponent, ttl time.Duration) error {
+	marshalledPayComponent, err := p.json.Marshal(payComponent)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, key, string(marshalledPayComponent), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payComponent) getCache(ctx context.Context, key string) (entity.PayComponent, error) {
+	payComponent := entity.PayComponent{}
+
+	marshalledPayComponent, err := p.redis.Get(ctx, key)
+	if err != nil {
+		return payComponent, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayComponent), &payComponent)
+	if err != nil {
+		return payComponent, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payComponent, nil
+}
+
+func (p *payComponent) upsertCacheList(ctx context.Context, param entity.PayComponentParam, payComponentList []entity.PayComponent, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set payComponent list to cache
+	marshalledPayComponentList, err := p.json.Marshal(payComponentList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayComponentByQueryKey, string(keyValue)), string(marshalledPayComponentList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := p.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayComponentByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payComponent) getCacheList(ctx context.Context, param entity.PayComponentParam) ([]entity.PayComponent, entity.Pagination, error) {
+	var (
+		payComponentList = []entity.PayComponent{}
+		pg               = entity.Pagination{}
+	)
+
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return payComponentList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get payComponent list from redis
+	marshalledPayComponentList, err := p.redis.Get(ctx, fmt.Sprintf(getPayComponentByQueryKey, string(keyValue)))
+	if err != nil {
+		return payComponentList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayComponentList), &payComponentList)
+	if err != nil {
+		return payComponentList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := p.redis.Get(ctx, fmt.Sprintf(getPayComponentByPaginationKey, string(keyValue)))
+	if err != nil {
+		return payComponentList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return payComponentList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payComponentList, pg, nil
+}
+
+func (p *payComponent) deleteCache(ctx context.Context, key string) error {
+	err := p.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
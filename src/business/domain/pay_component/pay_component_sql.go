@@ -0,0 +1,169 @@
+package pay_component
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (p *payComponent) getSQL(ctx context.Context, param entity.PayComponentParam) (entity.PayComponent, error) {
+	payComponent := entity.PayComponent{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get pay component with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return payComponent, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := p.db.QueryRow(ctx, "rPayComponent", readPayComponent+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payComponent, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&payComponent); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return payComponent, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return payComponent, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success get pay component with body: %v", param))
+
+	return payComponent, nil
+}
+
+func (p *payComponent) getListSQL(ctx context.Context, param entity.PayComponentParam) ([]entity.PayComponent, *entity.Pagination, error) {
+	payComponentList := []entity.PayComponent{}
+	pg := entity.Pagination{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get pay component list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return payComponentList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := p.db.Query(ctx, "rPayComponentList", readPayComponent+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payComponentList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		payComponent := entity.PayComponent{}
+		err := rows.StructScan(&payComponent)
+		if err != nil {
+			p.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		payComponentList = append(payComponentList, payComponent)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(payComponentList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(payComponentList) > 0 {
+		err := p.db.Get(ctx, "cPayComponentList", countPayComponent+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return payComponentList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	p.log.Debug(ctx, fmt.Sprintf("success get pay component list with body: %v", param))
+
+	return payComponentList, &pg, nil
+}
+
+func (p *payComponent) createSQL(ctx context.Context, inputParam entity.PayComponentInputParam) (entity.PayComponent, error) {
+	payComponent := entity.PayComponent{}
+
+	p.log.Debug(ctx, fmt.Sprintf("create pay component with body: %v", inputParam))
+
+	stmt, err := p.db.PrepareNamed(ctx, "iNewPayComponent", insertPayComponent)
+	if err != nil {
+		return payComponent, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&payComponent, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return payComponent, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return payComponent, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create pay component with body: %v", inputParam))
+
+	return payComponent, nil
+}
+
+func (p *payComponent) createManySQL(ctx context.Context, inputParams []entity.PayComponentInputParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("create many pay component with body: %v", inputParams))
+
+	res, err := p.db.NamedExec(ctx, "iManyPayComponent", insertManyPayComponent, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no pay component created")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create many pay component with body: %v", inputParams))
+
+	return nil
+}
+
+func (p *payComponent) updateSQL(ctx context.Context, updateParam entity.PayComponentUpdateParam, selectParam entity.PayComponentParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("update pay component with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := p.db.Exec(ctx, "uPayComponent", updatePayComponent+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no pay component updated")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success update pay component with body: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package payroll_adjustment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.PayrollAdjustmentParam) (entity.PayrollAdjustment, error)
+	GetList(ctx context.Context, param entity.PayrollAdjustmentParam) ([]entity.PayrollAdjustment, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.PayrollAdjustmentInputParam) (entity.PayrollAdjustment, error)
+	CreateMany(ctx context.Context, inputParams []entity.PayrollAdjustmentInputParam) error
+	Update(ctx context.Context, updateParam entity.PayrollAdjustmentUpdateParam, selectParam entity.PayrollAdjustmentParam) error
+}
+
+type payrollAdjustment struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &payrollAdjustment{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (p *payrollAdjustment) Get(ctx context.Context, param entity.PayrollAdjustmentParam) (entity.PayrollAdjustment, error) {
+	payrollAdjustment := entity.PayrollAdjustment{}
+
+	marshalledParam, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollAdjustment, err
+	}
+
+	if !param.BypassCache {
+		payrollAdjustment, err = p.getCache(ctx, fmt.Sprintf(getPayrollAdjustmentByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollAdjustment, nil
+		}
+	}
+
+	payrollAdjustment, err = p.getSQL(ctx, param)
+	if err != nil {
+		return payrollAdjustment, err
+	}
+
+	err = p.upsertCache(ctx, fmt.Sprintf(getPayrollAdjustmentByKey, string(marshalledParam)), payrollAdjustment, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollAdjustment, nil
+}
+
+func (p *payrollAdjustment) GetList(ctx context.Context, param entity.PayrollAdjustmentParam) ([]entity.PayrollAdjustment, *entity.Pagination, error) {
+	if !param.BypassCache {
+		payrollAdjustmentList, pg, err := p.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollAdjustmentList, &pg, nil
+		}
+	}
+
+	payrollAdjustmentList, pg, err := p.getListSQL(ctx, param)
+	if err != nil {
+		return payrollAdjustmentList, pg, err
+	}
+
+	err = p.upsertCacheList(ctx, param, payrollAdjustmentList, *pg, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollAdjustmentList, pg, nil
+}
+
+func (p *payrollAdjustment) Create(ctx context.Context, param entity.PayrollAdjustmentInputParam) (entity.PayrollAdjustment, error) {
+	payrollAdjustment, err := p.createSQL(ctx, param)
+	if err != nil {
+		return payrollAdjustment, err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollAdjustmentKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollAdjustment, nil
+}
+
+func (p *payrollAdjustment) CreateMany(ctx context.Context, inputParams []entity.PayrollAdjustmentInputParam) error {
+	err := p.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollAdjustmentKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (p *payrollAdjustment) Update(ctx context.Context, updateParam entity.PayrollAdjustmentUpdateParam, selectParam entity.PayrollAdjustmentParam) error {
+	err := p.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollAdjustmentKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package payroll_adjustment
+
+const (
+	insertPayrollAdjustment = `
+		INSERT INTO payroll_adjustments (
+			fk_attendance_period_id,
+			fk_user_id,
+			adjustment_type,
+			amount,
+			currency,
+			reason,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:fk_user_id,
+			:adjustment_type,
+			:amount,
+			:currency,
+			:reason,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyPayrollAdjustment = `
+		INSERT INTO payroll_adjustments (
+			fk_attendance_period_id,
+			fk_user_id,
+			adjustment_type,
+			amount,
+			currency,
+			reason,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:fk_user_id,
+			:adjustment_type,
+			:amount,
+			:currency,
+			:reason,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readPayrollAdjustment = `
+		SELECT
+			id,
+			fk_attendance_period_id,
+			fk_user_id,
+			adjustment_type,
+			amount,
+			currency,
+			reason,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			payroll_adjustments
+	`
+
+	countPayrollAdjustment = `
+		SELECT
+			COUNT(*)
+		FROM
+			payroll_adjustments
+	`
+
+	updatePayrollAdjustment = `
+		UPDATE
+			payroll_adjustments
+	`
+)
@@ -0,0 +1,123 @@
+package payroll_adjustment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getPayrollAdjustmentByKey           = "employeePayroll:payrollAdjustment:get:%s"
+	getPayrollAdjustmentByQueryKey      = "employeePayroll:payrollAdjustment:get:q:%s"
+	getPayrollAdjustmentByPaginationKey = "employeePayroll:payrollAdjustment:get:p:%s"
+	deletePayrollAdjustmentKeysPattern  = "employeePayroll:payrollAdjustment*"
+)
+
+func (p *payrollAdjustment) upsertCache(ctx context.Context, key string, payrollAdjustment entity.PayrollAdjustment, ttl time.Duration) error {
+	marshalledPayrollAdjustment, err := p.json.Marshal(payrollAdjustment)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, key, string(marshalledPayrollAdjustment), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollAdjustment) getCache(ctx context.Context, key string) (entity.PayrollAdjustment, error) {
+	payrollAdjustment := entity.PayrollAdjustment{}
+
+	marshalledPayrollAdjustment, err := p.redis.Get(ctx, key)
+	if err != nil {
+		return payrollAdjustment, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollAdjustment), &payrollAdjustment)
+	if err != nil {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollAdjustment, nil
+}
+
+func (p *payrollAdjustment) upsertCacheList(ctx context.Context, param entity.PayrollAdjustmentParam, payrollAdjustmentList []entity.PayrollAdjustment, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set payrollAdjustment list to cache
+	marshalledPayrollAdjustmentList, err := p.json.Marshal(payrollAdjustmentList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollAdjustmentByQueryKey, string(keyValue)), string(marshalledPayrollAdjustmentList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := p.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollAdjustmentByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollAdjustment) getCacheList(ctx context.Context, param entity.PayrollAdjustmentParam) ([]entity.PayrollAdjustment, entity.Pagination, error) {
+	var (
+		payrollAdjustmentList = []entity.PayrollAdjustment{}
+		pg                    = entity.Pagination{}
+	)
+
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollAdjustmentList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get payrollAdjustment list from redis
+	marshalledPayrollAdjustmentList, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollAdjustmentByQueryKey, string(keyValue)))
+	if err != nil {
+		return payrollAdjustmentList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollAdjustmentList), &payrollAdjustmentList)
+	if err != nil {
+		return payrollAdjustmentList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollAdjustmentByPaginationKey, string(keyValue)))
+	if err != nil {
+		return payrollAdjustmentList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return payrollAdjustmentList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollAdjustmentList, pg, nil
+}
+
+func (p *payrollAdjustment) deleteCache(ctx context.Context, key string) error {
+	err := p.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
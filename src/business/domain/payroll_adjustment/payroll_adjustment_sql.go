@@ -0,0 +1,169 @@
+package payroll_adjustment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (p *payrollAdjustment) getSQL(ctx context.Context, param entity.PayrollAdjustmentParam) (entity.PayrollAdjustment, error) {
+	payrollAdjustment := entity.PayrollAdjustment{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll adjustment with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := p.db.QueryRow(ctx, "rPayrollAdjustment", readPayrollAdjustment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&payrollAdjustment); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll adjustment with body: %v", param))
+
+	return payrollAdjustment, nil
+}
+
+func (p *payrollAdjustment) getListSQL(ctx context.Context, param entity.PayrollAdjustmentParam) ([]entity.PayrollAdjustment, *entity.Pagination, error) {
+	payrollAdjustmentList := []entity.PayrollAdjustment{}
+	pg := entity.Pagination{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll adjustment list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return payrollAdjustmentList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := p.db.Query(ctx, "rPayrollAdjustmentList", readPayrollAdjustment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollAdjustmentList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		payrollAdjustment := entity.PayrollAdjustment{}
+		err := rows.StructScan(&payrollAdjustment)
+		if err != nil {
+			p.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		payrollAdjustmentList = append(payrollAdjustmentList, payrollAdjustment)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(payrollAdjustmentList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(payrollAdjustmentList) > 0 {
+		err := p.db.Get(ctx, "cPayrollAdjustmentList", countPayrollAdjustment+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return payrollAdjustmentList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll adjustment list with body: %v", param))
+
+	return payrollAdjustmentList, &pg, nil
+}
+
+func (p *payrollAdjustment) createSQL(ctx context.Context, inputParam entity.PayrollAdjustmentInputParam) (entity.PayrollAdjustment, error) {
+	payrollAdjustment := entity.PayrollAdjustment{}
+
+	p.log.Debug(ctx, fmt.Sprintf("create payroll adjustment with body: %v", inputParam))
+
+	stmt, err := p.db.PrepareNamed(ctx, "iNewPayrollAdjustment", insertPayrollAdjustment)
+	if err != nil {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&payrollAdjustment, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return payrollAdjustment, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create payroll adjustment with body: %v", inputParam))
+
+	return payrollAdjustment, nil
+}
+
+func (p *payrollAdjustment) createManySQL(ctx context.Context, inputParams []entity.PayrollAdjustmentInputParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("create many payroll adjustment with body: %v", inputParams))
+
+	res, err := p.db.NamedExec(ctx, "iManyPayrollAdjustment", insertManyPayrollAdjustment, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll adjustment created")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create many payroll adjustment with body: %v", inputParams))
+
+	return nil
+}
+
+func (p *payrollAdjustment) updateSQL(ctx context.Context, updateParam entity.PayrollAdjustmentUpdateParam, selectParam entity.PayrollAdjustmentParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("update payroll adjustment with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := p.db.Exec(ctx, "uPayrollAdjustment", updatePayrollAdjustment+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll adjustment updated")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success update payroll adjustment with body: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package payroll_preview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.PayrollPreviewParam) (entity.PayrollPreview, error)
+	GetList(ctx context.Context, param entity.PayrollPreviewParam) ([]entity.PayrollPreview, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.PayrollPreviewInputParam) (entity.PayrollPreview, error)
+	CreateMany(ctx context.Context, inputParams []entity.PayrollPreviewInputParam) error
+	Update(ctx context.Context, updateParam entity.PayrollPreviewUpdateParam, selectParam entity.PayrollPreviewParam) error
+}
+
+type payrollPreview struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &payrollPreview{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (p *payrollPreview) Get(ctx context.Context, param entity.PayrollPreviewParam) (entity.PayrollPreview, error) {
+	payrollPreview := entity.PayrollPreview{}
+
+	marshalledParam, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollPreview, err
+	}
+
+	if !param.BypassCache {
+		payrollPreview, err = p.getCache(ctx, fmt.Sprintf(getPayrollPreviewByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollPreview, nil
+		}
+	}
+
+	payrollPreview, err = p.getSQL(ctx, param)
+	if err != nil {
+		return payrollPreview, err
+	}
+
+	err = p.upsertCache(ctx, fmt.Sprintf(getPayrollPreviewByKey, string(marshalledParam)), payrollPreview, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollPreview, nil
+}
+
+func (p *payrollPreview) GetList(ctx context.Context, param entity.PayrollPreviewParam) ([]entity.PayrollPreview, *entity.Pagination, error) {
+	if !param.BypassCache {
+		payrollPreviewList, pg, err := p.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollPreviewList, &pg, nil
+		}
+	}
+
+	payrollPreviewList, pg, err := p.getListSQL(ctx, param)
+	if err != nil {
+		return payrollPreviewList, pg, err
+	}
+
+	err = p.upsertCacheList(ctx, param, payrollPreviewList, *pg, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollPreviewList, pg, nil
+}
+
+func (p *payrollPreview) Create(ctx context.Context, param entity.PayrollPreviewInputParam) (entity.PayrollPreview, error) {
+	payrollPreview, err := p.createSQL(ctx, param)
+	if err != nil {
+		return payrollPreview, err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollPreviewKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollPreview, nil
+}
+
+func (p *payrollPreview) CreateMany(ctx context.Context, inputParams []entity.PayrollPreviewInputParam) error {
+	err := p.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollPreviewKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (p *payrollPreview) Update(ctx context.Context, updateParam entity.PayrollPreviewUpdateParam, selectParam entity.PayrollPreviewParam) error {
+	err := p.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollPreviewKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package payroll_preview
+
+const (
+	insertPayrollPreview = `
+		INSERT INTO payroll_previews (
+			fk_attendance_period_id,
+			preview_status,
+			total_employee,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:preview_status,
+			:total_employee,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyPayrollPreview = `
+		INSERT INTO payroll_previews (
+			fk_attendance_period_id,
+			preview_status,
+			total_employee,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:preview_status,
+			:total_employee,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readPayrollPreview = `
+		SELECT
+			id,
+			fk_attendance_period_id,
+			preview_status,
+			total_employee,
+			result,
+			preview_error,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			payroll_previews
+	`
+
+	countPayrollPreview = `
+		SELECT
+			COUNT(*)
+		FROM
+			payroll_previews
+	`
+
+	updatePayrollPreview = `
+		UPDATE
+			payroll_previews
+	`
+)
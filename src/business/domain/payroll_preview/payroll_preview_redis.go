@@ -0,0 +1,123 @@
+package payroll_preview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getPayrollPreviewByKey           = "employeePayroll:payrollPreview:get:%s"
+	getPayrollPreviewByQueryKey      = "employeePayroll:payrollPreview:get:q:%s"
+	getPayrollPreviewByPaginationKey = "employeePayroll:payrollPreview:get:p:%s"
+	deletePayrollPreviewKeysPattern  = "employeePayroll:payrollPreview*"
+)
+
+func (p *payrollPreview) upsertCache(ctx context.Context, key string, payrollPreview entity.PayrollPreview, ttl time.Duration) error {
+	marshalledPayrollPreview, err := p.json.Marshal(payrollPreview)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, key, string(marshalledPayrollPreview), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollPreview) getCache(ctx context.Context, key string) (entity.PayrollPreview, error) {
+	payrollPreview := entity.PayrollPreview{}
+
+	marshalledPayrollPreview, err := p.redis.Get(ctx, key)
+	if err != nil {
+		return payrollPreview, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollPreview), &payrollPreview)
+	if err != nil {
+		return payrollPreview, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollPreview, nil
+}
+
+func (p *payrollPreview) upsertCacheList(ctx context.Context, param entity.PayrollPreviewParam, payrollPreviewList []entity.PayrollPreview, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set payrollPreview list to cache
+	marshalledPayrollPreviewList, err := p.json.Marshal(payrollPreviewList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollPreviewByQueryKey, string(keyValue)), string(marshalledPayrollPreviewList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := p.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollPreviewByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollPreview) getCacheList(ctx context.Context, param entity.PayrollPreviewParam) ([]entity.PayrollPreview, entity.Pagination, error) {
+	var (
+		payrollPreviewList = []entity.PayrollPreview{}
+		pg                 = entity.Pagination{}
+	)
+
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollPreviewList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get payrollPreview list from redis
+	marshalledPayrollPreviewList, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollPreviewByQueryKey, string(keyValue)))
+	if err != nil {
+		return payrollPreviewList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollPreviewList), &payrollPreviewList)
+	if err != nil {
+		return payrollPreviewList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollPreviewByPaginationKey, string(keyValue)))
+	if err != nil {
+		return payrollPreviewList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return payrollPreviewList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollPreviewList, pg, nil
+}
+
+func (p *payrollPreview) deleteCache(ctx context.Context, key string) error {
+	err := p.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package payroll_preview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (p *payrollPreview) getSQL(ctx context.Context, param entity.PayrollPreviewParam) (entity.PayrollPreview, error) {
+	payrollPreview := entity.PayrollPreview{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll preview with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return payrollPreview, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := p.db.QueryRow(ctx, "rPayrollPreview", readPayrollPreview+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollPreview, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&payrollPreview); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return payrollPreview, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return payrollPreview, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll preview with body: %v", param))
+
+	return payrollPreview, nil
+}
+
+func (p *payrollPreview) getListSQL(ctx context.Context, param entity.PayrollPreviewParam) ([]entity.PayrollPreview, *entity.Pagination, error) {
+	payrollPreviewList := []entity.PayrollPreview{}
+	pg := entity.Pagination{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll preview list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return payrollPreviewList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := p.db.Query(ctx, "rPayrollPreviewList", readPayrollPreview+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollPreviewList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		payrollPreview := entity.PayrollPreview{}
+		err := rows.StructScan(&payrollPreview)
+		if err != nil {
+			p.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		payrollPreviewList = append(payrollPreviewList, payrollPreview)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(payrollPreviewList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(payrollPreviewList) > 0 {
+		err := p.db.Get(ctx, "cPayrollPreviewList", countPayrollPreview+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return payrollPreviewList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll preview list with body: %v", param))
+
+	return payrollPreviewList, &pg, nil
+}
+
+func (p *payrollPreview) createSQL(ctx context.Context, inputParam entity.PayrollPreviewInputParam) (entity.PayrollPreview, error) {
+	payrollPreview := entity.PayrollPreview{}
+
+	p.log.Debug(ctx, fmt.Sprintf("create payroll preview with body: %v", inputParam))
+
+	stmt, err := p.db.PrepareNamed(ctx, "iNewPayrollPreview", insertPayrollPreview)
+	if err != nil {
+		return payrollPreview, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&payrollPreview, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return payrollPreview, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return payrollPreview, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create payroll preview with body: %v", inputParam))
+
+	return payrollPreview, nil
+}
+
+func (p *payrollPreview) createManySQL(ctx context.Context, inputParams []entity.PayrollPreviewInputParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("create many payroll preview with body: %v", inputParams))
+
+	res, err := p.db.NamedExec(ctx, "iManyPayrollPreview", insertManyPayrollPreview, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll preview created")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create many payroll preview with body: %v", inputParams))
+
+	return nil
+}
+
+func (p *payrollPreview) updateSQL(ctx context.Context, updateParam entity.PayrollPreviewUpdateParam, selectParam entity.PayrollPreviewParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("update payroll preview with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := p.db.Exec(ctx, "uPayrollPreview", updatePayrollPreview+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll preview updated")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success update payroll preview with body: %v", updateParam))
+
+	return nil
+}
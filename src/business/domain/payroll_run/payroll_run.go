@@ -0,0 +1,145 @@
+package payroll_run
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.PayrollRunParam) (entity.PayrollRun, error)
+	GetList(ctx context.Context, param entity.PayrollRunParam) ([]entity.PayrollRun, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.PayrollRunInputParam) (entity.PayrollRun, error)
+	CreateMany(ctx context.Context, inputParams []entity.PayrollRunInputParam) error
+	Update(ctx context.Context, updateParam entity.PayrollRunUpdateParam, selectParam entity.PayrollRunParam) error
+}
+
+type payrollRun struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &payrollRun{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (p *payrollRun) Get(ctx context.Context, param entity.PayrollRunParam) (entity.PayrollRun, error) {
+	payrollRun := entity.PayrollRun{}
+
+	marshalledParam, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollRun, err
+	}
+
+	if !param.BypassCache {
+		payrollRun, err = p.getCache(ctx, fmt.Sprintf(getPayrollRunByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollRun, nil
+		}
+	}
+
+	payrollRun, err = p.getSQL(ctx, param)
+	if err != nil {
+		return payrollRun, err
+	}
+
+	err = p.upsertCache(ctx, fmt.Sprintf(getPayrollRunByKey, string(marshalledParam)), payrollRun, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollRun, nil
+}
+
+func (p *payrollRun) GetList(ctx context.Context, param entity.PayrollRunParam) ([]entity.PayrollRun, *entity.Pagination, error) {
+	if !param.BypassCache {
+		payrollRunList, pg, err := p.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollRunList, &pg, nil
+		}
+	}
+
+	payrollRunList, pg, err := p.getListSQL(ctx, param)
+	if err != nil {
+		return payrollRunList, pg, err
+	}
+
+	err = p.upsertCacheList(ctx, param, payrollRunList, *pg, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollRunList, pg, nil
+}
+
+func (p *payrollRun) Create(ctx context.Context, param entity.PayrollRunInputParam) (entity.PayrollRun, error) {
+	payrollRun, err := p.createSQL(ctx, param)
+	if err != nil {
+		return payrollRun, err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollRunKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollRun, nil
+}
+
+func (p *payrollRun) CreateMany(ctx context.Context, inputParams []entity.PayrollRunInputParam) error {
+	err := p.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollRunKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (p *payrollRun) Update(ctx context.Context, updateParam entity.PayrollRunUpdateParam, selectParam entity.PayrollRunParam) error {
+	err := p.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollRunKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
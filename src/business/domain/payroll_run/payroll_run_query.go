@@ -0,0 +1,75 @@
+package payroll_run
+
+const (
+	insertPayrollRun = `
+		INSERT INTO payroll_runs (
+			fk_attendance_period_id,
+			version,
+			run_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:version,
+			:run_status,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyPayrollRun = `
+		INSERT INTO payroll_runs (
+			fk_attendance_period_id,
+			version,
+			run_status,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_attendance_period_id,
+			:version,
+			:run_status,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readPayrollRun = `
+		SELECT
+			id,
+			fk_attendance_period_id,
+			version,
+			run_status,
+			process_error,
+			total_employee,
+			processed_employee,
+			failed_employee,
+			started_at,
+			finished_at,
+			void_reason,
+			voided_at,
+			voided_by,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			payroll_runs
+	`
+
+	countPayrollRun = `
+		SELECT
+			COUNT(*)
+		FROM
+			payroll_runs
+	`
+
+	updatePayrollRun = `
+		UPDATE
+			payroll_runs
+	`
+)
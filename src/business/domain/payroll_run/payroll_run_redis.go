@@ -0,0 +1,123 @@
+package payroll_run
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getPayrollRunByKey           = "employeePayroll:payrollRun:get:%s"
+	getPayrollRunByQueryKey      = "employeePayroll:payrollRun:get:q:%s"
+	getPayrollRunByPaginationKey = "employeePayroll:payrollRun:get:p:%s"
+	deletePayrollRunKeysPattern  = "employeePayroll:payrollRun*"
+)
+
+func (p *payrollRun) upsertCache(ctx context.Context, key string, payrollRun entity.PayrollRun, ttl time.Duration) error {
+	marshalledPayrollRun, err := p.json.Marshal(payrollRun)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, key, string(marshalledPayrollRun), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollRun) getCache(ctx context.Context, key string) (entity.PayrollRun, error) {
+	payrollRun := entity.PayrollRun{}
+
+	marshalledPayrollRun, err := p.redis.Get(ctx, key)
+	if err != nil {
+		return payrollRun, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollRun), &payrollRun)
+	if err != nil {
+		return payrollRun, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollRun, nil
+}
+
+func (p *payrollRun) upsertCacheList(ctx context.Context, param entity.PayrollRunParam, payrollRunList []entity.PayrollRun, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set payrollRun list to cache
+	marshalledPayrollRunList, err := p.json.Marshal(payrollRunList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollRunByQueryKey, string(keyValue)), string(marshalledPayrollRunList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := p.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollRunByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollRun) getCacheList(ctx context.Context, param entity.PayrollRunParam) ([]entity.PayrollRun, entity.Pagination, error) {
+	var (
+		payrollRunList = []entity.PayrollRun{}
+		pg             = entity.Pagination{}
+	)
+
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollRunList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get payrollRun list from redis
+	marshalledPayrollRunList, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollRunByQueryKey, string(keyValue)))
+	if err != nil {
+		return payrollRunList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollRunList), &payrollRunList)
+	if err != nil {
+		return payrollRunList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollRunByPaginationKey, string(keyValue)))
+	if err != nil {
+		return payrollRunList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return payrollRunList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollRunList, pg, nil
+}
+
+func (p *payrollRun) deleteCache(ctx context.Context, key string) error {
+	err := p.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package payroll_run
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (p *payrollRun) getSQL(ctx context.Context, param entity.PayrollRunParam) (entity.PayrollRun, error) {
+	payrollRun := entity.PayrollRun{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll run with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return payrollRun, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := p.db.QueryRow(ctx, "rPayrollRun", readPayrollRun+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollRun, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&payrollRun); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return payrollRun, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return payrollRun, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll run with body: %v", param))
+
+	return payrollRun, nil
+}
+
+func (p *payrollRun) getListSQL(ctx context.Context, param entity.PayrollRunParam) ([]entity.PayrollRun, *entity.Pagination, error) {
+	payrollRunList := []entity.PayrollRun{}
+	pg := entity.Pagination{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll run list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return payrollRunList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := p.db.Query(ctx, "rPayrollRunList", readPayrollRun+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollRunList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		payrollRun := entity.PayrollRun{}
+		err := rows.StructScan(&payrollRun)
+		if err != nil {
+			p.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		payrollRunList = append(payrollRunList, payrollRun)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(payrollRunList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(payrollRunList) > 0 {
+		err := p.db.Get(ctx, "cPayrollRunList", countPayrollRun+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return payrollRunList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll run list with body: %v", param))
+
+	return payrollRunList, &pg, nil
+}
+
+func (p *payrollRun) createSQL(ctx context.Context, inputParam entity.PayrollRunInputParam) (entity.PayrollRun, error) {
+	payrollRun := entity.PayrollRun{}
+
+	p.log.Debug(ctx, fmt.Sprintf("create payroll run with body: %v", inputParam))
+
+	stmt, err := p.db.PrepareNamed(ctx, "iNewPayrollRun", insertPayrollRun)
+	if err != nil {
+		return payrollRun, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&payrollRun, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return payrollRun, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return payrollRun, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create payroll run with body: %v", inputParam))
+
+	return payrollRun, nil
+}
+
+func (p *payrollRun) createManySQL(ctx context.Context, inputParams []entity.PayrollRunInputParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("create many payroll run with body: %v", inputParams))
+
+	res, err := p.db.NamedExec(ctx, "iManyPayrollRun", insertManyPayrollRun, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll run created")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create many payroll run with body: %v", inputParams))
+
+	return nil
+}
+
+func (p *payrollRun) updateSQL(ctx context.Context, updateParam entity.PayrollRunUpdateParam, selectParam entity.PayrollRunParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("update payroll run with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := p.db.Exec(ctx, "uPayrollRun", updatePayrollRun+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll run updated")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success update payroll run with body: %v", updateParam))
+
+	return nil
+}
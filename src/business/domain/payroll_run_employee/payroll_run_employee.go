@@ -0,0 +1,145 @@
+package payroll_run_employee
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.PayrollRunEmployeeParam) (entity.PayrollRunEmployee, error)
+	GetList(ctx context.Context, param entity.PayrollRunEmployeeParam) ([]entity.PayrollRunEmployee, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.PayrollRunEmployeeInputParam) (entity.PayrollRunEmployee, error)
+	CreateMany(ctx context.Context, inputParams []entity.PayrollRunEmployeeInputParam) error
+	Update(ctx context.Context, updateParam entity.PayrollRunEmployeeUpdateParam, selectParam entity.PayrollRunEmployeeParam) error
+}
+
+type payrollRunEmployee struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &payrollRunEmployee{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (p *payrollRunEmployee) Get(ctx context.Context, param entity.PayrollRunEmployeeParam) (entity.PayrollRunEmployee, error) {
+	payrollRunEmployee := entity.PayrollRunEmployee{}
+
+	marshalledParam, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollRunEmployee, err
+	}
+
+	if !param.BypassCache {
+		payrollRunEmployee, err = p.getCache(ctx, fmt.Sprintf(getPayrollRunEmployeeByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollRunEmployee, nil
+		}
+	}
+
+	payrollRunEmployee, err = p.getSQL(ctx, param)
+	if err != nil {
+		return payrollRunEmployee, err
+	}
+
+	err = p.upsertCache(ctx, fmt.Sprintf(getPayrollRunEmployeeByKey, string(marshalledParam)), payrollRunEmployee, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollRunEmployee, nil
+}
+
+func (p *payrollRunEmployee) GetList(ctx context.Context, param entity.PayrollRunEmployeeParam) ([]entity.PayrollRunEmployee, *entity.Pagination, error) {
+	if !param.BypassCache {
+		payrollRunEmployeeList, pg, err := p.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payrollRunEmployeeList, &pg, nil
+		}
+	}
+
+	payrollRunEmployeeList, pg, err := p.getListSQL(ctx, param)
+	if err != nil {
+		return payrollRunEmployeeList, pg, err
+	}
+
+	err = p.upsertCacheList(ctx, param, payrollRunEmployeeList, *pg, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollRunEmployeeList, pg, nil
+}
+
+func (p *payrollRunEmployee) Create(ctx context.Context, param entity.PayrollRunEmployeeInputParam) (entity.PayrollRunEmployee, error) {
+	payrollRunEmployee, err := p.createSQL(ctx, param)
+	if err != nil {
+		return payrollRunEmployee, err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollRunEmployeeKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payrollRunEmployee, nil
+}
+
+func (p *payrollRunEmployee) CreateMany(ctx context.Context, inputParams []entity.PayrollRunEmployeeInputParam) error {
+	err := p.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollRunEmployeeKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (p *payrollRunEmployee) Update(ctx context.Context, updateParam entity.PayrollRunEmployeeUpdateParam, selectParam entity.PayrollRunEmployeeParam) error {
+	err := p.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayrollRunEmployeeKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package payroll_run_employee
+
+const (
+	insertPayrollRunEmployee = `
+		INSERT INTO payroll_run_employees (
+			fk_payroll_run_id,
+			fk_user_id,
+			fk_payslip_id,
+			employee_status,
+			process_error,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_payroll_run_id,
+			:fk_user_id,
+			:fk_payslip_id,
+			:employee_status,
+			:process_error,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyPayrollRunEmployee = `
+		INSERT INTO payroll_run_employees (
+			fk_payroll_run_id,
+			fk_user_id,
+			fk_payslip_id,
+			employee_status,
+			process_error,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_payroll_run_id,
+			:fk_user_id,
+			:fk_payslip_id,
+			:employee_status,
+			:process_error,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readPayrollRunEmployee = `
+		SELECT
+			id,
+			fk_payroll_run_id,
+			fk_user_id,
+			fk_payslip_id,
+			employee_status,
+			process_error,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			payroll_run_employees
+	`
+
+	countPayrollRunEmployee = `
+		SELECT
+			COUNT(*)
+		FROM
+			payroll_run_employees
+	`
+
+	updatePayrollRunEmployee = `
+		UPDATE
+			payroll_run_employees
+	`
+)
@@ -0,0 +1,123 @@
+package payroll_run_employee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getPayrollRunEmployeeByKey           = "employeePayroll:payrollRunEmployee:get:%s"
+	getPayrollRunEmployeeByQueryKey      = "employeePayroll:payrollRunEmployee:get:q:%s"
+	getPayrollRunEmployeeByPaginationKey = "employeePayroll:payrollRunEmployee:get:p:%s"
+	deletePayrollRunEmployeeKeysPattern  = "employeePayroll:payrollRunEmployee*"
+)
+
+func (p *payrollRunEmployee) upsertCache(ctx context.Context, key string, payrollRunEmployee entity.PayrollRunEmployee, ttl time.Duration) error {
+	marshalledPayrollRunEmployee, err := p.json.Marshal(payrollRunEmployee)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, key, string(marshalledPayrollRunEmployee), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollRunEmployee) getCache(ctx context.Context, key string) (entity.PayrollRunEmployee, error) {
+	payrollRunEmployee := entity.PayrollRunEmployee{}
+
+	marshalledPayrollRunEmployee, err := p.redis.Get(ctx, key)
+	if err != nil {
+		return payrollRunEmployee, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollRunEmployee), &payrollRunEmployee)
+	if err != nil {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollRunEmployee, nil
+}
+
+func (p *payrollRunEmployee) upsertCacheList(ctx context.Context, param entity.PayrollRunEmployeeParam, payrollRunEmployeeList []entity.PayrollRunEmployee, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set payrollRunEmployee list to cache
+	marshalledPayrollRunEmployeeList, err := p.json.Marshal(payrollRunEmployeeList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollRunEmployeeByQueryKey, string(keyValue)), string(marshalledPayrollRunEmployeeList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := p.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayrollRunEmployeeByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payrollRunEmployee) getCacheList(ctx context.Context, param entity.PayrollRunEmployeeParam) ([]entity.PayrollRunEmployee, entity.Pagination, error) {
+	var (
+		payrollRunEmployeeList = []entity.PayrollRunEmployee{}
+		pg                     = entity.Pagination{}
+	)
+
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return payrollRunEmployeeList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get payrollRunEmployee list from redis
+	marshalledPayrollRunEmployeeList, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollRunEmployeeByQueryKey, string(keyValue)))
+	if err != nil {
+		return payrollRunEmployeeList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayrollRunEmployeeList), &payrollRunEmployeeList)
+	if err != nil {
+		return payrollRunEmployeeList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := p.redis.Get(ctx, fmt.Sprintf(getPayrollRunEmployeeByPaginationKey, string(keyValue)))
+	if err != nil {
+		return payrollRunEmployeeList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return payrollRunEmployeeList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payrollRunEmployeeList, pg, nil
+}
+
+func (p *payrollRunEmployee) deleteCache(ctx context.Context, key string) error {
+	err := p.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
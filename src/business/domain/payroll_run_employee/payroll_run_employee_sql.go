@@ -0,0 +1,169 @@
+package payroll_run_employee
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (p *payrollRunEmployee) getSQL(ctx context.Context, param entity.PayrollRunEmployeeParam) (entity.PayrollRunEmployee, error) {
+	payrollRunEmployee := entity.PayrollRunEmployee{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll run employee with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := p.db.QueryRow(ctx, "rPayrollRunEmployee", readPayrollRunEmployee+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&payrollRunEmployee); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll run employee with body: %v", param))
+
+	return payrollRunEmployee, nil
+}
+
+func (p *payrollRunEmployee) getListSQL(ctx context.Context, param entity.PayrollRunEmployeeParam) ([]entity.PayrollRunEmployee, *entity.Pagination, error) {
+	payrollRunEmployeeList := []entity.PayrollRunEmployee{}
+	pg := entity.Pagination{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payroll run employee list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return payrollRunEmployeeList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := p.db.Query(ctx, "rPayrollRunEmployeeList", readPayrollRunEmployee+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payrollRunEmployeeList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		payrollRunEmployee := entity.PayrollRunEmployee{}
+		err := rows.StructScan(&payrollRunEmployee)
+		if err != nil {
+			p.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		payrollRunEmployeeList = append(payrollRunEmployeeList, payrollRunEmployee)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(payrollRunEmployeeList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(payrollRunEmployeeList) > 0 {
+		err := p.db.Get(ctx, "cPayrollRunEmployeeList", countPayrollRunEmployee+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return payrollRunEmployeeList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payroll run employee list with body: %v", param))
+
+	return payrollRunEmployeeList, &pg, nil
+}
+
+func (p *payrollRunEmployee) createSQL(ctx context.Context, inputParam entity.PayrollRunEmployeeInputParam) (entity.PayrollRunEmployee, error) {
+	payrollRunEmployee := entity.PayrollRunEmployee{}
+
+	p.log.Debug(ctx, fmt.Sprintf("create payroll run employee with body: %v", inputParam))
+
+	stmt, err := p.db.PrepareNamed(ctx, "iNewPayrollRunEmployee", insertPayrollRunEmployee)
+	if err != nil {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&payrollRunEmployee, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return payrollRunEmployee, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create payroll run employee with body: %v", inputParam))
+
+	return payrollRunEmployee, nil
+}
+
+func (p *payrollRunEmployee) createManySQL(ctx context.Context, inputParams []entity.PayrollRunEmployeeInputParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("create many payroll run employee with body: %v", inputParams))
+
+	res, err := p.db.NamedExec(ctx, "iManyPayrollRunEmployee", insertManyPayrollRunEmployee, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll run employee created")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create many payroll run employee with body: %v", inputParams))
+
+	return nil
+}
+
+func (p *payrollRunEmployee) updateSQL(ctx context.Context, updateParam entity.PayrollRunEmployeeUpdateParam, selectParam entity.PayrollRunEmployeeParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("update payroll run employee with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := p.db.Exec(ctx, "uPayrollRunEmployee", updatePayrollRunEmployee+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payroll run employee updated")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success update payroll run employee with body: %v", updateParam))
+
+	return nil
+}
@@ -5,18 +5,32 @@ const (
 		INSERT INTO payslips (
 			fk_user_id,
 			fk_attendance_period_id,
+			fk_payroll_run_id,
 			base_pay_component,
 			overtime_component,
+			allowance_component,
+			adjustment_component,
 			reimbursement_component,
+			deduction_component,
+			tax_deduction,
+			employee_contribution,
+			employer_contribution,
 			total_take_home_pay,
 			created_at,
 			created_by
 		) VALUES (
 			:fk_user_id,
 			:fk_attendance_period_id,
+			:fk_payroll_run_id,
 			:base_pay_component,
 			:overtime_component,
+			:allowance_component,
+			:adjustment_component,
 			:reimbursement_component,
+			:deduction_component,
+			:tax_deduction,
+			:employee_contribution,
+			:employer_contribution,
 			:total_take_home_pay,
 			:created_at,
 			:created_by
@@ -27,18 +41,32 @@ const (
 		INSERT INTO payslips (
 			fk_user_id,
 			fk_attendance_period_id,
+			fk_payroll_run_id,
 			base_pay_component,
 			overtime_component,
+			allowance_component,
+			adjustment_component,
 			reimbursement_component,
+			deduction_component,
+			tax_deduction,
+			employee_contribution,
+			employer_contribution,
 			total_take_home_pay,
 			created_at,
 			created_by
 		) VALUES (
 			:fk_user_id,
 			:fk_attendance_period_id,
+			:fk_payroll_run_id,
 			:base_pay_component,
 			:overtime_component,
+			:allowance_component,
+			:adjustment_component,
 			:reimbursement_component,
+			:deduction_component,
+			:tax_deduction,
+			:employee_contribution,
+			:employer_contribution,
 			:total_take_home_pay,
 			:created_at,
 			:created_by
@@ -50,9 +78,16 @@ const (
 			id,
 			fk_user_id,
 			fk_attendance_period_id,
+			fk_payroll_run_id,
 			base_pay_component,
 			overtime_component,
+			allowance_component,
+			adjustment_component,
 			reimbursement_component,
+			deduction_component,
+			tax_deduction,
+			employee_contribution,
+			employer_contribution,
 			total_take_home_pay,
 			status,
 			flag,
@@ -0,0 +1,145 @@
+package payslip_currency_total
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.PayslipCurrencyTotalParam) (entity.PayslipCurrencyTotal, error)
+	GetList(ctx context.Context, param entity.PayslipCurrencyTotalParam) ([]entity.PayslipCurrencyTotal, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.PayslipCurrencyTotalInputParam) (entity.PayslipCurrencyTotal, error)
+	CreateMany(ctx context.Context, inputParams []entity.PayslipCurrencyTotalInputParam) error
+	Update(ctx context.Context, updateParam entity.PayslipCurrencyTotalUpdateParam, selectParam entity.PayslipCurrencyTotalParam) error
+}
+
+type payslipCurrencyTotal struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &payslipCurrencyTotal{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (p *payslipCurrencyTotal) Get(ctx context.Context, param entity.PayslipCurrencyTotalParam) (entity.PayslipCurrencyTotal, error) {
+	payslipCurrencyTotal := entity.PayslipCurrencyTotal{}
+
+	marshalledParam, err := p.json.Marshal(param)
+	if err != nil {
+		return payslipCurrencyTotal, err
+	}
+
+	if !param.BypassCache {
+		payslipCurrencyTotal, err = p.getCache(ctx, fmt.Sprintf(getPayslipCurrencyTotalByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payslipCurrencyTotal, nil
+		}
+	}
+
+	payslipCurrencyTotal, err = p.getSQL(ctx, param)
+	if err != nil {
+		return payslipCurrencyTotal, err
+	}
+
+	err = p.upsertCache(ctx, fmt.Sprintf(getPayslipCurrencyTotalByKey, string(marshalledParam)), payslipCurrencyTotal, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payslipCurrencyTotal, nil
+}
+
+func (p *payslipCurrencyTotal) GetList(ctx context.Context, param entity.PayslipCurrencyTotalParam) ([]entity.PayslipCurrencyTotal, *entity.Pagination, error) {
+	if !param.BypassCache {
+		payslipCurrencyTotalList, pg, err := p.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			p.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return payslipCurrencyTotalList, &pg, nil
+		}
+	}
+
+	payslipCurrencyTotalList, pg, err := p.getListSQL(ctx, param)
+	if err != nil {
+		return payslipCurrencyTotalList, pg, err
+	}
+
+	err = p.upsertCacheList(ctx, param, payslipCurrencyTotalList, *pg, p.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payslipCurrencyTotalList, pg, nil
+}
+
+func (p *payslipCurrencyTotal) Create(ctx context.Context, param entity.PayslipCurrencyTotalInputParam) (entity.PayslipCurrencyTotal, error) {
+	payslipCurrencyTotal, err := p.createSQL(ctx, param)
+	if err != nil {
+		return payslipCurrencyTotal, err
+	}
+
+	err = p.deleteCache(ctx, deletePayslipCurrencyTotalKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return payslipCurrencyTotal, nil
+}
+
+func (p *payslipCurrencyTotal) CreateMany(ctx context.Context, inputParams []entity.PayslipCurrencyTotalInputParam) error {
+	err := p.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayslipCurrencyTotalKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (p *payslipCurrencyTotal) Update(ctx context.Context, updateParam entity.PayslipCurrencyTotalUpdateParam, selectParam entity.PayslipCurrencyTotalParam) error {
+	err := p.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteCache(ctx, deletePayslipCurrencyTotalKeysPattern)
+	if err != nil {
+		p.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
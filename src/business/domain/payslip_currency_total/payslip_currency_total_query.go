@@ -0,0 +1,111 @@
+package payslip_currency_total
+
+const (
+	insertPayslipCurrencyTotal = `
+		INSERT INTO payslip_currency_totals (
+			fk_payslip_id,
+			fk_payroll_run_id,
+			currency,
+			exchange_rate,
+			base_pay_component,
+			overtime_component,
+			allowance_component,
+			adjustment_component,
+			reimbursement_component,
+			deduction_component,
+			employer_contribution,
+			total_take_home_pay,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_payslip_id,
+			:fk_payroll_run_id,
+			:currency,
+			:exchange_rate,
+			:base_pay_component,
+			:overtime_component,
+			:allowance_component,
+			:adjustment_component,
+			:reimbursement_component,
+			:deduction_component,
+			:employer_contribution,
+			:total_take_home_pay,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyPayslipCurrencyTotal = `
+		INSERT INTO payslip_currency_totals (
+			fk_payslip_id,
+			fk_payroll_run_id,
+			currency,
+			exchange_rate,
+			base_pay_component,
+			overtime_component,
+			allowance_component,
+			adjustment_component,
+			reimbursement_component,
+			deduction_component,
+			employer_contribution,
+			total_take_home_pay,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_payslip_id,
+			:fk_payroll_run_id,
+			:currency,
+			:exchange_rate,
+			:base_pay_component,
+			:overtime_component,
+			:allowance_component,
+			:adjustment_component,
+			:reimbursement_component,
+			:deduction_component,
+			:employer_contribution,
+			:total_take_home_pay,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readPayslipCurrencyTotal = `
+		SELECT
+			id,
+			fk_payslip_id,
+			fk_payroll_run_id,
+			currency,
+			exchange_rate,
+			base_pay_component,
+			overtime_component,
+			allowance_component,
+			adjustment_component,
+			reimbursement_component,
+			deduction_component,
+			employer_contribution,
+			total_take_home_pay,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			payslip_currency_totals
+	`
+
+	countPayslipCurrencyTotal = `
+		SELECT
+			COUNT(*)
+		FROM
+			payslip_currency_totals
+	`
+
+	updatePayslipCurrencyTotal = `
+		UPDATE
+			payslip_currency_totals
+	`
+)
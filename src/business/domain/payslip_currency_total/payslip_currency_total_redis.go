@@ -0,0 +1,123 @@
+package payslip_currency_total
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getPayslipCurrencyTotalByKey           = "employeePayroll:payslipCurrencyTotal:get:%s"
+	getPayslipCurrencyTotalByQueryKey      = "employeePayroll:payslipCurrencyTotal:get:q:%s"
+	getPayslipCurrencyTotalByPaginationKey = "employeePayroll:payslipCurrencyTotal:get:p:%s"
+	deletePayslipCurrencyTotalKeysPattern  = "employeePayroll:payslipCurrencyTotal*"
+)
+
+func (p *payslipCurrencyTotal) upsertCache(ctx context.Context, key string, payslipCurrencyTotal entity.PayslipCurrencyTotal, ttl time.Duration) error {
+	marshalledPayslipCurrencyTotal, err := p.json.Marshal(payslipCurrencyTotal)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, key, string(marshalledPayslipCurrencyTotal), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payslipCurrencyTotal) getCache(ctx context.Context, key string) (entity.PayslipCurrencyTotal, error) {
+	payslipCurrencyTotal := entity.PayslipCurrencyTotal{}
+
+	marshalledPayslipCurrencyTotal, err := p.redis.Get(ctx, key)
+	if err != nil {
+		return payslipCurrencyTotal, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayslipCurrencyTotal), &payslipCurrencyTotal)
+	if err != nil {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payslipCurrencyTotal, nil
+}
+
+func (p *payslipCurrencyTotal) upsertCacheList(ctx context.Context, param entity.PayslipCurrencyTotalParam, payslipCurrencyTotalList []entity.PayslipCurrencyTotal, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set payslipCurrencyTotal list to cache
+	marshalledPayslipCurrencyTotalList, err := p.json.Marshal(payslipCurrencyTotalList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayslipCurrencyTotalByQueryKey, string(keyValue)), string(marshalledPayslipCurrencyTotalList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := p.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = p.redis.SetEX(ctx, fmt.Sprintf(getPayslipCurrencyTotalByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (p *payslipCurrencyTotal) getCacheList(ctx context.Context, param entity.PayslipCurrencyTotalParam) ([]entity.PayslipCurrencyTotal, entity.Pagination, error) {
+	var (
+		payslipCurrencyTotalList = []entity.PayslipCurrencyTotal{}
+		pg                       = entity.Pagination{}
+	)
+
+	keyValue, err := p.json.Marshal(param)
+	if err != nil {
+		return payslipCurrencyTotalList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get payslipCurrencyTotal list from redis
+	marshalledPayslipCurrencyTotalList, err := p.redis.Get(ctx, fmt.Sprintf(getPayslipCurrencyTotalByQueryKey, string(keyValue)))
+	if err != nil {
+		return payslipCurrencyTotalList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPayslipCurrencyTotalList), &payslipCurrencyTotalList)
+	if err != nil {
+		return payslipCurrencyTotalList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := p.redis.Get(ctx, fmt.Sprintf(getPayslipCurrencyTotalByPaginationKey, string(keyValue)))
+	if err != nil {
+		return payslipCurrencyTotalList, pg, err
+	}
+
+	err = p.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return payslipCurrencyTotalList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return payslipCurrencyTotalList, pg, nil
+}
+
+func (p *payslipCurrencyTotal) deleteCache(ctx context.Context, key string) error {
+	err := p.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package payslip_currency_total
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (p *payslipCurrencyTotal) getSQL(ctx context.Context, param entity.PayslipCurrencyTotalParam) (entity.PayslipCurrencyTotal, error) {
+	payslipCurrencyTotal := entity.PayslipCurrencyTotal{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payslip currency total with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := p.db.QueryRow(ctx, "rPayslipCurrencyTotal", readPayslipCurrencyTotal+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&payslipCurrencyTotal); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payslip currency total with body: %v", param))
+
+	return payslipCurrencyTotal, nil
+}
+
+func (p *payslipCurrencyTotal) getListSQL(ctx context.Context, param entity.PayslipCurrencyTotalParam) ([]entity.PayslipCurrencyTotal, *entity.Pagination, error) {
+	payslipCurrencyTotalList := []entity.PayslipCurrencyTotal{}
+	pg := entity.Pagination{}
+
+	p.log.Debug(ctx, fmt.Sprintf("get payslip currency total list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return payslipCurrencyTotalList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := p.db.Query(ctx, "rPayslipCurrencyTotalList", readPayslipCurrencyTotal+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return payslipCurrencyTotalList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		payslipCurrencyTotal := entity.PayslipCurrencyTotal{}
+		err := rows.StructScan(&payslipCurrencyTotal)
+		if err != nil {
+			p.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		payslipCurrencyTotalList = append(payslipCurrencyTotalList, payslipCurrencyTotal)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(payslipCurrencyTotalList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(payslipCurrencyTotalList) > 0 {
+		err := p.db.Get(ctx, "cPayslipCurrencyTotalList", countPayslipCurrencyTotal+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return payslipCurrencyTotalList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	p.log.Debug(ctx, fmt.Sprintf("success get payslip currency total list with body: %v", param))
+
+	return payslipCurrencyTotalList, &pg, nil
+}
+
+func (p *payslipCurrencyTotal) createSQL(ctx context.Context, inputParam entity.PayslipCurrencyTotalInputParam) (entity.PayslipCurrencyTotal, error) {
+	payslipCurrencyTotal := entity.PayslipCurrencyTotal{}
+
+	p.log.Debug(ctx, fmt.Sprintf("create payslip currency total with body: %v", inputParam))
+
+	stmt, err := p.db.PrepareNamed(ctx, "iNewPayslipCurrencyTotal", insertPayslipCurrencyTotal)
+	if err != nil {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&payslipCurrencyTotal, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return payslipCurrencyTotal, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create payslip currency total with body: %v", inputParam))
+
+	return payslipCurrencyTotal, nil
+}
+
+func (p *payslipCurrencyTotal) createManySQL(ctx context.Context, inputParams []entity.PayslipCurrencyTotalInputParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("create many payslip currency total with body: %v", inputParams))
+
+	res, err := p.db.NamedExec(ctx, "iManyPayslipCurrencyTotal", insertManyPayslipCurrencyTotal, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payslip currency total created")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success create many payslip currency total with body: %v", inputParams))
+
+	return nil
+}
+
+func (p *payslipCurrencyTotal) updateSQL(ctx context.Context, updateParam entity.PayslipCurrencyTotalUpdateParam, selectParam entity.PayslipCurrencyTotalParam) error {
+	p.log.Debug(ctx, fmt.Sprintf("update payslip currency total with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := p.db.Exec(ctx, "uPayslipCurrencyTotal", updatePayslipCurrencyTotal+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no payslip currency total updated")
+	}
+
+	p.log.Debug(ctx, fmt.Sprintf("success update payslip currency total with body: %v", updateParam))
+
+	return nil
+}
@@ -7,6 +7,8 @@ const (
 			item_type,
 			description,
 			amount,
+			currency,
+			rate,
 			created_at,
 			created_by
 		) VALUES (
@@ -14,6 +16,8 @@ const (
 			:item_type,
 			:description,
 			:amount,
+			:currency,
+			:rate,
 			:created_at,
 			:created_by
 		) RETURNING *
@@ -25,6 +29,8 @@ const (
 			item_type,
 			description,
 			amount,
+			currency,
+			rate,
 			created_at,
 			created_by
 		) VALUES (
@@ -32,6 +38,8 @@ const (
 			:item_type,
 			:description,
 			:amount,
+			:currency,
+			:rate,
 			:created_at,
 			:created_by
 		)
@@ -44,6 +52,8 @@ const (
 			item_type,
 			description,
 			amount,
+			currency,
+			rate,
 			status,
 			flag,
 			meta,
@@ -143,7 +143,7 @@ func (p *payslipDetail) updateSQL(ctx context.Context, updateParam entity.Paysli
 	p.log.Debug(ctx, fmt.Sprintf("update payslip detail with body: %v", updateParam))
 
 	qb := query.NewSQLQueryBuilder(p.db, "param", "db", &selectParam.QueryOption)
-	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	queryUpdate, args, err := qb.BuildUpdate(entity.SQLUpdateParam(updateParam), &selectParam)
 	if err != nil {
 		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
 	}
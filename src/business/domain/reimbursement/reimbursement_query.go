@@ -6,6 +6,7 @@ const (
 			fk_user_id,
 			description,
 			amount,
+			currency,
 			reimbursement_date,
 			approved_date,
 			approved_by,
@@ -15,6 +16,7 @@ const (
 			:fk_user_id,
 			:description,
 			:amount,
+			:currency,
 			:reimbursement_date,
 			:approved_date,
 			:approved_by,
@@ -29,6 +31,7 @@ const (
 			fk_user_id,
 			description,
 			amount,
+			currency,
 			reimbursement_date,
 			approved_date,
 			approved_by,
@@ -121,7 +121,7 @@ func (r *reimbursement) updateSQL(ctx context.Context, updateParam entity.Reimbu
 	r.log.Debug(ctx, fmt.Sprintf("update reimbursement with body: %v", updateParam))
 
 	qb := query.NewSQLQueryBuilder(r.db, "param", "db", &selectParam.QueryOption)
-	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	queryUpdate, args, err := qb.BuildUpdate(entity.SQLUpdateParam(updateParam), &selectParam)
 	if err != nil {
 		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
 	}
@@ -0,0 +1,145 @@
+package tax_bracket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.TaxBracketParam) (entity.TaxBracket, error)
+	GetList(ctx context.Context, param entity.TaxBracketParam) ([]entity.TaxBracket, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.TaxBracketInputParam) (entity.TaxBracket, error)
+	CreateMany(ctx context.Context, inputParams []entity.TaxBracketInputParam) error
+	Update(ctx context.Context, updateParam entity.TaxBracketUpdateParam, selectParam entity.TaxBracketParam) error
+}
+
+type taxBracket struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &taxBracket{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (t *taxBracket) Get(ctx context.Context, param entity.TaxBracketParam) (entity.TaxBracket, error) {
+	taxBracket := entity.TaxBracket{}
+
+	marshalledParam, err := t.json.Marshal(param)
+	if err != nil {
+		return taxBracket, err
+	}
+
+	if !param.BypassCache {
+		taxBracket, err = t.getCache(ctx, fmt.Sprintf(getTaxBracketByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			t.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			t.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return taxBracket, nil
+		}
+	}
+
+	taxBracket, err = t.getSQL(ctx, param)
+	if err != nil {
+		return taxBracket, err
+	}
+
+	err = t.upsertCache(ctx, fmt.Sprintf(getTaxBracketByKey, string(marshalledParam)), taxBracket, t.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		t.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return taxBracket, nil
+}
+
+func (t *taxBracket) GetList(ctx context.Context, param entity.TaxBracketParam) ([]entity.TaxBracket, *entity.Pagination, error) {
+	if !param.BypassCache {
+		taxBracketList, pg, err := t.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			t.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			t.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return taxBracketList, &pg, nil
+		}
+	}
+
+	taxBracketList, pg, err := t.getListSQL(ctx, param)
+	if err != nil {
+		return taxBracketList, pg, err
+	}
+
+	err = t.upsertCacheList(ctx, param, taxBracketList, *pg, t.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		t.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return taxBracketList, pg, nil
+}
+
+func (t *taxBracket) Create(ctx context.Context, param entity.TaxBracketInputParam) (entity.TaxBracket, error) {
+	taxBracket, err := t.createSQL(ctx, param)
+	if err != nil {
+		return taxBracket, err
+	}
+
+	err = t.deleteCache(ctx, deleteTaxBracketKeysPattern)
+	if err != nil {
+		t.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return taxBracket, nil
+}
+
+func (t *taxBracket) CreateMany(ctx context.Context, inputParams []entity.TaxBracketInputParam) error {
+	err := t.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = t.deleteCache(ctx, deleteTaxBracketKeysPattern)
+	if err != nil {
+		t.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (t *taxBracket) Update(ctx context.Context, updateParam entity.TaxBracketUpdateParam, selectParam entity.TaxBracketParam) error {
+	err := t.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = t.deleteCache(ctx, deleteTaxBracketKeysPattern)
+	if err != nil {
+		t.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
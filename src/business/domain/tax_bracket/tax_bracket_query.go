@@ -0,0 +1,71 @@
+package tax_bracket
+
+const (
+	insertTaxBracket = `
+		INSERT INTO tax_brackets (
+			effective_date,
+			lower_bound,
+			upper_bound,
+			rate,
+			created_at,
+			created_by
+		) VALUES (
+			:effective_date,
+			:lower_bound,
+			:upper_bound,
+			:rate,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyTaxBracket = `
+		INSERT INTO tax_brackets (
+			effective_date,
+			lower_bound,
+			upper_bound,
+			rate,
+			created_at,
+			created_by
+		) VALUES (
+			:effective_date,
+			:lower_bound,
+			:upper_bound,
+			:rate,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readTaxBracket = `
+		SELECT
+			id,
+			effective_date,
+			lower_bound,
+			upper_bound,
+			rate,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			tax_brackets
+	`
+
+	countTaxBracket = `
+		SELECT
+			COUNT(*)
+		FROM
+			tax_brackets
+	`
+
+	updateTaxBracket = `
+		UPDATE
+			tax_brackets
+	`
+)
@@ -0,0 +1,123 @@
+package tax_bracket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getTaxBracketByKey           = "employeePayroll:taxBracket:get:%s"
+	getTaxBracketByQueryKey      = "employeePayroll:taxBracket:get:q:%s"
+	getTaxBracketByPaginationKey = "employeePayroll:taxBracket:get:p:%s"
+	deleteTaxBracketKeysPattern  = "employeePayroll:taxBracket*"
+)
+
+func (t *taxBracket) upsertCache(ctx context.Context, key string, taxBracket entity.TaxBracket, ttl time.Duration) error {
+	marshalledTaxBracket, err := t.json.Marshal(taxBracket)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = t.redis.SetEX(ctx, key, string(marshalledTaxBracket), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (t *taxBracket) getCache(ctx context.Context, key string) (entity.TaxBracket, error) {
+	taxBracket := entity.TaxBracket{}
+
+	marshalledTaxBracket, err := t.redis.Get(ctx, key)
+	if err != nil {
+		return taxBracket, err
+	}
+
+	err = t.json.Unmarshal([]byte(marshalledTaxBracket), &taxBracket)
+	if err != nil {
+		return taxBracket, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return taxBracket, nil
+}
+
+func (t *taxBracket) upsertCacheList(ctx context.Context, param entity.TaxBracketParam, taxBracketList []entity.TaxBracket, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := t.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set taxBracket list to cache
+	marshalledTaxBracketList, err := t.json.Marshal(taxBracketList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = t.redis.SetEX(ctx, fmt.Sprintf(getTaxBracketByQueryKey, string(keyValue)), string(marshalledTaxBracketList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := t.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = t.redis.SetEX(ctx, fmt.Sprintf(getTaxBracketByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (t *taxBracket) getCacheList(ctx context.Context, param entity.TaxBracketParam) ([]entity.TaxBracket, entity.Pagination, error) {
+	var (
+		taxBracketList = []entity.TaxBracket{}
+		pg             = entity.Pagination{}
+	)
+
+	keyValue, err := t.json.Marshal(param)
+	if err != nil {
+		return taxBracketList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get taxBracket list from redis
+	marshalledTaxBracketList, err := t.redis.Get(ctx, fmt.Sprintf(getTaxBracketByQueryKey, string(keyValue)))
+	if err != nil {
+		return taxBracketList, pg, err
+	}
+
+	err = t.json.Unmarshal([]byte(marshalledTaxBracketList), &taxBracketList)
+	if err != nil {
+		return taxBracketList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := t.redis.Get(ctx, fmt.Sprintf(getTaxBracketByPaginationKey, string(keyValue)))
+	if err != nil {
+		return taxBracketList, pg, err
+	}
+
+	err = t.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return taxBracketList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return taxBracketList, pg, nil
+}
+
+func (t *taxBracket) deleteCache(ctx context.Context, key string) error {
+	err := t.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
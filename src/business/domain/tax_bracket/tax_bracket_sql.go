@@ -0,0 +1,169 @@
+package tax_bracket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (t *taxBracket) getSQL(ctx context.Context, param entity.TaxBracketParam) (entity.TaxBracket, error) {
+	taxBracket := entity.TaxBracket{}
+
+	t.log.Debug(ctx, fmt.Sprintf("get tax bracket with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(entity.SQLQueryParam(param))
+	if err != nil {
+		return taxBracket, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := t.db.QueryRow(ctx, "rTaxBracket", readTaxBracket+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return taxBracket, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&taxBracket); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return taxBracket, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return taxBracket, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("success get tax bracket with body: %v", param))
+
+	return taxBracket, nil
+}
+
+func (t *taxBracket) getListSQL(ctx context.Context, param entity.TaxBracketParam) ([]entity.TaxBracket, *entity.Pagination, error) {
+	taxBracketList := []entity.TaxBracket{}
+	pg := entity.Pagination{}
+
+	t.log.Debug(ctx, fmt.Sprintf("get tax bracket list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(entity.SQLQueryParam(param))
+	if err != nil {
+		return taxBracketList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := t.db.Query(ctx, "rTaxBracketList", readTaxBracket+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return taxBracketList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		taxBracket := entity.TaxBracket{}
+		err := rows.StructScan(&taxBracket)
+		if err != nil {
+			t.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		taxBracketList = append(taxBracketList, taxBracket)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(taxBracketList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(taxBracketList) > 0 {
+		err := t.db.Get(ctx, "cTaxBracketList", countTaxBracket+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return taxBracketList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	t.log.Debug(ctx, fmt.Sprintf("success get tax bracket list with body: %v", param))
+
+	return taxBracketList, &pg, nil
+}
+
+func (t *taxBracket) createSQL(ctx context.Context, inputParam entity.TaxBracketInputParam) (entity.TaxBracket, error) {
+	taxBracket := entity.TaxBracket{}
+
+	t.log.Debug(ctx, fmt.Sprintf("create tax bracket with body: %v", inputParam))
+
+	stmt, err := t.db.PrepareNamed(ctx, "iNewTaxBracket", insertTaxBracket)
+	if err != nil {
+		return taxBracket, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&taxBracket, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return taxBracket, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return taxBracket, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("success create tax bracket with body: %v", inputParam))
+
+	return taxBracket, nil
+}
+
+func (t *taxBracket) createManySQL(ctx context.Context, inputParams []entity.TaxBracketInputParam) error {
+	t.log.Debug(ctx, fmt.Sprintf("create many tax bracket with body: %v", inputParams))
+
+	res, err := t.db.NamedExec(ctx, "iManyTaxBracket", insertManyTaxBracket, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no tax bracket created")
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("success create many tax bracket with body: %v", inputParams))
+
+	return nil
+}
+
+func (t *taxBracket) updateSQL(ctx context.Context, updateParam entity.TaxBracketUpdateParam, selectParam entity.TaxBracketParam) error {
+	t.log.Debug(ctx, fmt.Sprintf("update tax bracket with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := t.db.Exec(ctx, "uTaxBracket", updateTaxBracket+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no tax bracket updated")
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("success update tax bracket with body: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package tax_bracket
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	libsql "github.com/reyhanmichiels/go-pkg/v2/sql"
+	mock_log "github.com/reyhanmichiels/go-pkg/v2/tests/mock/log"
+	mock_parser "github.com/reyhanmichiels/go-pkg/v2/tests/mock/parser"
+	mock_redis "github.com/reyhanmichiels/go-pkg/v2/tests/mock/redis"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_taxBracket_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mock_log.NewMockInterface(ctrl)
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRedis := mock_redis.NewMockInterface(ctrl)
+	mockRedis.EXPECT().GetDefaultTTL(gomock.Any()).AnyTimes()
+	mockRedis.EXPECT().SetEX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mockJson := mock_parser.NewMockJSONInterface(ctrl)
+	mockJson.EXPECT().Marshal(gomock.Any()).Return([]byte("{}"), nil).AnyTimes()
+
+	ctx := context.Background()
+
+	// the first bracket starts at zero, which the builder would skip as a plain Money
+	mockParam := entity.TaxBracketParam{
+		LowerBound:  entity.NullMoneyFrom(0),
+		BypassCache: true,
+	}
+
+	query := regexp.QuoteMeta(readTaxBracket + " WHERE 1=1 AND lower_bound=?;")
+
+	tests := []struct {
+		name        string
+		param       entity.TaxBracketParam
+		prepSqlMock func() (*sql.DB, error)
+		want        entity.TaxBracket
+		wantErr     bool
+	}{
+		{
+			name:  "success with zero lower bound",
+			param: mockParam,
+			prepSqlMock: func() (*sql.DB, error) {
+				sqlServer, sqlMock, err := sqlmock.New()
+
+				sqlMock.ExpectQuery(query).WithArgs("0.00").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "lower_bound"}).AddRow(1, "0.00"))
+
+				return sqlServer, err
+			},
+			want:    entity.TaxBracket{ID: 1},
+			wantErr: false,
+		},
+		{
+			name: "success without lower bound",
+			param: entity.TaxBracketParam{
+				BypassCache: true,
+			},
+			prepSqlMock: func() (*sql.DB, error) {
+				sqlServer, sqlMock, err := sqlmock.New()
+
+				sqlMock.ExpectQuery(regexp.QuoteMeta(readTaxBracket + " WHERE 1=1;")).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "lower_bound"}).AddRow(1, "0.00"))
+
+				return sqlServer, err
+			},
+			want:    entity.TaxBracket{ID: 1},
+			wantErr: false,
+		},
+		{
+			name:  "failed to read",
+			param: mockParam,
+			prepSqlMock: func() (*sql.DB, error) {
+				sqlServer, sqlMock, err := sqlmock.New()
+
+				sqlMock.ExpectQuery(query).WithArgs("0.00").WillReturnError(assert.AnError)
+
+				return sqlServer, err
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlServer, err := tt.prepSqlMock()
+			if err != nil {
+				t.Error(err)
+			}
+			defer sqlServer.Close()
+
+			sqlClient := libsql.Init(libsql.Config{
+				Driver: "sqlmock",
+				Leader: libsql.ConnConfig{
+					MockDB: sqlServer,
+				},
+				Follower: libsql.ConnConfig{
+					MockDB: sqlServer,
+				},
+			}, logger)
+
+			d := Init(InitParam{Db: sqlClient, Log: logger, Redis: mockRedis, Json: mockJson})
+			got, err := d.Get(ctx, tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TaxBracket.Get() err %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.want.ID, got.ID)
+			}
+		})
+	}
+}
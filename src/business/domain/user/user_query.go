@@ -30,6 +30,13 @@ const (
 		 	email,
 		 	password,
 		 	base_salary,
+		 	currency,
+		 	tax_marital_status,
+		 	tax_dependants,
+		 	region,
+		 	device_user_id,
+		 	hire_date,
+		 	termination_date,
 			status,
 			flag,
 			meta,
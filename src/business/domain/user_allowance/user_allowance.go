@@ -0,0 +1,145 @@
+package user_allowance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.UserAllowanceParam) (entity.UserAllowance, error)
+	GetList(ctx context.Context, param entity.UserAllowanceParam) ([]entity.UserAllowance, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.UserAllowanceInputParam) (entity.UserAllowance, error)
+	CreateMany(ctx context.Context, inputParams []entity.UserAllowanceInputParam) error
+	Update(ctx context.Context, updateParam entity.UserAllowanceUpdateParam, selectParam entity.UserAllowanceParam) error
+}
+
+type userAllowance struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &userAllowance{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (u *userAllowance) Get(ctx context.Context, param entity.UserAllowanceParam) (entity.UserAllowance, error) {
+	userAllowance := entity.UserAllowance{}
+
+	marshalledParam, err := u.json.Marshal(param)
+	if err != nil {
+		return userAllowance, err
+	}
+
+	if !param.BypassCache {
+		userAllowance, err = u.getCache(ctx, fmt.Sprintf(getUserAllowanceByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userAllowance, nil
+		}
+	}
+
+	userAllowance, err = u.getSQL(ctx, param)
+	if err != nil {
+		return userAllowance, err
+	}
+
+	err = u.upsertCache(ctx, fmt.Sprintf(getUserAllowanceByKey, string(marshalledParam)), userAllowance, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userAllowance, nil
+}
+
+func (u *userAllowance) GetList(ctx context.Context, param entity.UserAllowanceParam) ([]entity.UserAllowance, *entity.Pagination, error) {
+	if !param.BypassCache {
+		userAllowanceList, pg, err := u.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userAllowanceList, &pg, nil
+		}
+	}
+
+	userAllowanceList, pg, err := u.getListSQL(ctx, param)
+	if err != nil {
+		return userAllowanceList, pg, err
+	}
+
+	err = u.upsertCacheList(ctx, param, userAllowanceList, *pg, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userAllowanceList, pg, nil
+}
+
+func (u *userAllowance) Create(ctx context.Context, param entity.UserAllowanceInputParam) (entity.UserAllowance, error) {
+	userAllowance, err := u.createSQL(ctx, param)
+	if err != nil {
+		return userAllowance, err
+	}
+
+	err = u.deleteCache(ctx, deleteUserAllowanceKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userAllowance, nil
+}
+
+func (u *userAllowance) CreateMany(ctx context.Context, inputParams []entity.UserAllowanceInputParam) error {
+	err := u.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserAllowanceKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (u *userAllowance) Update(ctx context.Context, updateParam entity.UserAllowanceUpdateParam, selectParam entity.UserAllowanceParam) error {
+	err := u.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserAllowanceKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
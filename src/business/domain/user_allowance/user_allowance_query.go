@@ -0,0 +1,81 @@
+package user_allowance
+
+const (
+	insertUserAllowance = `
+		INSERT INTO user_allowances (
+			fk_user_id,
+			fk_allowance_type_id,
+			amount,
+			is_prorated,
+			effective_date,
+			end_date,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:fk_allowance_type_id,
+			:amount,
+			:is_prorated,
+			:effective_date,
+			:end_date,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyUserAllowance = `
+		INSERT INTO user_allowances (
+			fk_user_id,
+			fk_allowance_type_id,
+			amount,
+			is_prorated,
+			effective_date,
+			end_date,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:fk_allowance_type_id,
+			:amount,
+			:is_prorated,
+			:effective_date,
+			:end_date,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readUserAllowance = `
+		SELECT
+			id,
+			fk_user_id,
+			fk_allowance_type_id,
+			amount,
+			is_prorated,
+			effective_date,
+			end_date,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			user_allowances
+	`
+
+	countUserAllowance = `
+		SELECT
+			COUNT(*)
+		FROM
+			user_allowances
+	`
+
+	updateUserAllowance = `
+		UPDATE
+			user_allowances
+	`
+)
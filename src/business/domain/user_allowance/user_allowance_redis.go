@@ -0,0 +1,123 @@
+package user_allowance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getUserAllowanceByKey           = "employeePayroll:userAllowance:get:%s"
+	getUserAllowanceByQueryKey      = "employeePayroll:userAllowance:get:q:%s"
+	getUserAllowanceByPaginationKey = "employeePayroll:userAllowance:get:p:%s"
+	deleteUserAllowanceKeysPattern  = "employeePayroll:userAllowance*"
+)
+
+func (u *userAllowance) upsertCache(ctx context.Context, key string, userAllowance entity.UserAllowance, ttl time.Duration) error {
+	marshalledUserAllowance, err := u.json.Marshal(userAllowance)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, key, string(marshalledUserAllowance), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userAllowance) getCache(ctx context.Context, key string) (entity.UserAllowance, error) {
+	userAllowance := entity.UserAllowance{}
+
+	marshalledUserAllowance, err := u.redis.Get(ctx, key)
+	if err != nil {
+		return userAllowance, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserAllowance), &userAllowance)
+	if err != nil {
+		return userAllowance, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userAllowance, nil
+}
+
+func (u *userAllowance) upsertCacheList(ctx context.Context, param entity.UserAllowanceParam, userAllowanceList []entity.UserAllowance, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set userAllowance list to cache
+	marshalledUserAllowanceList, err := u.json.Marshal(userAllowanceList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserAllowanceByQueryKey, string(keyValue)), string(marshalledUserAllowanceList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := u.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserAllowanceByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userAllowance) getCacheList(ctx context.Context, param entity.UserAllowanceParam) ([]entity.UserAllowance, entity.Pagination, error) {
+	var (
+		userAllowanceList = []entity.UserAllowance{}
+		pg                = entity.Pagination{}
+	)
+
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return userAllowanceList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get userAllowance list from redis
+	marshalledUserAllowanceList, err := u.redis.Get(ctx, fmt.Sprintf(getUserAllowanceByQueryKey, string(keyValue)))
+	if err != nil {
+		return userAllowanceList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserAllowanceList), &userAllowanceList)
+	if err != nil {
+		return userAllowanceList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := u.redis.Get(ctx, fmt.Sprintf(getUserAllowanceByPaginationKey, string(keyValue)))
+	if err != nil {
+		return userAllowanceList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return userAllowanceList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userAllowanceList, pg, nil
+}
+
+func (u *userAllowance) deleteCache(ctx context.Context, key string) error {
+	err := u.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package user_allowance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (u *userAllowance) getSQL(ctx context.Context, param entity.UserAllowanceParam) (entity.UserAllowance, error) {
+	userAllowance := entity.UserAllowance{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user allowance with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return userAllowance, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := u.db.QueryRow(ctx, "rUserAllowance", readUserAllowance+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userAllowance, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&userAllowance); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return userAllowance, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return userAllowance, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user allowance with body: %v", param))
+
+	return userAllowance, nil
+}
+
+func (u *userAllowance) getListSQL(ctx context.Context, param entity.UserAllowanceParam) ([]entity.UserAllowance, *entity.Pagination, error) {
+	userAllowanceList := []entity.UserAllowance{}
+	pg := entity.Pagination{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user allowance list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return userAllowanceList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := u.db.Query(ctx, "rUserAllowanceList", readUserAllowance+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userAllowanceList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		userAllowance := entity.UserAllowance{}
+		err := rows.StructScan(&userAllowance)
+		if err != nil {
+			u.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		userAllowanceList = append(userAllowanceList, userAllowance)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(userAllowanceList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(userAllowanceList) > 0 {
+		err := u.db.Get(ctx, "cUserAllowanceList", countUserAllowance+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return userAllowanceList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user allowance list with body: %v", param))
+
+	return userAllowanceList, &pg, nil
+}
+
+func (u *userAllowance) createSQL(ctx context.Context, inputParam entity.UserAllowanceInputParam) (entity.UserAllowance, error) {
+	userAllowance := entity.UserAllowance{}
+
+	u.log.Debug(ctx, fmt.Sprintf("create user allowance with body: %v", inputParam))
+
+	stmt, err := u.db.PrepareNamed(ctx, "iNewUserAllowance", insertUserAllowance)
+	if err != nil {
+		return userAllowance, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&userAllowance, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return userAllowance, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return userAllowance, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create user allowance with body: %v", inputParam))
+
+	return userAllowance, nil
+}
+
+func (u *userAllowance) createManySQL(ctx context.Context, inputParams []entity.UserAllowanceInputParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("create many user allowance with body: %v", inputParams))
+
+	res, err := u.db.NamedExec(ctx, "iManyUserAllowance", insertManyUserAllowance, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user allowance created")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create many user allowance with body: %v", inputParams))
+
+	return nil
+}
+
+func (u *userAllowance) updateSQL(ctx context.Context, updateParam entity.UserAllowanceUpdateParam, selectParam entity.UserAllowanceParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("update user allowance with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := u.db.Exec(ctx, "uUserAllowance", updateUserAllowance+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user allowance updated")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success update user allowance with body: %v", updateParam))
+
+	return nil
+}
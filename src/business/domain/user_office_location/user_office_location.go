@@ -0,0 +1,145 @@
+package user_office_location
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.UserOfficeLocationParam) (entity.UserOfficeLocation, error)
+	GetList(ctx context.Context, param entity.UserOfficeLocationParam) ([]entity.UserOfficeLocation, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.UserOfficeLocationInputParam) (entity.UserOfficeLocation, error)
+	CreateMany(ctx context.Context, inputParams []entity.UserOfficeLocationInputParam) error
+	Update(ctx context.Context, updateParam entity.UserOfficeLocationUpdateParam, selectParam entity.UserOfficeLocationParam) error
+}
+
+type userOfficeLocation struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &userOfficeLocation{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (u *userOfficeLocation) Get(ctx context.Context, param entity.UserOfficeLocationParam) (entity.UserOfficeLocation, error) {
+	userOfficeLocation := entity.UserOfficeLocation{}
+
+	marshalledParam, err := u.json.Marshal(param)
+	if err != nil {
+		return userOfficeLocation, err
+	}
+
+	if !param.BypassCache {
+		userOfficeLocation, err = u.getCache(ctx, fmt.Sprintf(getUserOfficeLocationByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userOfficeLocation, nil
+		}
+	}
+
+	userOfficeLocation, err = u.getSQL(ctx, param)
+	if err != nil {
+		return userOfficeLocation, err
+	}
+
+	err = u.upsertCache(ctx, fmt.Sprintf(getUserOfficeLocationByKey, string(marshalledParam)), userOfficeLocation, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userOfficeLocation, nil
+}
+
+func (u *userOfficeLocation) GetList(ctx context.Context, param entity.UserOfficeLocationParam) ([]entity.UserOfficeLocation, *entity.Pagination, error) {
+	if !param.BypassCache {
+		userOfficeLocationList, pg, err := u.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userOfficeLocationList, &pg, nil
+		}
+	}
+
+	userOfficeLocationList, pg, err := u.getListSQL(ctx, param)
+	if err != nil {
+		return userOfficeLocationList, pg, err
+	}
+
+	err = u.upsertCacheList(ctx, param, userOfficeLocationList, *pg, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userOfficeLocationList, pg, nil
+}
+
+func (u *userOfficeLocation) Create(ctx context.Context, param entity.UserOfficeLocationInputParam) (entity.UserOfficeLocation, error) {
+	userOfficeLocation, err := u.createSQL(ctx, param)
+	if err != nil {
+		return userOfficeLocation, err
+	}
+
+	err = u.deleteCache(ctx, deleteUserOfficeLocationKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userOfficeLocation, nil
+}
+
+func (u *userOfficeLocation) CreateMany(ctx context.Context, inputParams []entity.UserOfficeLocationInputParam) error {
+	err := u.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserOfficeLocationKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (u *userOfficeLocation) Update(ctx context.Context, updateParam entity.UserOfficeLocationUpdateParam, selectParam entity.UserOfficeLocationParam) error {
+	err := u.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserOfficeLocationKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
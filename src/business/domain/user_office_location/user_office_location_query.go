@@ -0,0 +1,61 @@
+package user_office_location
+
+const (
+	insertUserOfficeLocation = `
+		INSERT INTO user_office_locations (
+			fk_user_id,
+			fk_office_location_id,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:fk_office_location_id,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyUserOfficeLocation = `
+		INSERT INTO user_office_locations (
+			fk_user_id,
+			fk_office_location_id,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:fk_office_location_id,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readUserOfficeLocation = `
+		SELECT
+			id,
+			fk_user_id,
+			fk_office_location_id,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			user_office_locations
+	`
+
+	countUserOfficeLocation = `
+		SELECT
+			COUNT(*)
+		FROM
+			user_office_locations
+	`
+
+	updateUserOfficeLocation = `
+		UPDATE
+			user_office_locations
+	`
+)
@@ -0,0 +1,123 @@
+package user_office_location
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getUserOfficeLocationByKey           = "employeePayroll:userOfficeLocation:get:%s"
+	getUserOfficeLocationByQueryKey      = "employeePayroll:userOfficeLocation:get:q:%s"
+	getUserOfficeLocationByPaginationKey = "employeePayroll:userOfficeLocation:get:p:%s"
+	deleteUserOfficeLocationKeysPattern  = "employeePayroll:userOfficeLocation*"
+)
+
+func (u *userOfficeLocation) upsertCache(ctx context.Context, key string, userOfficeLocation entity.UserOfficeLocation, ttl time.Duration) error {
+	marshalledUserOfficeLocation, err := u.json.Marshal(userOfficeLocation)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, key, string(marshalledUserOfficeLocation), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userOfficeLocation) getCache(ctx context.Context, key string) (entity.UserOfficeLocation, error) {
+	userOfficeLocation := entity.UserOfficeLocation{}
+
+	marshalledUserOfficeLocation, err := u.redis.Get(ctx, key)
+	if err != nil {
+		return userOfficeLocation, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserOfficeLocation), &userOfficeLocation)
+	if err != nil {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userOfficeLocation, nil
+}
+
+func (u *userOfficeLocation) upsertCacheList(ctx context.Context, param entity.UserOfficeLocationParam, userOfficeLocationList []entity.UserOfficeLocation, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set userOfficeLocation list to cache
+	marshalledUserOfficeLocationList, err := u.json.Marshal(userOfficeLocationList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserOfficeLocationByQueryKey, string(keyValue)), string(marshalledUserOfficeLocationList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := u.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserOfficeLocationByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userOfficeLocation) getCacheList(ctx context.Context, param entity.UserOfficeLocationParam) ([]entity.UserOfficeLocation, entity.Pagination, error) {
+	var (
+		userOfficeLocationList = []entity.UserOfficeLocation{}
+		pg                     = entity.Pagination{}
+	)
+
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return userOfficeLocationList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get userOfficeLocation list from redis
+	marshalledUserOfficeLocationList, err := u.redis.Get(ctx, fmt.Sprintf(getUserOfficeLocationByQueryKey, string(keyValue)))
+	if err != nil {
+		return userOfficeLocationList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserOfficeLocationList), &userOfficeLocationList)
+	if err != nil {
+		return userOfficeLocationList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := u.redis.Get(ctx, fmt.Sprintf(getUserOfficeLocationByPaginationKey, string(keyValue)))
+	if err != nil {
+		return userOfficeLocationList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return userOfficeLocationList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userOfficeLocationList, pg, nil
+}
+
+func (u *userOfficeLocation) deleteCache(ctx context.Context, key string) error {
+	err := u.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package user_office_location
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (u *userOfficeLocation) getSQL(ctx context.Context, param entity.UserOfficeLocationParam) (entity.UserOfficeLocation, error) {
+	userOfficeLocation := entity.UserOfficeLocation{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user office location with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := u.db.QueryRow(ctx, "rUserOfficeLocation", readUserOfficeLocation+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&userOfficeLocation); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user office location with body: %v", param))
+
+	return userOfficeLocation, nil
+}
+
+func (u *userOfficeLocation) getListSQL(ctx context.Context, param entity.UserOfficeLocationParam) ([]entity.UserOfficeLocation, *entity.Pagination, error) {
+	userOfficeLocationList := []entity.UserOfficeLocation{}
+	pg := entity.Pagination{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user office location list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return userOfficeLocationList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := u.db.Query(ctx, "rUserOfficeLocationList", readUserOfficeLocation+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userOfficeLocationList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		userOfficeLocation := entity.UserOfficeLocation{}
+		err := rows.StructScan(&userOfficeLocation)
+		if err != nil {
+			u.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		userOfficeLocationList = append(userOfficeLocationList, userOfficeLocation)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(userOfficeLocationList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(userOfficeLocationList) > 0 {
+		err := u.db.Get(ctx, "cUserOfficeLocationList", countUserOfficeLocation+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return userOfficeLocationList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user office location list with body: %v", param))
+
+	return userOfficeLocationList, &pg, nil
+}
+
+func (u *userOfficeLocation) createSQL(ctx context.Context, inputParam entity.UserOfficeLocationInputParam) (entity.UserOfficeLocation, error) {
+	userOfficeLocation := entity.UserOfficeLocation{}
+
+	u.log.Debug(ctx, fmt.Sprintf("create user office location with body: %v", inputParam))
+
+	stmt, err := u.db.PrepareNamed(ctx, "iNewUserOfficeLocation", insertUserOfficeLocation)
+	if err != nil {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&userOfficeLocation, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return userOfficeLocation, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create user office location with body: %v", inputParam))
+
+	return userOfficeLocation, nil
+}
+
+func (u *userOfficeLocation) createManySQL(ctx context.Context, inputParams []entity.UserOfficeLocationInputParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("create many user office location with body: %v", inputParams))
+
+	res, err := u.db.NamedExec(ctx, "iManyUserOfficeLocation", insertManyUserOfficeLocation, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user office location created")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create many user office location with body: %v", inputParams))
+
+	return nil
+}
+
+func (u *userOfficeLocation) updateSQL(ctx context.Context, updateParam entity.UserOfficeLocationUpdateParam, selectParam entity.UserOfficeLocationParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("update user office location with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := u.db.Exec(ctx, "uUserOfficeLocation", updateUserOfficeLocation+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user office location updated")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success update user office location with body: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package user_salary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.UserSalaryParam) (entity.UserSalary, error)
+	GetList(ctx context.Context, param entity.UserSalaryParam) ([]entity.UserSalary, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.UserSalaryInputParam) (entity.UserSalary, error)
+	CreateMany(ctx context.Context, inputParams []entity.UserSalaryInputParam) error
+	Update(ctx context.Context, updateParam entity.UserSalaryUpdateParam, selectParam entity.UserSalaryParam) error
+}
+
+type userSalary struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &userSalary{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (u *userSalary) Get(ctx context.Context, param entity.UserSalaryParam) (entity.UserSalary, error) {
+	userSalary := entity.UserSalary{}
+
+	marshalledParam, err := u.json.Marshal(param)
+	if err != nil {
+		return userSalary, err
+	}
+
+	if !param.BypassCache {
+		userSalary, err = u.getCache(ctx, fmt.Sprintf(getUserSalaryByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userSalary, nil
+		}
+	}
+
+	userSalary, err = u.getSQL(ctx, param)
+	if err != nil {
+		return userSalary, err
+	}
+
+	err = u.upsertCache(ctx, fmt.Sprintf(getUserSalaryByKey, string(marshalledParam)), userSalary, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userSalary, nil
+}
+
+func (u *userSalary) GetList(ctx context.Context, param entity.UserSalaryParam) ([]entity.UserSalary, *entity.Pagination, error) {
+	if !param.BypassCache {
+		userSalaryList, pg, err := u.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userSalaryList, &pg, nil
+		}
+	}
+
+	userSalaryList, pg, err := u.getListSQL(ctx, param)
+	if err != nil {
+		return userSalaryList, pg, err
+	}
+
+	err = u.upsertCacheList(ctx, param, userSalaryList, *pg, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userSalaryList, pg, nil
+}
+
+func (u *userSalary) Create(ctx context.Context, param entity.UserSalaryInputParam) (entity.UserSalary, error) {
+	userSalary, err := u.createSQL(ctx, param)
+	if err != nil {
+		return userSalary, err
+	}
+
+	err = u.deleteCache(ctx, deleteUserSalaryKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userSalary, nil
+}
+
+func (u *userSalary) CreateMany(ctx context.Context, inputParams []entity.UserSalaryInputParam) error {
+	err := u.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserSalaryKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (u *userSalary) Update(ctx context.Context, updateParam entity.UserSalaryUpdateParam, selectParam entity.UserSalaryParam) error {
+	err := u.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserSalaryKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package user_salary
+
+const (
+	insertUserSalary = `
+		INSERT INTO user_salaries (
+			fk_user_id,
+			base_salary,
+			effective_date,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:base_salary,
+			:effective_date,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyUserSalary = `
+		INSERT INTO user_salaries (
+			fk_user_id,
+			base_salary,
+			effective_date,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:base_salary,
+			:effective_date,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readUserSalary = `
+		SELECT
+			id,
+			fk_user_id,
+			base_salary,
+			effective_date,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			user_salaries
+	`
+
+	countUserSalary = `
+		SELECT
+			COUNT(*)
+		FROM
+			user_salaries
+	`
+
+	updateUserSalary = `
+		UPDATE
+			user_salaries
+	`
+)
@@ -0,0 +1,123 @@
+package user_salary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getUserSalaryByKey           = "employeePayroll:userSalary:get:%s"
+	getUserSalaryByQueryKey      = "employeePayroll:userSalary:get:q:%s"
+	getUserSalaryByPaginationKey = "employeePayroll:userSalary:get:p:%s"
+	deleteUserSalaryKeysPattern  = "employeePayroll:userSalary*"
+)
+
+func (u *userSalary) upsertCache(ctx context.Context, key string, userSalary entity.UserSalary, ttl time.Duration) error {
+	marshalledUserSalary, err := u.json.Marshal(userSalary)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, key, string(marshalledUserSalary), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userSalary) getCache(ctx context.Context, key string) (entity.UserSalary, error) {
+	userSalary := entity.UserSalary{}
+
+	marshalledUserSalary, err := u.redis.Get(ctx, key)
+	if err != nil {
+		return userSalary, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserSalary), &userSalary)
+	if err != nil {
+		return userSalary, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userSalary, nil
+}
+
+func (u *userSalary) upsertCacheList(ctx context.Context, param entity.UserSalaryParam, userSalaryList []entity.UserSalary, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set userSalary list to cache
+	marshalledUserSalaryList, err := u.json.Marshal(userSalaryList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserSalaryByQueryKey, string(keyValue)), string(marshalledUserSalaryList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := u.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserSalaryByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userSalary) getCacheList(ctx context.Context, param entity.UserSalaryParam) ([]entity.UserSalary, entity.Pagination, error) {
+	var (
+		userSalaryList = []entity.UserSalary{}
+		pg             = entity.Pagination{}
+	)
+
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return userSalaryList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get userSalary list from redis
+	marshalledUserSalaryList, err := u.redis.Get(ctx, fmt.Sprintf(getUserSalaryByQueryKey, string(keyValue)))
+	if err != nil {
+		return userSalaryList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserSalaryList), &userSalaryList)
+	if err != nil {
+		return userSalaryList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := u.redis.Get(ctx, fmt.Sprintf(getUserSalaryByPaginationKey, string(keyValue)))
+	if err != nil {
+		return userSalaryList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return userSalaryList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userSalaryList, pg, nil
+}
+
+func (u *userSalary) deleteCache(ctx context.Context, key string) error {
+	err := u.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
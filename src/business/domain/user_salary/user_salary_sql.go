@@ -0,0 +1,169 @@
+package user_salary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (u *userSalary) getSQL(ctx context.Context, param entity.UserSalaryParam) (entity.UserSalary, error) {
+	userSalary := entity.UserSalary{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user salary with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return userSalary, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := u.db.QueryRow(ctx, "rUserSalary", readUserSalary+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userSalary, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&userSalary); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return userSalary, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return userSalary, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user salary with body: %v", param))
+
+	return userSalary, nil
+}
+
+func (u *userSalary) getListSQL(ctx context.Context, param entity.UserSalaryParam) ([]entity.UserSalary, *entity.Pagination, error) {
+	userSalaryList := []entity.UserSalary{}
+	pg := entity.Pagination{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user salary list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return userSalaryList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := u.db.Query(ctx, "rUserSalaryList", readUserSalary+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userSalaryList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		userSalary := entity.UserSalary{}
+		err := rows.StructScan(&userSalary)
+		if err != nil {
+			u.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		userSalaryList = append(userSalaryList, userSalary)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(userSalaryList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(userSalaryList) > 0 {
+		err := u.db.Get(ctx, "cUserSalaryList", countUserSalary+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return userSalaryList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user salary list with body: %v", param))
+
+	return userSalaryList, &pg, nil
+}
+
+func (u *userSalary) createSQL(ctx context.Context, inputParam entity.UserSalaryInputParam) (entity.UserSalary, error) {
+	userSalary := entity.UserSalary{}
+
+	u.log.Debug(ctx, fmt.Sprintf("create user salary with body: %v", inputParam))
+
+	stmt, err := u.db.PrepareNamed(ctx, "iNewUserSalary", insertUserSalary)
+	if err != nil {
+		return userSalary, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&userSalary, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return userSalary, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return userSalary, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create user salary with body: %v", inputParam))
+
+	return userSalary, nil
+}
+
+func (u *userSalary) createManySQL(ctx context.Context, inputParams []entity.UserSalaryInputParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("create many user salary with body: %v", inputParams))
+
+	res, err := u.db.NamedExec(ctx, "iManyUserSalary", insertManyUserSalary, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user salary created")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create many user salary with body: %v", inputParams))
+
+	return nil
+}
+
+func (u *userSalary) updateSQL(ctx context.Context, updateParam entity.UserSalaryUpdateParam, selectParam entity.UserSalaryParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("update user salary with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := u.db.Exec(ctx, "uUserSalary", updateUserSalary+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user salary updated")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success update user salary with body: %v", updateParam))
+
+	return nil
+}
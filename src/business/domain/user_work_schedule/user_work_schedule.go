@@ -0,0 +1,145 @@
+package user_work_schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.UserWorkScheduleParam) (entity.UserWorkSchedule, error)
+	GetList(ctx context.Context, param entity.UserWorkScheduleParam) ([]entity.UserWorkSchedule, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.UserWorkScheduleInputParam) (entity.UserWorkSchedule, error)
+	CreateMany(ctx context.Context, inputParams []entity.UserWorkScheduleInputParam) error
+	Update(ctx context.Context, updateParam entity.UserWorkScheduleUpdateParam, selectParam entity.UserWorkScheduleParam) error
+}
+
+type userWorkSchedule struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &userWorkSchedule{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (u *userWorkSchedule) Get(ctx context.Context, param entity.UserWorkScheduleParam) (entity.UserWorkSchedule, error) {
+	userWorkSchedule := entity.UserWorkSchedule{}
+
+	marshalledParam, err := u.json.Marshal(param)
+	if err != nil {
+		return userWorkSchedule, err
+	}
+
+	if !param.BypassCache {
+		userWorkSchedule, err = u.getCache(ctx, fmt.Sprintf(getUserWorkScheduleByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userWorkSchedule, nil
+		}
+	}
+
+	userWorkSchedule, err = u.getSQL(ctx, param)
+	if err != nil {
+		return userWorkSchedule, err
+	}
+
+	err = u.upsertCache(ctx, fmt.Sprintf(getUserWorkScheduleByKey, string(marshalledParam)), userWorkSchedule, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userWorkSchedule, nil
+}
+
+func (u *userWorkSchedule) GetList(ctx context.Context, param entity.UserWorkScheduleParam) ([]entity.UserWorkSchedule, *entity.Pagination, error) {
+	if !param.BypassCache {
+		userWorkScheduleList, pg, err := u.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			u.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return userWorkScheduleList, &pg, nil
+		}
+	}
+
+	userWorkScheduleList, pg, err := u.getListSQL(ctx, param)
+	if err != nil {
+		return userWorkScheduleList, pg, err
+	}
+
+	err = u.upsertCacheList(ctx, param, userWorkScheduleList, *pg, u.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userWorkScheduleList, pg, nil
+}
+
+func (u *userWorkSchedule) Create(ctx context.Context, param entity.UserWorkScheduleInputParam) (entity.UserWorkSchedule, error) {
+	userWorkSchedule, err := u.createSQL(ctx, param)
+	if err != nil {
+		return userWorkSchedule, err
+	}
+
+	err = u.deleteCache(ctx, deleteUserWorkScheduleKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return userWorkSchedule, nil
+}
+
+func (u *userWorkSchedule) CreateMany(ctx context.Context, inputParams []entity.UserWorkScheduleInputParam) error {
+	err := u.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserWorkScheduleKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (u *userWorkSchedule) Update(ctx context.Context, updateParam entity.UserWorkScheduleUpdateParam, selectParam entity.UserWorkScheduleParam) error {
+	err := u.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = u.deleteCache(ctx, deleteUserWorkScheduleKeysPattern)
+	if err != nil {
+		u.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package user_work_schedule
+
+const (
+	insertUserWorkSchedule = `
+		INSERT INTO user_work_schedules (
+			fk_user_id,
+			fk_work_schedule_id,
+			effective_date,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:fk_work_schedule_id,
+			:effective_date,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyUserWorkSchedule = `
+		INSERT INTO user_work_schedules (
+			fk_user_id,
+			fk_work_schedule_id,
+			effective_date,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:fk_work_schedule_id,
+			:effective_date,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readUserWorkSchedule = `
+		SELECT
+			id,
+			fk_user_id,
+			fk_work_schedule_id,
+			effective_date,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			user_work_schedules
+	`
+
+	countUserWorkSchedule = `
+		SELECT
+			COUNT(*)
+		FROM
+			user_work_schedules
+	`
+
+	updateUserWorkSchedule = `
+		UPDATE
+			user_work_schedules
+	`
+)
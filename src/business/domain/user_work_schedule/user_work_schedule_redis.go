@@ -0,0 +1,123 @@
+package user_work_schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getUserWorkScheduleByKey           = "employeePayroll:userWorkSchedule:get:%s"
+	getUserWorkScheduleByQueryKey      = "employeePayroll:userWorkSchedule:get:q:%s"
+	getUserWorkScheduleByPaginationKey = "employeePayroll:userWorkSchedule:get:p:%s"
+	deleteUserWorkScheduleKeysPattern  = "employeePayroll:userWorkSchedule*"
+)
+
+func (u *userWorkSchedule) upsertCache(ctx context.Context, key string, userWorkSchedule entity.UserWorkSchedule, ttl time.Duration) error {
+	marshalledUserWorkSchedule, err := u.json.Marshal(userWorkSchedule)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, key, string(marshalledUserWorkSchedule), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userWorkSchedule) getCache(ctx context.Context, key string) (entity.UserWorkSchedule, error) {
+	userWorkSchedule := entity.UserWorkSchedule{}
+
+	marshalledUserWorkSchedule, err := u.redis.Get(ctx, key)
+	if err != nil {
+		return userWorkSchedule, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserWorkSchedule), &userWorkSchedule)
+	if err != nil {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userWorkSchedule, nil
+}
+
+func (u *userWorkSchedule) upsertCacheList(ctx context.Context, param entity.UserWorkScheduleParam, userWorkScheduleList []entity.UserWorkSchedule, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set userWorkSchedule list to cache
+	marshalledUserWorkScheduleList, err := u.json.Marshal(userWorkScheduleList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserWorkScheduleByQueryKey, string(keyValue)), string(marshalledUserWorkScheduleList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := u.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = u.redis.SetEX(ctx, fmt.Sprintf(getUserWorkScheduleByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (u *userWorkSchedule) getCacheList(ctx context.Context, param entity.UserWorkScheduleParam) ([]entity.UserWorkSchedule, entity.Pagination, error) {
+	var (
+		userWorkScheduleList = []entity.UserWorkSchedule{}
+		pg                   = entity.Pagination{}
+	)
+
+	keyValue, err := u.json.Marshal(param)
+	if err != nil {
+		return userWorkScheduleList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get userWorkSchedule list from redis
+	marshalledUserWorkScheduleList, err := u.redis.Get(ctx, fmt.Sprintf(getUserWorkScheduleByQueryKey, string(keyValue)))
+	if err != nil {
+		return userWorkScheduleList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledUserWorkScheduleList), &userWorkScheduleList)
+	if err != nil {
+		return userWorkScheduleList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := u.redis.Get(ctx, fmt.Sprintf(getUserWorkScheduleByPaginationKey, string(keyValue)))
+	if err != nil {
+		return userWorkScheduleList, pg, err
+	}
+
+	err = u.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return userWorkScheduleList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return userWorkScheduleList, pg, nil
+}
+
+func (u *userWorkSchedule) deleteCache(ctx context.Context, key string) error {
+	err := u.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package user_work_schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (u *userWorkSchedule) getSQL(ctx context.Context, param entity.UserWorkScheduleParam) (entity.UserWorkSchedule, error) {
+	userWorkSchedule := entity.UserWorkSchedule{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user work schedule with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := u.db.QueryRow(ctx, "rUserWorkSchedule", readUserWorkSchedule+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&userWorkSchedule); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user work schedule with body: %v", param))
+
+	return userWorkSchedule, nil
+}
+
+func (u *userWorkSchedule) getListSQL(ctx context.Context, param entity.UserWorkScheduleParam) ([]entity.UserWorkSchedule, *entity.Pagination, error) {
+	userWorkScheduleList := []entity.UserWorkSchedule{}
+	pg := entity.Pagination{}
+
+	u.log.Debug(ctx, fmt.Sprintf("get user work schedule list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return userWorkScheduleList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := u.db.Query(ctx, "rUserWorkScheduleList", readUserWorkSchedule+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userWorkScheduleList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		userWorkSchedule := entity.UserWorkSchedule{}
+		err := rows.StructScan(&userWorkSchedule)
+		if err != nil {
+			u.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		userWorkScheduleList = append(userWorkScheduleList, userWorkSchedule)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(userWorkScheduleList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(userWorkScheduleList) > 0 {
+		err := u.db.Get(ctx, "cUserWorkScheduleList", countUserWorkSchedule+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return userWorkScheduleList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	u.log.Debug(ctx, fmt.Sprintf("success get user work schedule list with body: %v", param))
+
+	return userWorkScheduleList, &pg, nil
+}
+
+func (u *userWorkSchedule) createSQL(ctx context.Context, inputParam entity.UserWorkScheduleInputParam) (entity.UserWorkSchedule, error) {
+	userWorkSchedule := entity.UserWorkSchedule{}
+
+	u.log.Debug(ctx, fmt.Sprintf("create user work schedule with body: %v", inputParam))
+
+	stmt, err := u.db.PrepareNamed(ctx, "iNewUserWorkSchedule", insertUserWorkSchedule)
+	if err != nil {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&userWorkSchedule, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return userWorkSchedule, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create user work schedule with body: %v", inputParam))
+
+	return userWorkSchedule, nil
+}
+
+func (u *userWorkSchedule) createManySQL(ctx context.Context, inputParams []entity.UserWorkScheduleInputParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("create many user work schedule with body: %v", inputParams))
+
+	res, err := u.db.NamedExec(ctx, "iManyUserWorkSchedule", insertManyUserWorkSchedule, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user work schedule created")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success create many user work schedule with body: %v", inputParams))
+
+	return nil
+}
+
+func (u *userWorkSchedule) updateSQL(ctx context.Context, updateParam entity.UserWorkScheduleUpdateParam, selectParam entity.UserWorkScheduleParam) error {
+	u.log.Debug(ctx, fmt.Sprintf("update user work schedule with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(u.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := u.db.Exec(ctx, "uUserWorkSchedule", updateUserWorkSchedule+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no user work schedule updated")
+	}
+
+	u.log.Debug(ctx, fmt.Sprintf("success update user work schedule with body: %v", updateParam))
+
+	return nil
+}
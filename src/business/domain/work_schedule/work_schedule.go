@@ -0,0 +1,145 @@
+package work_schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.WorkScheduleParam) (entity.WorkSchedule, error)
+	GetList(ctx context.Context, param entity.WorkScheduleParam) ([]entity.WorkSchedule, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.WorkScheduleInputParam) (entity.WorkSchedule, error)
+	CreateMany(ctx context.Context, inputParams []entity.WorkScheduleInputParam) error
+	Update(ctx context.Context, updateParam entity.WorkScheduleUpdateParam, selectParam entity.WorkScheduleParam) error
+}
+
+type workSchedule struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &workSchedule{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (w *workSchedule) Get(ctx context.Context, param entity.WorkScheduleParam) (entity.WorkSchedule, error) {
+	workSchedule := entity.WorkSchedule{}
+
+	marshalledParam, err := w.json.Marshal(param)
+	if err != nil {
+		return workSchedule, err
+	}
+
+	if !param.BypassCache {
+		workSchedule, err = w.getCache(ctx, fmt.Sprintf(getWorkScheduleByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			w.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			w.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return workSchedule, nil
+		}
+	}
+
+	workSchedule, err = w.getSQL(ctx, param)
+	if err != nil {
+		return workSchedule, err
+	}
+
+	err = w.upsertCache(ctx, fmt.Sprintf(getWorkScheduleByKey, string(marshalledParam)), workSchedule, w.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		w.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return workSchedule, nil
+}
+
+func (w *workSchedule) GetList(ctx context.Context, param entity.WorkScheduleParam) ([]entity.WorkSchedule, *entity.Pagination, error) {
+	if !param.BypassCache {
+		workScheduleList, pg, err := w.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			w.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			w.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return workScheduleList, &pg, nil
+		}
+	}
+
+	workScheduleList, pg, err := w.getListSQL(ctx, param)
+	if err != nil {
+		return workScheduleList, pg, err
+	}
+
+	err = w.upsertCacheList(ctx, param, workScheduleList, *pg, w.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		w.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return workScheduleList, pg, nil
+}
+
+func (w *workSchedule) Create(ctx context.Context, param entity.WorkScheduleInputParam) (entity.WorkSchedule, error) {
+	workSchedule, err := w.createSQL(ctx, param)
+	if err != nil {
+		return workSchedule, err
+	}
+
+	err = w.deleteCache(ctx, deleteWorkScheduleKeysPattern)
+	if err != nil {
+		w.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return workSchedule, nil
+}
+
+func (w *workSchedule) CreateMany(ctx context.Context, inputParams []entity.WorkScheduleInputParam) error {
+	err := w.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = w.deleteCache(ctx, deleteWorkScheduleKeysPattern)
+	if err != nil {
+		w.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (w *workSchedule) Update(ctx context.Context, updateParam entity.WorkScheduleUpdateParam, selectParam entity.WorkScheduleParam) error {
+	err := w.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = w.deleteCache(ctx, deleteWorkScheduleKeysPattern)
+	if err != nil {
+		w.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package work_schedule
+
+const (
+	insertWorkSchedule = `
+		INSERT INTO work_schedules (
+			name,
+			work_days,
+			shift_start,
+			shift_end,
+			time_zone,
+			grace_minutes,
+			penalty_type,
+			penalty_per_minute,
+			half_day_after_lates,
+			created_at,
+			created_by
+		) VALUES (
+			:name,
+			:work_days,
+			:shift_start,
+			:shift_end,
+			:time_zone,
+			:grace_minutes,
+			:penalty_type,
+			:penalty_per_minute,
+			:half_day_after_lates,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyWorkSchedule = `
+		INSERT INTO work_schedules (
+			name,
+			work_days,
+			shift_start,
+			shift_end,
+			time_zone,
+			grace_minutes,
+			penalty_type,
+			penalty_per_minute,
+			half_day_after_lates,
+			created_at,
+			created_by
+		) VALUES (
+			:name,
+			:work_days,
+			:shift_start,
+			:shift_end,
+			:time_zone,
+			:grace_minutes,
+			:penalty_type,
+			:penalty_per_minute,
+			:half_day_after_lates,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readWorkSchedule = `
+		SELECT
+			id,
+			name,
+			work_days,
+			shift_start,
+			shift_end,
+			time_zone,
+			grace_minutes,
+			penalty_type,
+			penalty_per_minute,
+			half_day_after_lates,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			work_schedules
+	`
+
+	countWorkSchedule = `
+		SELECT
+			COUNT(*)
+		FROM
+			work_schedules
+	`
+
+	updateWorkSchedule = `
+		UPDATE
+			work_schedules
+	`
+)
@@ -0,0 +1,123 @@
+package work_schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getWorkScheduleByKey           = "employeePayroll:workSchedule:get:%s"
+	getWorkScheduleByQueryKey      = "employeePayroll:workSchedule:get:q:%s"
+	getWorkScheduleByPaginationKey = "employeePayroll:workSchedule:get:p:%s"
+	deleteWorkScheduleKeysPattern  = "employeePayroll:workSchedule*"
+)
+
+func (w *workSchedule) upsertCache(ctx context.Context, key string, workSchedule entity.WorkSchedule, ttl time.Duration) error {
+	marshalledWorkSchedule, err := w.json.Marshal(workSchedule)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = w.redis.SetEX(ctx, key, string(marshalledWorkSchedule), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (w *workSchedule) getCache(ctx context.Context, key string) (entity.WorkSchedule, error) {
+	workSchedule := entity.WorkSchedule{}
+
+	marshalledWorkSchedule, err := w.redis.Get(ctx, key)
+	if err != nil {
+		return workSchedule, err
+	}
+
+	err = w.json.Unmarshal([]byte(marshalledWorkSchedule), &workSchedule)
+	if err != nil {
+		return workSchedule, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return workSchedule, nil
+}
+
+func (w *workSchedule) upsertCacheList(ctx context.Context, param entity.WorkScheduleParam, workScheduleList []entity.WorkSchedule, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := w.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set workSchedule list to cache
+	marshalledWorkScheduleList, err := w.json.Marshal(workScheduleList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = w.redis.SetEX(ctx, fmt.Sprintf(getWorkScheduleByQueryKey, string(keyValue)), string(marshalledWorkScheduleList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := w.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = w.redis.SetEX(ctx, fmt.Sprintf(getWorkScheduleByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (w *workSchedule) getCacheList(ctx context.Context, param entity.WorkScheduleParam) ([]entity.WorkSchedule, entity.Pagination, error) {
+	var (
+		workScheduleList = []entity.WorkSchedule{}
+		pg               = entity.Pagination{}
+	)
+
+	keyValue, err := w.json.Marshal(param)
+	if err != nil {
+		return workScheduleList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get workSchedule list from redis
+	marshalledWorkScheduleList, err := w.redis.Get(ctx, fmt.Sprintf(getWorkScheduleByQueryKey, string(keyValue)))
+	if err != nil {
+		return workScheduleList, pg, err
+	}
+
+	err = w.json.Unmarshal([]byte(marshalledWorkScheduleList), &workScheduleList)
+	if err != nil {
+		return workScheduleList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := w.redis.Get(ctx, fmt.Sprintf(getWorkScheduleByPaginationKey, string(keyValue)))
+	if err != nil {
+		return workScheduleList, pg, err
+	}
+
+	err = w.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return workScheduleList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return workScheduleList, pg, nil
+}
+
+func (w *workSchedule) deleteCache(ctx context.Context, key string) error {
+	err := w.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package work_schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (w *workSchedule) getSQL(ctx context.Context, param entity.WorkScheduleParam) (entity.WorkSchedule, error) {
+	workSchedule := entity.WorkSchedule{}
+
+	w.log.Debug(ctx, fmt.Sprintf("get work schedule with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(w.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return workSchedule, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := w.db.QueryRow(ctx, "rWorkSchedule", readWorkSchedule+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return workSchedule, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&workSchedule); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return workSchedule, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return workSchedule, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	w.log.Debug(ctx, fmt.Sprintf("success get work schedule with body: %v", param))
+
+	return workSchedule, nil
+}
+
+func (w *workSchedule) getListSQL(ctx context.Context, param entity.WorkScheduleParam) ([]entity.WorkSchedule, *entity.Pagination, error) {
+	workScheduleList := []entity.WorkSchedule{}
+	pg := entity.Pagination{}
+
+	w.log.Debug(ctx, fmt.Sprintf("get work schedule list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(w.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return workScheduleList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := w.db.Query(ctx, "rWorkScheduleList", readWorkSchedule+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return workScheduleList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		workSchedule := entity.WorkSchedule{}
+		err := rows.StructScan(&workSchedule)
+		if err != nil {
+			w.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		workScheduleList = append(workScheduleList, workSchedule)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(workScheduleList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(workScheduleList) > 0 {
+		err := w.db.Get(ctx, "cWorkScheduleList", countWorkSchedule+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return workScheduleList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	w.log.Debug(ctx, fmt.Sprintf("success get work schedule list with body: %v", param))
+
+	return workScheduleList, &pg, nil
+}
+
+func (w *workSchedule) createSQL(ctx context.Context, inputParam entity.WorkScheduleInputParam) (entity.WorkSchedule, error) {
+	workSchedule := entity.WorkSchedule{}
+
+	w.log.Debug(ctx, fmt.Sprintf("create work schedule with body: %v", inputParam))
+
+	stmt, err := w.db.PrepareNamed(ctx, "iNewWorkSchedule", insertWorkSchedule)
+	if err != nil {
+		return workSchedule, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&workSchedule, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return workSchedule, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return workSchedule, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	w.log.Debug(ctx, fmt.Sprintf("success create work schedule with body: %v", inputParam))
+
+	return workSchedule, nil
+}
+
+func (w *workSchedule) createManySQL(ctx context.Context, inputParams []entity.WorkScheduleInputParam) error {
+	w.log.Debug(ctx, fmt.Sprintf("create many work schedule with body: %v", inputParams))
+
+	res, err := w.db.NamedExec(ctx, "iManyWorkSchedule", insertManyWorkSchedule, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no work schedule created")
+	}
+
+	w.log.Debug(ctx, fmt.Sprintf("success create many work schedule with body: %v", inputParams))
+
+	return nil
+}
+
+func (w *workSchedule) updateSQL(ctx context.Context, updateParam entity.WorkScheduleUpdateParam, selectParam entity.WorkScheduleParam) error {
+	w.log.Debug(ctx, fmt.Sprintf("update work schedule with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(w.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := w.db.Exec(ctx, "uWorkSchedule", updateWorkSchedule+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no work schedule updated")
+	}
+
+	w.log.Debug(ctx, fmt.Sprintf("success update work schedule with body: %v", updateParam))
+
+	return nil
+}
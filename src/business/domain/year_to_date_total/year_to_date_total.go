@@ -0,0 +1,145 @@
+package year_to_date_total
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type Interface interface {
+	Get(ctx context.Context, param entity.YearToDateTotalParam) (entity.YearToDateTotal, error)
+	GetList(ctx context.Context, param entity.YearToDateTotalParam) ([]entity.YearToDateTotal, *entity.Pagination, error)
+	Create(ctx context.Context, param entity.YearToDateTotalInputParam) (entity.YearToDateTotal, error)
+	CreateMany(ctx context.Context, inputParams []entity.YearToDateTotalInputParam) error
+	Update(ctx context.Context, updateParam entity.YearToDateTotalUpdateParam, selectParam entity.YearToDateTotalParam) error
+}
+
+type yearToDateTotal struct {
+	db    sql.Interface
+	log   log.Interface
+	redis redis.Interface
+	json  parser.JSONInterface
+}
+
+type InitParam struct {
+	Db    sql.Interface
+	Log   log.Interface
+	Redis redis.Interface
+	Json  parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	return &yearToDateTotal{
+		db:    param.Db,
+		log:   param.Log,
+		redis: param.Redis,
+		json:  param.Json,
+	}
+}
+
+func (y *yearToDateTotal) Get(ctx context.Context, param entity.YearToDateTotalParam) (entity.YearToDateTotal, error) {
+	yearToDateTotal := entity.YearToDateTotal{}
+
+	marshalledParam, err := y.json.Marshal(param)
+	if err != nil {
+		return yearToDateTotal, err
+	}
+
+	if !param.BypassCache {
+		yearToDateTotal, err = y.getCache(ctx, fmt.Sprintf(getYearToDateTotalByKey, string(marshalledParam)))
+		switch {
+		case errors.Is(err, redis.Nil):
+			y.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			y.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return yearToDateTotal, nil
+		}
+	}
+
+	yearToDateTotal, err = y.getSQL(ctx, param)
+	if err != nil {
+		return yearToDateTotal, err
+	}
+
+	err = y.upsertCache(ctx, fmt.Sprintf(getYearToDateTotalByKey, string(marshalledParam)), yearToDateTotal, y.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		y.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return yearToDateTotal, nil
+}
+
+func (y *yearToDateTotal) GetList(ctx context.Context, param entity.YearToDateTotalParam) ([]entity.YearToDateTotal, *entity.Pagination, error) {
+	if !param.BypassCache {
+		yearToDateTotalList, pg, err := y.getCacheList(ctx, param)
+		switch {
+		case errors.Is(err, redis.Nil):
+			y.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedisNil, err.Error()))
+		case err != nil:
+			y.log.Warn(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+		default:
+			return yearToDateTotalList, &pg, nil
+		}
+	}
+
+	yearToDateTotalList, pg, err := y.getListSQL(ctx, param)
+	if err != nil {
+		return yearToDateTotalList, pg, err
+	}
+
+	err = y.upsertCacheList(ctx, param, yearToDateTotalList, *pg, y.redis.GetDefaultTTL(ctx))
+	if err != nil {
+		y.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return yearToDateTotalList, pg, nil
+}
+
+func (y *yearToDateTotal) Create(ctx context.Context, param entity.YearToDateTotalInputParam) (entity.YearToDateTotal, error) {
+	yearToDateTotal, err := y.createSQL(ctx, param)
+	if err != nil {
+		return yearToDateTotal, err
+	}
+
+	err = y.deleteCache(ctx, deleteYearToDateTotalKeysPattern)
+	if err != nil {
+		y.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return yearToDateTotal, nil
+}
+
+func (y *yearToDateTotal) CreateMany(ctx context.Context, inputParams []entity.YearToDateTotalInputParam) error {
+	err := y.createManySQL(ctx, inputParams)
+	if err != nil {
+		return err
+	}
+
+	err = y.deleteCache(ctx, deleteYearToDateTotalKeysPattern)
+	if err != nil {
+		y.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
+
+func (y *yearToDateTotal) Update(ctx context.Context, updateParam entity.YearToDateTotalUpdateParam, selectParam entity.YearToDateTotalParam) error {
+	err := y.updateSQL(ctx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	err = y.deleteCache(ctx, deleteYearToDateTotalKeysPattern)
+	if err != nil {
+		y.log.Error(ctx, fmt.Sprintf(entity.ErrorRedis, err.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package year_to_date_total
+
+const (
+	insertYearToDateTotal = `
+		INSERT INTO year_to_date_totals (
+			fk_user_id,
+			year,
+			gross_earnings,
+			tax_deduction,
+			employee_contribution,
+			employer_contribution,
+			total_take_home_pay,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:year,
+			:gross_earnings,
+			:tax_deduction,
+			:employee_contribution,
+			:employer_contribution,
+			:total_take_home_pay,
+			:created_at,
+			:created_by
+		) RETURNING *
+	`
+
+	insertManyYearToDateTotal = `
+		INSERT INTO year_to_date_totals (
+			fk_user_id,
+			year,
+			gross_earnings,
+			tax_deduction,
+			employee_contribution,
+			employer_contribution,
+			total_take_home_pay,
+			created_at,
+			created_by
+		) VALUES (
+			:fk_user_id,
+			:year,
+			:gross_earnings,
+			:tax_deduction,
+			:employee_contribution,
+			:employer_contribution,
+			:total_take_home_pay,
+			:created_at,
+			:created_by
+		)
+	`
+
+	readYearToDateTotal = `
+		SELECT
+			id,
+			fk_user_id,
+			year,
+			gross_earnings,
+			tax_deduction,
+			employee_contribution,
+			employer_contribution,
+			total_take_home_pay,
+			status,
+			flag,
+			meta,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by,
+			deleted_at,
+			deleted_by
+		FROM
+			year_to_date_totals
+	`
+
+	countYearToDateTotal = `
+		SELECT
+			COUNT(*)
+		FROM
+			year_to_date_totals
+	`
+
+	updateYearToDateTotal = `
+		UPDATE
+			year_to_date_totals
+	`
+)
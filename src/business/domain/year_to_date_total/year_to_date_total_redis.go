@@ -0,0 +1,123 @@
+package year_to_date_total
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+const (
+	getYearToDateTotalByKey           = "employeePayroll:yearToDateTotal:get:%s"
+	getYearToDateTotalByQueryKey      = "employeePayroll:yearToDateTotal:get:q:%s"
+	getYearToDateTotalByPaginationKey = "employeePayroll:yearToDateTotal:get:p:%s"
+	deleteYearToDateTotalKeysPattern  = "employeePayroll:yearToDateTotal*"
+)
+
+func (y *yearToDateTotal) upsertCache(ctx context.Context, key string, yearToDateTotal entity.YearToDateTotal, ttl time.Duration) error {
+	marshalledYearToDateTotal, err := y.json.Marshal(yearToDateTotal)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = y.redis.SetEX(ctx, key, string(marshalledYearToDateTotal), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (y *yearToDateTotal) getCache(ctx context.Context, key string) (entity.YearToDateTotal, error) {
+	yearToDateTotal := entity.YearToDateTotal{}
+
+	marshalledYearToDateTotal, err := y.redis.Get(ctx, key)
+	if err != nil {
+		return yearToDateTotal, err
+	}
+
+	err = y.json.Unmarshal([]byte(marshalledYearToDateTotal), &yearToDateTotal)
+	if err != nil {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return yearToDateTotal, nil
+}
+
+func (y *yearToDateTotal) upsertCacheList(ctx context.Context, param entity.YearToDateTotalParam, yearToDateTotalList []entity.YearToDateTotal, pg entity.Pagination, ttl time.Duration) error {
+	keyValue, err := y.json.Marshal(param)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Set yearToDateTotal list to cache
+	marshalledYearToDateTotalList, err := y.json.Marshal(yearToDateTotalList)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+	err = y.redis.SetEX(ctx, fmt.Sprintf(getYearToDateTotalByQueryKey, string(keyValue)), string(marshalledYearToDateTotalList), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	// Set pagination to cache
+	marshalledPagination, err := y.json.Marshal(pg)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	err = y.redis.SetEX(ctx, fmt.Sprintf(getYearToDateTotalByPaginationKey, string(keyValue)), string(marshalledPagination), ttl)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeCacheSetSimpleKey, err.Error())
+	}
+
+	return nil
+}
+
+func (y *yearToDateTotal) getCacheList(ctx context.Context, param entity.YearToDateTotalParam) ([]entity.YearToDateTotal, entity.Pagination, error) {
+	var (
+		yearToDateTotalList = []entity.YearToDateTotal{}
+		pg                  = entity.Pagination{}
+	)
+
+	keyValue, err := y.json.Marshal(param)
+	if err != nil {
+		return yearToDateTotalList, pg, errors.NewWithCode(codes.CodeCacheMarshal, err.Error())
+	}
+
+	// Get yearToDateTotal list from redis
+	marshalledYearToDateTotalList, err := y.redis.Get(ctx, fmt.Sprintf(getYearToDateTotalByQueryKey, string(keyValue)))
+	if err != nil {
+		return yearToDateTotalList, pg, err
+	}
+
+	err = y.json.Unmarshal([]byte(marshalledYearToDateTotalList), &yearToDateTotalList)
+	if err != nil {
+		return yearToDateTotalList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	// Get pagination from redis
+	marshalledPagination, err := y.redis.Get(ctx, fmt.Sprintf(getYearToDateTotalByPaginationKey, string(keyValue)))
+	if err != nil {
+		return yearToDateTotalList, pg, err
+	}
+
+	err = y.json.Unmarshal([]byte(marshalledPagination), &pg)
+	if err != nil {
+		return yearToDateTotalList, pg, errors.NewWithCode(codes.CodeCacheUnmarshal, err.Error())
+	}
+
+	return yearToDateTotalList, pg, nil
+}
+
+func (y *yearToDateTotal) deleteCache(ctx context.Context, key string) error {
+	err := y.redis.Del(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package year_to_date_total
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+func (y *yearToDateTotal) getSQL(ctx context.Context, param entity.YearToDateTotalParam) (entity.YearToDateTotal, error) {
+	yearToDateTotal := entity.YearToDateTotal{}
+
+	y.log.Debug(ctx, fmt.Sprintf("get year to date total with body: %v", param))
+
+	param.QueryOption.DisableLimit = true
+	qb := query.NewSQLQueryBuilder(y.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&param)
+	if err != nil {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := y.db.QueryRow(ctx, "rYearToDateTotal", readYearToDateTotal+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&yearToDateTotal); err != nil && errors.Is(err, sql.ErrNotFound) {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	} else if err != nil {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	y.log.Debug(ctx, fmt.Sprintf("success get year to date total with body: %v", param))
+
+	return yearToDateTotal, nil
+}
+
+func (y *yearToDateTotal) getListSQL(ctx context.Context, param entity.YearToDateTotalParam) ([]entity.YearToDateTotal, *entity.Pagination, error) {
+	yearToDateTotalList := []entity.YearToDateTotal{}
+	pg := entity.Pagination{}
+
+	y.log.Debug(ctx, fmt.Sprintf("get year to date total list with body: %v", param))
+
+	qb := query.NewSQLQueryBuilder(y.db, "param", "db", &param.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&param)
+	if err != nil {
+		return yearToDateTotalList, &pg, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := y.db.Query(ctx, "rYearToDateTotalList", readYearToDateTotal+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return yearToDateTotalList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		yearToDateTotal := entity.YearToDateTotal{}
+		err := rows.StructScan(&yearToDateTotal)
+		if err != nil {
+			y.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+
+		yearToDateTotalList = append(yearToDateTotalList, yearToDateTotal)
+	}
+
+	pg = entity.Pagination{
+		CurrentPage:     param.PaginationParam.Page,
+		CurrentElements: int64(len(yearToDateTotalList)),
+		SortBy:          param.SortBy,
+	}
+
+	if !param.QueryOption.DisableLimit && len(yearToDateTotalList) > 0 {
+		err := y.db.Get(ctx, "cYearToDateTotalList", countYearToDateTotal+countExt, &pg.TotalElements, countArgs...)
+		if err != nil {
+			return yearToDateTotalList, &pg, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(param.Limit)
+
+	y.log.Debug(ctx, fmt.Sprintf("success get year to date total list with body: %v", param))
+
+	return yearToDateTotalList, &pg, nil
+}
+
+func (y *yearToDateTotal) createSQL(ctx context.Context, inputParam entity.YearToDateTotalInputParam) (entity.YearToDateTotal, error) {
+	yearToDateTotal := entity.YearToDateTotal{}
+
+	y.log.Debug(ctx, fmt.Sprintf("create year to date total with body: %v", inputParam))
+
+	stmt, err := y.db.PrepareNamed(ctx, "iNewYearToDateTotal", insertYearToDateTotal)
+	if err != nil {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeSQLPrepareStmt, err.Error())
+	}
+	defer stmt.Close()
+
+	err = stmt.Get(&yearToDateTotal, inputParam)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return yearToDateTotal, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	y.log.Debug(ctx, fmt.Sprintf("success create year to date total with body: %v", inputParam))
+
+	return yearToDateTotal, nil
+}
+
+func (y *yearToDateTotal) createManySQL(ctx context.Context, inputParams []entity.YearToDateTotalInputParam) error {
+	y.log.Debug(ctx, fmt.Sprintf("create many year to date total with body: %v", inputParams))
+
+	res, err := y.db.NamedExec(ctx, "iManyYearToDateTotal", insertManyYearToDateTotal, inputParams)
+
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < int64(len(inputParams)) {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no year to date total created")
+	}
+
+	y.log.Debug(ctx, fmt.Sprintf("success create many year to date total with body: %v", inputParams))
+
+	return nil
+}
+
+func (y *yearToDateTotal) updateSQL(ctx context.Context, updateParam entity.YearToDateTotalUpdateParam, selectParam entity.YearToDateTotalParam) error {
+	y.log.Debug(ctx, fmt.Sprintf("update year to date total with body: %v", updateParam))
+
+	qb := query.NewSQLQueryBuilder(y.db, "param", "db", &selectParam.QueryOption)
+	queryUpdate, args, err := qb.BuildUpdate(entity.SQLUpdateParam(updateParam), &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := y.db.Exec(ctx, "uYearToDateTotal", updateYearToDateTotal+queryUpdate, args...)
+	pgErr := &pq.Error{}
+	if err != nil && errors.As(err, &pgErr) && pgErr.Code == entity.PSQLUniqueConstraintCode {
+		return errors.NewWithCode(codes.CodeSQLUniqueConstraint, err.Error())
+	} else if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no year to date total updated")
+	}
+
+	y.log.Debug(ctx, fmt.Sprintf("success update year to date total with body: %v", updateParam))
+
+	return nil
+}
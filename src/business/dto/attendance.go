@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// maxAttendanceHistoryLimit is the most attendances listed on a page of the attendance history.
+const maxAttendanceHistoryLimit = 100
+
+// CheckInParam holds where the employee works today and the coordinates they check in from. The coordinates are
+// required for an OFFICE attendance once office locations are defined.
+type CheckInParam struct {
+	// AttendanceType is the code of the attendance type, OFFICE when empty.
+	AttendanceType string       `json:"attendanceType" example:"OFFICE"`
+	Latitude       null.Float64 `json:"latitude" swaggertype:"number" example:"-6.2251"`
+	Longitude      null.Float64 `json:"longitude" swaggertype:"number" example:"106.7996"`
+}
+
+// HasCoordinates reports whether any coordinate is given.
+func (c *CheckInParam) HasCoordinates() bool {
+	return c.Latitude.Valid || c.Longitude.Valid
+}
+
+func (c *CheckInParam) Validate() error {
+	c.AttendanceType = normalizeAttendanceType(c.AttendanceType)
+
+	if !c.HasCoordinates() {
+		return nil
+	}
+
+	return validateCoordinates(c.Latitude, c.Longitude)
+}
+
+// normalizeAttendanceType returns the upper case code of the attendance type, OFFICE when it is empty.
+func normalizeAttendanceType(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return entity.AttendanceTypeCodeOffice
+	}
+
+	return code
+}
+
+// AttendanceRecord is the daily attendance record of an employee.
+type AttendanceRecord struct {
+	ID                 int64      `json:"id" example:"12"`
+	AttendancePeriodID int64      `json:"attendancePeriodId" example:"3"`
+	AttendanceDate     null.Date  `json:"attendanceDate" swaggertype:"string" example:"2025-06-09"`
+	AttendanceType     string     `json:"attendanceType" example:"OFFICE"`
+	CheckInAt          null.Time  `json:"checkInAt" swaggertype:"string" example:"2025-06-09T08:55:00Z"`
+	CheckOutAt         null.Time  `json:"checkOutAt" swaggertype:"string" example:"2025-06-09T17:40:00Z"`
+	WorkedHours        float64    `json:"workedHours" example:"8.75"`
+	MissingCheckOut    bool       `json:"missingCheckOut" example:"false"`
+	OfficeLocationID   null.Int64 `json:"officeLocationId" swaggertype:"integer" example:"1"`
+}
+
+// GetAttendancesParam filters the attendance history of an employee, the dates are formatted as YYYY-MM-DD.
+type GetAttendancesParam struct {
+	AttendancePeriodID int64  `form:"attendance_period_id"`
+	StartDate          string `form:"start_date"`
+	EndDate            string `form:"end_date"`
+	Page               int64  `form:"page"`
+	Limit              int64  `form:"limit"`
+}
+
+// ToAttendanceParam returns the filter of the attendances of the user, the latest day first.
+func (g *GetAttendancesParam) ToAttendanceParam(userID int64) (entity.AttendanceParam, error) {
+	param := entity.AttendanceParam{
+		UserID:             userID,
+		AttendancePeriodID: g.AttendancePeriodID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-attendance_date"},
+			Page:   g.Page,
+			Limit:  g.Limit,
+		},
+	}
+
+	if g.Limit > maxAttendanceHistoryLimit {
+		return entity.AttendanceParam{}, errors.NewWithCode(codes.CodeBadRequest, "limit cannot exceed %v", maxAttendanceHistoryLimit)
+	}
+
+	if g.StartDate != "" {
+		startDate, err := time.Parse(time.DateOnly, g.StartDate)
+		if err != nil {
+			return entity.AttendanceParam{}, errors.NewWithCode(codes.CodeBadRequest, "start_date must be formatted as YYYY-MM-DD")
+		}
+		param.AttendanceDateGTE = null.DateFrom(startDate)
+	}
+
+	if g.EndDate != "" {
+		endDate, err := time.Parse(time.DateOnly, g.EndDate)
+		if err != nil {
+			return entity.AttendanceParam{}, errors.NewWithCode(codes.CodeBadRequest, "end_date must be formatted as YYYY-MM-DD")
+		}
+		param.AttendanceDateLTE = null.DateFrom(endDate)
+	}
+
+	if param.AttendanceDateGTE.Valid && param.AttendanceDateLTE.Valid && param.AttendanceDateLTE.Time.Before(param.AttendanceDateGTE.Time) {
+		return entity.AttendanceParam{}, errors.NewWithCode(codes.CodeBadRequest, "end_date cannot be before start_date")
+	}
+
+	return param, nil
+}
+
+// AttendanceHistory is a page of the attendances of an employee, with how many days they attended in each
+// attendance period matching the filter.
+type AttendanceHistory struct {
+	Attendances  []AttendanceRecord      `json:"attendances"`
+	PeriodCounts []AttendancePeriodCount `json:"periodCounts"`
+}
+
+// AttendancePeriodCount is the number of days an employee attended in an attendance period.
+type AttendancePeriodCount struct {
+	AttendancePeriodID int64 `json:"attendancePeriodId" example:"3"`
+	AttendanceCount    int64 `json:"attendanceCount" example:"20"`
+}
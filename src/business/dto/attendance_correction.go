@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// CreateAttendanceCorrectionParam is the attendance an employee asks to be recorded for a past workday, the workday is
+// the date of the check-in.
+type CreateAttendanceCorrectionParam struct {
+	// AttendanceType is the code of the attendance type, OFFICE when empty.
+	AttendanceType string    `json:"attendanceType" example:"FIELD_VISIT"`
+	CheckInAt      null.Time `json:"checkInAt" swaggertype:"string" example:"2025-06-09T08:55:00+07:00"`
+	CheckOutAt     null.Time `json:"checkOutAt" swaggertype:"string" example:"2025-06-09T17:40:00+07:00"`
+	Reason         string    `json:"reason" example:"Forgot to check in after the client visit"`
+}
+
+func (c *CreateAttendanceCorrectionParam) Validate() error {
+	c.AttendanceType = normalizeAttendanceType(c.AttendanceType)
+
+	if !c.CheckInAt.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "checkInAt is required")
+	}
+
+	if c.CheckOutAt.Valid && !c.CheckOutAt.Time.After(c.CheckInAt.Time) {
+		return errors.NewWithCode(codes.CodeBadRequest, "checkOutAt must be after checkInAt")
+	}
+
+	if strings.TrimSpace(c.Reason) == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "reason is required")
+	}
+
+	if len(c.Reason) > 255 {
+		return errors.NewWithCode(codes.CodeBadRequest, "reason cannot exceed 255 characters")
+	}
+
+	return nil
+}
+
+func (c *CreateAttendanceCorrectionParam) ToAttendanceCorrectionInputParam(currentTime null.Time, userID int64, attendancePeriodID int64) entity.AttendanceCorrectionInputParam {
+	return entity.AttendanceCorrectionInputParam{
+		AttendancePeriodID: attendancePeriodID,
+		UserID:             userID,
+		AttendanceDate:     null.DateFrom(c.CheckInAt.Time),
+		AttendanceType:     c.AttendanceType,
+		CheckInAt:          c.CheckInAt,
+		CheckOutAt:         c.CheckOutAt,
+		Reason:             strings.TrimSpace(c.Reason),
+		CorrectionStatus:   entity.AttendanceCorrectionStatusPending,
+		CreatedAt:          currentTime,
+		CreatedBy:          null.Int64From(userID),
+	}
+}
+
+// RejectAttendanceCorrectionParam holds why the admin rejects an attendance correction.
+type RejectAttendanceCorrectionParam struct {
+	ReviewNote string `json:"reviewNote" example:"No client visit was scheduled on that day"`
+}
+
+func (r *RejectAttendanceCorrectionParam) Validate() error {
+	if strings.TrimSpace(r.ReviewNote) == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "reviewNote is required")
+	}
+
+	if len(r.ReviewNote) > 255 {
+		return errors.NewWithCode(codes.CodeBadRequest, "reviewNote cannot exceed 255 characters")
+	}
+
+	return nil
+}
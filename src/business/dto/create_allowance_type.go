@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"regexp"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var allowanceTypeCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+type CreateAllowanceTypeParam struct {
+	Code       string `json:"code" example:"TRANSPORT"`
+	Name       string `json:"name" example:"Transport Allowance"`
+	IsProrated bool   `json:"isProrated" example:"true"`
+}
+
+func (c *CreateAllowanceTypeParam) Validate() error {
+	if !allowanceTypeCodePattern.MatchString(c.Code) {
+		return errors.NewWithCode(codes.CodeBadRequest, "code must be upper case letters, digits and underscores")
+	}
+
+	if c.Name == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "name is required")
+	}
+
+	return nil
+}
+
+func (c *CreateAllowanceTypeParam) ToAllowanceTypeInputParam(currentTime null.Time, userID int64) entity.AllowanceTypeInputParam {
+	return entity.AllowanceTypeInputParam{
+		Code:       c.Code,
+		Name:       c.Name,
+		IsProrated: c.IsProrated,
+		CreatedAt:  currentTime,
+		CreatedBy:  null.Int64From(userID),
+	}
+}
@@ -12,6 +12,9 @@ import (
 type CreateAttendancePeriodParam struct {
 	StartDate null.Date `json:"startDate" swaggertype:"string" example:"2025-06-09T00:00:00Z"`
 	EndDate   null.Date `json:"endDate" swaggertype:"string" example:"2025-06-09T00:00:00Z"`
+
+	// ExchangeRateFixingDate is the date the exchange rates of the payroll are fixed on, the end date when empty.
+	ExchangeRateFixingDate null.Date `json:"exchangeRateFixingDate" swaggertype:"string" example:"2025-06-30T00:00:00Z"`
 }
 
 func (c *CreateAttendancePeriodParam) Validate() error {
@@ -31,15 +34,20 @@ func (c *CreateAttendancePeriodParam) Validate() error {
 		return errors.NewWithCode(codes.CodeBadRequest, "startDate cannot be before current date")
 	}
 
+	if c.ExchangeRateFixingDate.Valid && c.ExchangeRateFixingDate.Time.Before(c.StartDate.Time) {
+		return errors.NewWithCode(codes.CodeBadRequest, "exchangeRateFixingDate cannot be before startDate")
+	}
+
 	return nil
 }
 
 func (c *CreateAttendancePeriodParam) ToAttendancePeriodInputParam(currentTime null.Time, userID int64) entity.AttendancePeriodInputParam {
 	return entity.AttendancePeriodInputParam{
-		StartDate:    c.StartDate,
-		EndDate:      c.EndDate,
-		PeriodStatus: entity.PeriodStatusUpcoming,
-		CreatedAt:    currentTime,
-		CreatedBy:    null.Int64From(userID),
+		StartDate:              c.StartDate,
+		EndDate:                c.EndDate,
+		ExchangeRateFixingDate: c.ExchangeRateFixingDate,
+		PeriodStatus:           entity.PeriodStatusUpcoming,
+		CreatedAt:              currentTime,
+		CreatedBy:              null.Int64From(userID),
 	}
 }
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateExchangeRatesParam struct {
+	FixingDate null.Date           `json:"fixingDate" swaggertype:"string" example:"2026-01-31T00:00:00Z"`
+	Rates      []ExchangeRateParam `json:"rates"`
+}
+
+type ExchangeRateParam struct {
+	Currency string  `json:"currency" example:"USD"`
+	Rate     float64 `json:"rate" example:"16250.5"`
+}
+
+func (c *CreateExchangeRatesParam) Validate() error {
+	if !c.FixingDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "fixingDate is required")
+	}
+
+	if len(c.Rates) == 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "rates is required")
+	}
+
+	currencies := make(map[string]bool, len(c.Rates))
+	for i, rate := range c.Rates {
+		if !entity.IsValidCurrency(rate.Currency) {
+			return errors.NewWithCode(codes.CodeBadRequest, "currency of rate %v must be a three letter uppercase currency code", i+1)
+		}
+
+		if rate.Currency == entity.ReportingCurrency {
+			return errors.NewWithCode(codes.CodeBadRequest, "%s is the reporting currency and always converts at 1", entity.ReportingCurrency)
+		}
+
+		if currencies[rate.Currency] {
+			return errors.NewWithCode(codes.CodeBadRequest, "currency %s is listed more than once", rate.Currency)
+		}
+		currencies[rate.Currency] = true
+
+		if rate.Rate <= 0 {
+			return errors.NewWithCode(codes.CodeBadRequest, "rate of %s must be greater than zero", rate.Currency)
+		}
+	}
+
+	return nil
+}
+
+func (c *CreateExchangeRatesParam) ToExchangeRateInputParams(currentTime null.Time, userID int64) []entity.ExchangeRateInputParam {
+	inputParams := make([]entity.ExchangeRateInputParam, 0, len(c.Rates))
+	for _, rate := range c.Rates {
+		inputParams = append(inputParams, entity.ExchangeRateInputParam{
+			Currency:   rate.Currency,
+			Rate:       rate.Rate,
+			FixingDate: c.FixingDate,
+			CreatedAt:  currentTime,
+			CreatedBy:  null.Int64From(userID),
+		})
+	}
+
+	return inputParams
+}
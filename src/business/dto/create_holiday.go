@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateHolidaysParam struct {
+	Holidays []HolidayParam `json:"holidays"`
+}
+
+type HolidayParam struct {
+	HolidayDate null.Date `json:"holidayDate" swaggertype:"string" example:"2026-08-17T00:00:00Z"`
+	Name        string    `json:"name" example:"Independence Day"`
+
+	// Region limits the holiday to the employees of a region, it is observed nationwide when empty.
+	Region string `json:"region" example:"BALI"`
+}
+
+func (c *CreateHolidaysParam) Validate() error {
+	if len(c.Holidays) == 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "holidays is required")
+	}
+
+	seen := make(map[string]bool, len(c.Holidays))
+	for i, holiday := range c.Holidays {
+		if !holiday.HolidayDate.Valid {
+			return errors.NewWithCode(codes.CodeBadRequest, "holidayDate of holiday %v is required", i+1)
+		}
+
+		if strings.TrimSpace(holiday.Name) == "" {
+			return errors.NewWithCode(codes.CodeBadRequest, "name of holiday %v is required", i+1)
+		}
+
+		key := holiday.HolidayDate.Time.Format(time.DateOnly) + "|" + NormalizeRegion(holiday.Region)
+		if seen[key] {
+			return errors.NewWithCode(codes.CodeBadRequest, "holiday %v is listed more than once for its date and region", i+1)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func (c *CreateHolidaysParam) ToHolidayInputParams(currentTime null.Time, userID int64) []entity.HolidayInputParam {
+	inputParams := make([]entity.HolidayInputParam, 0, len(c.Holidays))
+	for _, holiday := range c.Holidays {
+		inputParams = append(inputParams, entity.HolidayInputParam{
+			HolidayDate: holiday.HolidayDate,
+			Name:        strings.TrimSpace(holiday.Name),
+			Region:      NormalizeRegion(holiday.Region),
+			CreatedAt:   currentTime,
+			CreatedBy:   null.Int64From(userID),
+		})
+	}
+
+	return inputParams
+}
+
+// NormalizeRegion upper cases a region code so that "bali" and "BALI" are the same region.
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
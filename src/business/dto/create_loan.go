@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateLoanParam struct {
+	Description string       `json:"description" example:"Laptop loan"`
+	Principal   entity.Money `json:"principal" swaggertype:"number" example:"6000000.00"`
+
+	// InstalmentCount is the number of payrolls the principal is spread over, a salary advance is repaid in one instalment.
+	InstalmentCount int64     `json:"instalmentCount" example:"12"`
+	StartDate       null.Date `json:"startDate" swaggertype:"string" example:"2026-01-01T00:00:00Z"`
+}
+
+func (c *CreateLoanParam) Validate() error {
+	if c.Description == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "description is required")
+	}
+
+	if c.Principal <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "principal must be greater than zero")
+	}
+
+	if c.InstalmentCount <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "instalmentCount must be greater than zero")
+	}
+
+	if !c.StartDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "startDate is required")
+	}
+
+	return nil
+}
+
+func (c *CreateLoanParam) ToLoanInputParam(
+	employee entity.User,
+	currentTime null.Time,
+	userID int64,
+) entity.LoanInputParam {
+	return entity.LoanInputParam{
+		UserID:           employee.ID,
+		Description:      c.Description,
+		Principal:        c.Principal,
+		InstalmentCount:  c.InstalmentCount,
+		InstalmentAmount: entity.CalculateLoanInstalment(c.Principal, c.InstalmentCount),
+		RemainingBalance: c.Principal,
+		Currency:         employee.Currency,
+		StartDate:        c.StartDate,
+		LoanStatus:       entity.LoanStatusActive,
+		CreatedAt:        currentTime,
+		CreatedBy:        null.Int64From(userID),
+	}
+}
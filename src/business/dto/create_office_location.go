@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateOfficeLocationParam struct {
+	Name         string       `json:"name" example:"Jakarta Head Office"`
+	Latitude     null.Float64 `json:"latitude" swaggertype:"number" example:"-6.2253"`
+	Longitude    null.Float64 `json:"longitude" swaggertype:"number" example:"106.7993"`
+	RadiusMeters int64        `json:"radiusMeters" example:"150"`
+}
+
+func (c *CreateOfficeLocationParam) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "name is required")
+	}
+
+	if err := validateCoordinates(c.Latitude, c.Longitude); err != nil {
+		return err
+	}
+
+	if c.RadiusMeters <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "radiusMeters is required and must be a positive number")
+	}
+
+	return nil
+}
+
+func (c *CreateOfficeLocationParam) ToOfficeLocationInputParam(currentTime null.Time, userID int64) entity.OfficeLocationInputParam {
+	return entity.OfficeLocationInputParam{
+		Name:         strings.TrimSpace(c.Name),
+		Latitude:     c.Latitude.Float64,
+		Longitude:    c.Longitude.Float64,
+		RadiusMeters: c.RadiusMeters,
+		CreatedAt:    currentTime,
+		CreatedBy:    null.Int64From(userID),
+	}
+}
+
+type CreateUserOfficeLocationParam struct {
+	OfficeLocationID int64 `json:"officeLocationId" example:"1"`
+}
+
+func (c *CreateUserOfficeLocationParam) Validate() error {
+	if c.OfficeLocationID <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "officeLocationId is required")
+	}
+
+	return nil
+}
+
+func (c *CreateUserOfficeLocationParam) ToUserOfficeLocationInputParam(employeeID int64, currentTime null.Time, userID int64) entity.UserOfficeLocationInputParam {
+	return entity.UserOfficeLocationInputParam{
+		UserID:           employeeID,
+		OfficeLocationID: c.OfficeLocationID,
+		CreatedAt:        currentTime,
+		CreatedBy:        null.Int64From(userID),
+	}
+}
+
+// validateCoordinates checks that both coordinates are given and on the globe.
+func validateCoordinates(latitude, longitude null.Float64) error {
+	if !latitude.Valid || latitude.Float64 < -90 || latitude.Float64 > 90 {
+		return errors.NewWithCode(codes.CodeBadRequest, "latitude is required and must be between -90 and 90")
+	}
+
+	if !longitude.Valid || longitude.Float64 < -180 || longitude.Float64 > 180 {
+		return errors.NewWithCode(codes.CodeBadRequest, "longitude is required and must be between -180 and 180")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreatePayrollAdjustmentParam struct {
+	UserID         int64        `json:"userId" example:"11"`
+	AdjustmentType string       `json:"adjustmentType" example:"EARNING"`
+	Amount         entity.Money `json:"amount" swaggertype:"number" example:"1000000.00"`
+	Reason         string       `json:"reason" example:"Q2 performance bonus"`
+}
+
+func (c *CreatePayrollAdjustmentParam) Validate() error {
+	if c.UserID <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "userId is required")
+	}
+
+	if c.AdjustmentType != entity.PayrollAdjustmentTypeEarning && c.AdjustmentType != entity.PayrollAdjustmentTypeDeduction {
+		return errors.NewWithCode(codes.CodeBadRequest, "adjustmentType must be %s or %s", entity.PayrollAdjustmentTypeEarning, entity.PayrollAdjustmentTypeDeduction)
+	}
+
+	if c.Amount <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "amount must be greater than zero")
+	}
+
+	if c.Reason == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "reason is required")
+	}
+
+	return nil
+}
+
+func (c *CreatePayrollAdjustmentParam) ToPayrollAdjustmentInputParam(
+	attendancePeriodID int64,
+	employee entity.User,
+	currentTime null.Time,
+	userID int64,
+) entity.PayrollAdjustmentInputParam {
+	return entity.PayrollAdjustmentInputParam{
+		AttendancePeriodID: attendancePeriodID,
+		UserID:             employee.ID,
+		AdjustmentType:     c.AdjustmentType,
+		Amount:             c.Amount,
+		Currency:           employee.Currency,
+		Reason:             c.Reason,
+		CreatedAt:          currentTime,
+		CreatedBy:          null.Int64From(userID),
+	}
+}
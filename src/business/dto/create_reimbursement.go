@@ -10,9 +10,10 @@ import (
 )
 
 type CreateReimbursementParam struct {
-	Description       string    `json:"description" example:"Reimbursement for office supplies"`
-	Amount            float64   `json:"amount" example:"150000.00"`
-	ReimbursementDate null.Date `json:"reimbursementDate" swaggertype:"string" example:"2025-06-19T00:00:00Z"`
+	Description       string       `json:"description" example:"Reimbursement for office supplies"`
+	Amount            entity.Money `json:"amount" swaggertype:"number" example:"150000.00"`
+	Currency          string       `json:"currency" example:"IDR"`
+	ReimbursementDate null.Date    `json:"reimbursementDate" swaggertype:"string" example:"2025-06-19T00:00:00Z"`
 }
 
 func (c *CreateReimbursementParam) Validate(currentTime time.Time) error {
@@ -24,6 +25,10 @@ func (c *CreateReimbursementParam) Validate(currentTime time.Time) error {
 		return errors.NewWithCode(codes.CodeBadRequest, "amount must be greater than zero")
 	}
 
+	if c.Currency != "" && !entity.IsValidCurrency(c.Currency) {
+		return errors.NewWithCode(codes.CodeBadRequest, "currency must be a three letter ISO 4217 code")
+	}
+
 	if !c.ReimbursementDate.Valid {
 		return errors.NewWithCode(codes.CodeBadRequest, "reimbursementDate is required")
 	}
@@ -35,11 +40,18 @@ func (c *CreateReimbursementParam) Validate(currentTime time.Time) error {
 	return nil
 }
 
+// ToReimbursementInputParam converts the request, a reimbursement without a currency is in the reporting currency.
 func (c *CreateReimbursementParam) ToReimbursementInputParam(currentTime null.Time, userID int64) entity.ReimbursementInputParam {
+	currency := c.Currency
+	if currency == "" {
+		currency = entity.ReportingCurrency
+	}
+
 	return entity.ReimbursementInputParam{
 		UserID:            userID,
 		Description:       c.Description,
 		Amount:            c.Amount,
+		Currency:          currency,
 		ReimbursementDate: c.ReimbursementDate,
 		CreatedAt:         currentTime,
 		CreatedBy:         null.Int64From(userID),
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateTaxBracketsParam struct {
+	EffectiveDate null.Date         `json:"effectiveDate" swaggertype:"string" example:"2026-01-01T00:00:00Z"`
+	Brackets      []TaxBracketParam `json:"brackets"`
+}
+
+type TaxBracketParam struct {
+	LowerBound entity.Money     `json:"lowerBound" swaggertype:"number" example:"0"`
+	UpperBound entity.NullMoney `json:"upperBound" swaggertype:"number" example:"60000000"`
+	Rate       float64          `json:"rate" example:"0.05"`
+}
+
+func (c *CreateTaxBracketsParam) Validate() error {
+	if !c.EffectiveDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "effectiveDate is required")
+	}
+
+	if len(c.Brackets) == 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "brackets is required")
+	}
+
+	for i, bracket := range c.Brackets {
+		if bracket.Rate < 0 || bracket.Rate > 1 {
+			return errors.NewWithCode(codes.CodeBadRequest, "rate of bracket %v must be between 0 and 1", i+1)
+		}
+
+		if i == 0 && bracket.LowerBound != 0 {
+			return errors.NewWithCode(codes.CodeBadRequest, "the first bracket must start at 0")
+		}
+
+		if i > 0 && bracket.LowerBound != c.Brackets[i-1].UpperBound.Money {
+			return errors.NewWithCode(codes.CodeBadRequest, "bracket %v must start where bracket %v ends", i+1, i)
+		}
+
+		isLast := i == len(c.Brackets)-1
+		if !isLast && !bracket.UpperBound.Valid {
+			return errors.NewWithCode(codes.CodeBadRequest, "only the last bracket can be open ended")
+		}
+
+		if bracket.UpperBound.Valid && bracket.UpperBound.Money <= bracket.LowerBound {
+			return errors.NewWithCode(codes.CodeBadRequest, "upperBound of bracket %v must be greater than its lowerBound", i+1)
+		}
+	}
+
+	return nil
+}
+
+func (c *CreateTaxBracketsParam) ToTaxBracketInputParams(currentTime null.Time, userID int64) []entity.TaxBracketInputParam {
+	inputParams := make([]entity.TaxBracketInputParam, 0, len(c.Brackets))
+	for _, bracket := range c.Brackets {
+		inputParams = append(inputParams, entity.TaxBracketInputParam{
+			EffectiveDate: c.EffectiveDate,
+			LowerBound:    bracket.LowerBound,
+			UpperBound:    bracket.UpperBound,
+			Rate:          null.Float64From(bracket.Rate),
+			CreatedAt:     currentTime,
+			CreatedBy:     null.Int64From(userID),
+		})
+	}
+
+	return inputParams
+}
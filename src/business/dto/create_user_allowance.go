@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateUserAllowanceParam struct {
+	AllowanceTypeID int64        `json:"allowanceTypeId" example:"1"`
+	Amount          entity.Money `json:"amount" swaggertype:"number" example:"500000.00"`
+
+	// IsProrated overrides the proration of the allowance type when given.
+	IsProrated    null.Bool `json:"isProrated" swaggertype:"boolean" example:"true"`
+	EffectiveDate null.Date `json:"effectiveDate" swaggertype:"string" example:"2026-01-01T00:00:00Z"`
+	EndDate       null.Date `json:"endDate" swaggertype:"string" example:"2026-12-31T00:00:00Z"`
+}
+
+func (c *CreateUserAllowanceParam) Validate() error {
+	if c.AllowanceTypeID <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "allowanceTypeId is required")
+	}
+
+	if c.Amount <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "amount must be greater than zero")
+	}
+
+	if !c.EffectiveDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "effectiveDate is required")
+	}
+
+	if c.EndDate.Valid && c.EndDate.Time.Before(c.EffectiveDate.Time) {
+		return errors.NewWithCode(codes.CodeBadRequest, "endDate cannot be before effectiveDate")
+	}
+
+	return nil
+}
+
+func (c *CreateUserAllowanceParam) ToUserAllowanceInputParam(
+	allowanceType entity.AllowanceType,
+	employeeID int64,
+	currentTime null.Time,
+	userID int64,
+) entity.UserAllowanceInputParam {
+	isProrated := allowanceType.IsProrated
+	if c.IsProrated.Valid {
+		isProrated = c.IsProrated.Bool
+	}
+
+	return entity.UserAllowanceInputParam{
+		UserID:          employeeID,
+		AllowanceTypeID: allowanceType.ID,
+		Amount:          c.Amount,
+		IsProrated:      isProrated,
+		EffectiveDate:   c.EffectiveDate,
+		EndDate:         c.EndDate,
+		CreatedAt:       currentTime,
+		CreatedBy:       null.Int64From(userID),
+	}
+}
+
+type EndUserAllowanceParam struct {
+	EndDate null.Date `json:"endDate" swaggertype:"string" example:"2026-06-30T00:00:00Z"`
+}
+
+func (e *EndUserAllowanceParam) Validate() error {
+	if !e.EndDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "endDate is required")
+	}
+
+	return nil
+}
+
+func (e *EndUserAllowanceParam) ToUserAllowanceUpdateParam(currentTime null.Time, userID int64) entity.UserAllowanceUpdateParam {
+	return entity.UserAllowanceUpdateParam{
+		EndDate:   e.EndDate,
+		UpdatedAt: currentTime,
+		UpdatedBy: null.Int64From(userID),
+	}
+}
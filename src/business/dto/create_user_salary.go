@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateUserSalaryParam struct {
+	BaseSalary entity.Money `json:"baseSalary" swaggertype:"number" example:"12000000.00"`
+
+	// EffectiveDate is the first day paid at the new salary, it may be in the future to schedule a salary change.
+	EffectiveDate null.Date `json:"effectiveDate" swaggertype:"string" example:"2026-01-01T00:00:00Z"`
+}
+
+func (c *CreateUserSalaryParam) Validate() error {
+	if c.BaseSalary <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "baseSalary must be greater than zero")
+	}
+
+	if !c.EffectiveDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "effectiveDate is required")
+	}
+
+	return nil
+}
+
+func (c *CreateUserSalaryParam) ToUserSalaryInputParam(employeeID int64, currentTime null.Time, userID int64) entity.UserSalaryInputParam {
+	return entity.UserSalaryInputParam{
+		UserID:        employeeID,
+		BaseSalary:    c.BaseSalary,
+		EffectiveDate: c.EffectiveDate,
+		CreatedAt:     currentTime,
+		CreatedBy:     null.Int64From(userID),
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateUserWorkScheduleParam struct {
+	WorkScheduleID int64     `json:"workScheduleId" example:"1"`
+	EffectiveDate  null.Date `json:"effectiveDate" swaggertype:"string" example:"2026-01-01T00:00:00Z"`
+}
+
+func (c *CreateUserWorkScheduleParam) Validate() error {
+	if c.WorkScheduleID <= 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "workScheduleId is required")
+	}
+
+	if !c.EffectiveDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "effectiveDate is required")
+	}
+
+	return nil
+}
+
+func (c *CreateUserWorkScheduleParam) ToUserWorkScheduleInputParam(employeeID int64, currentTime null.Time, userID int64) entity.UserWorkScheduleInputParam {
+	return entity.UserWorkScheduleInputParam{
+		UserID:         employeeID,
+		WorkScheduleID: c.WorkScheduleID,
+		EffectiveDate:  c.EffectiveDate,
+		CreatedAt:      currentTime,
+		CreatedBy:      null.Int64From(userID),
+	}
+}
@@ -0,0 +1,116 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type CreateWorkScheduleParam struct {
+	Name     string   `json:"name" example:"Warehouse Tuesday to Saturday"`
+	WorkDays []string `json:"workDays" example:"TUE,WED,THU,FRI,SAT"`
+
+	// ShiftStart and ShiftEnd are the clock times of the daily shift, nine to five when empty.
+	ShiftStart string `json:"shiftStart" example:"08:00"`
+	ShiftEnd   string `json:"shiftEnd" example:"16:00"`
+
+	// TimeZone is the IANA time zone the shift times are in, the time zone of the offices when empty.
+	TimeZone string `json:"timeZone" example:"Asia/Jakarta"`
+
+	// GraceMinutes, PenaltyType, PenaltyPerMinute and HalfDayAfterLates penalise late arrivals and early departures,
+	// they are not penalised when PenaltyType is empty.
+	GraceMinutes      int64        `json:"graceMinutes" example:"10"`
+	PenaltyType       string       `json:"penaltyType" example:"PER_MINUTE"`
+	PenaltyPerMinute  entity.Money `json:"penaltyPerMinute" swaggertype:"number" example:"2000"`
+	HalfDayAfterLates int64        `json:"halfDayAfterLates" example:"3"`
+}
+
+func (c *CreateWorkScheduleParam) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "name is required")
+	}
+
+	if len(c.WorkDays) == 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "workDays is required")
+	}
+
+	seen := make(map[string]bool, len(c.WorkDays))
+	for _, workDay := range c.WorkDays {
+		if !entity.IsWorkDayCode(workDay) {
+			return errors.NewWithCode(codes.CodeBadRequest, "workDays must be day codes from MON to SUN, got %s", workDay)
+		}
+
+		if seen[workDay] {
+			return errors.NewWithCode(codes.CodeBadRequest, "workDays lists %s more than once", workDay)
+		}
+		seen[workDay] = true
+	}
+
+	if c.ShiftStart == "" && c.ShiftEnd == "" {
+		c.ShiftStart = entity.DefaultWorkSchedule.ShiftStart
+		c.ShiftEnd = entity.DefaultWorkSchedule.ShiftEnd
+	}
+
+	if !entity.IsShiftTime(c.ShiftStart) {
+		return errors.NewWithCode(codes.CodeBadRequest, "shiftStart must be a time such as 09:00")
+	}
+
+	if !entity.IsShiftTime(c.ShiftEnd) {
+		return errors.NewWithCode(codes.CodeBadRequest, "shiftEnd must be a time such as 17:00")
+	}
+
+	if c.ShiftStart == c.ShiftEnd {
+		return errors.NewWithCode(codes.CodeBadRequest, "shiftEnd cannot be the same as shiftStart")
+	}
+
+	if c.TimeZone == "" {
+		c.TimeZone = entity.DefaultTimeZone
+	}
+
+	if !entity.IsTimeZone(c.TimeZone) {
+		return errors.NewWithCode(codes.CodeBadRequest, "timeZone must be an IANA time zone such as %s", entity.DefaultTimeZone)
+	}
+
+	if c.GraceMinutes < 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "graceMinutes cannot be negative")
+	}
+
+	if c.PenaltyType == "" {
+		c.PenaltyType = entity.WorkSchedulePenaltyTypeNone
+	}
+
+	switch c.PenaltyType {
+	case entity.WorkSchedulePenaltyTypePerMinute:
+		if c.PenaltyPerMinute <= 0 {
+			return errors.NewWithCode(codes.CodeBadRequest, "penaltyPerMinute must be a positive amount for penaltyType %s", c.PenaltyType)
+		}
+	case entity.WorkSchedulePenaltyTypeHalfDay:
+		if c.HalfDayAfterLates <= 0 {
+			return errors.NewWithCode(codes.CodeBadRequest, "halfDayAfterLates must be a positive number for penaltyType %s", c.PenaltyType)
+		}
+	case entity.WorkSchedulePenaltyTypeNone:
+	default:
+		return errors.NewWithCode(codes.CodeBadRequest, "penaltyType must be one of %s, %s or %s", entity.WorkSchedulePenaltyTypeNone, entity.WorkSchedulePenaltyTypePerMinute, entity.WorkSchedulePenaltyTypeHalfDay)
+	}
+
+	return nil
+}
+
+func (c *CreateWorkScheduleParam) ToWorkScheduleInputParam(currentTime null.Time, userID int64) entity.WorkScheduleInputParam {
+	return entity.WorkScheduleInputParam{
+		Name:              strings.TrimSpace(c.Name),
+		WorkDays:          strings.Join(c.WorkDays, ","),
+		ShiftStart:        c.ShiftStart,
+		ShiftEnd:          c.ShiftEnd,
+		TimeZone:          c.TimeZone,
+		GraceMinutes:      c.GraceMinutes,
+		PenaltyType:       c.PenaltyType,
+		PenaltyPerMinute:  c.PenaltyPerMinute,
+		HalfDayAfterLates: c.HalfDayAfterLates,
+		CreatedAt:         currentTime,
+		CreatedBy:         null.Int64From(userID),
+	}
+}
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// ImportAttendanceParam is a daily log exported by a biometric device, one attendance per line.
+type ImportAttendanceParam struct {
+	// Format is the format of the device log, CSV when empty.
+	Format  string `json:"format" example:"CSV"`
+	Content string `json:"content" example:"device_user_id,date,check_in,check_out\n1042,2025-06-09,08:55,17:40"`
+}
+
+func (i *ImportAttendanceParam) Validate() error {
+	i.Format = strings.ToUpper(strings.TrimSpace(i.Format))
+	if i.Format == "" {
+		i.Format = entity.AttendanceImportFormatCSV
+	}
+
+	if !entity.IsAttendanceImportFormat(i.Format) {
+		return errors.NewWithCode(codes.CodeBadRequest, "format must be %s or %s", entity.AttendanceImportFormatCSV, entity.AttendanceImportFormatFixedWidth)
+	}
+
+	if strings.TrimSpace(i.Content) == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "content is required")
+	}
+
+	return nil
+}
+
+// AttendanceImportRawLine is a line of a device log as it was exported.
+type AttendanceImportRawLine struct {
+	LineNumber int64
+	Text       string
+}
+
+// Lines returns the non-blank lines of the device log with their line numbers, the CSV header row is skipped.
+func (i *ImportAttendanceParam) Lines() []AttendanceImportRawLine {
+	lines := []AttendanceImportRawLine{}
+	for index, line := range strings.Split(strings.ReplaceAll(i.Content, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" || entity.IsAttendanceImportHeader(i.Format, line) {
+			continue
+		}
+
+		lines = append(lines, AttendanceImportRawLine{LineNumber: int64(index + 1), Text: line})
+	}
+
+	return lines
+}
+
+// AttendanceImportReport tells which lines of a device log were imported, which were already recorded and which
+// were rejected.
+type AttendanceImportReport struct {
+	Accepted       int64                       `json:"accepted" example:"42"`
+	Duplicate      int64                       `json:"duplicate" example:"3"`
+	Rejected       int64                       `json:"rejected" example:"1"`
+	AcceptedLines  []int64                     `json:"acceptedLines"`
+	DuplicateLines []int64                     `json:"duplicateLines"`
+	Rejections     []AttendanceImportRejection `json:"rejections"`
+}
+
+// AttendanceImportRejection is a line of a device log that could not be imported.
+type AttendanceImportRejection struct {
+	LineNumber int64  `json:"lineNumber" example:"7"`
+	Line       string `json:"line" example:"9999,2025-06-09,08:55,17:40"`
+	Reason     string `json:"reason" example:"device user ID 9999 is not mapped to an employee"`
+}
+
+func (a *AttendanceImportReport) Accept(line AttendanceImportRawLine) {
+	a.Accepted++
+	a.AcceptedLines = append(a.AcceptedLines, line.LineNumber)
+}
+
+func (a *AttendanceImportReport) MarkDuplicate(line AttendanceImportRawLine) {
+	a.Duplicate++
+	a.DuplicateLines = append(a.DuplicateLines, line.LineNumber)
+}
+
+func (a *AttendanceImportReport) Reject(line AttendanceImportRawLine, reason string) {
+	a.Rejected++
+	a.Rejections = append(a.Rejections, AttendanceImportRejection{
+		LineNumber: line.LineNumber,
+		Line:       line.Text,
+		Reason:     reason,
+	})
+}
+
+// Sort orders the lines of the report as they appear in the device log.
+func (a *AttendanceImportReport) Sort() {
+	sort.Slice(a.AcceptedLines, func(i, j int) bool { return a.AcceptedLines[i] < a.AcceptedLines[j] })
+	sort.Slice(a.DuplicateLines, func(i, j int) bool { return a.DuplicateLines[i] < a.DuplicateLines[j] })
+	sort.Slice(a.Rejections, func(i, j int) bool { return a.Rejections[i].LineNumber < a.Rejections[j].LineNumber })
+}
+
+// UpdateUserDeviceParam maps an employee to the user ID the biometric devices know them by.
+type UpdateUserDeviceParam struct {
+	DeviceUserID string `json:"deviceUserID" example:"1042"`
+}
+
+func (u *UpdateUserDeviceParam) Validate() error {
+	u.DeviceUserID = strings.TrimSpace(u.DeviceUserID)
+	if u.DeviceUserID == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "deviceUserID is required")
+	}
+
+	if len(u.DeviceUserID) > 10 {
+		return errors.NewWithCode(codes.CodeBadRequest, "deviceUserID cannot exceed 10 characters")
+	}
+
+	return nil
+}
+
+func (u *UpdateUserDeviceParam) ToUserUpdateParam(currentTime null.Time, userID int64) entity.UserUpdateParam {
+	return entity.UserUpdateParam{
+		DeviceUserID: null.StringFrom(u.DeviceUserID),
+		UpdatedAt:    currentTime,
+		UpdatedBy:    null.StringFrom(strconv.FormatInt(userID, 10)),
+	}
+}
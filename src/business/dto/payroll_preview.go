@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type PayrollPreview struct {
+	PreviewID                int64                    `json:"previewId,omitempty" example:"1"`
+	PreviewStatus            string                   `json:"previewStatus" example:"COMPLETED"`
+	PreviewError             string                   `json:"previewError,omitempty" example:""`
+	AttendancePeriodID       int64                    `json:"attendancePeriodId" example:"1"`
+	StartDate                null.Date                `json:"startDate" example:"2025-06-01"`
+	EndDate                  null.Date                `json:"endDate" example:"2025-06-30"`
+	TotalEmployee            int64                    `json:"totalEmployee" example:"100"`
+	ReportingCurrency        string                   `json:"reportingCurrency" example:"IDR"`
+	TotalEmployeeTakeHomePay entity.Money             `json:"totalEmployeeTakeHomePay" swaggertype:"number" example:"5000000.00"`
+	TotalEmployerCost        entity.Money             `json:"totalEmployerCost" swaggertype:"number" example:"5600000.00"`
+	CurrencyTotals           []CurrencyPayout         `json:"currencyTotals"`
+	Employees                []EmployeePayrollPreview `json:"employees"`
+}
+
+type EmployeePayrollPreview struct {
+	ID                   int64                  `json:"id" example:"1"`
+	Name                 string                 `json:"name" example:"John Doe"`
+	BasePayComponent     entity.Money           `json:"basePayComponent" swaggertype:"number" example:"5000000.00"`
+	OvertimeComponent    entity.Money           `json:"overtimeComponent" swaggertype:"number" example:"200000.00"`
+	AllowanceComponent   entity.Money           `json:"allowanceComponent" swaggertype:"number" example:"750000.00"`
+	AdjustmentComponent  entity.Money           `json:"adjustmentComponent" swaggertype:"number" example:"1000000.00"`
+	ReimburseComponent   entity.Money           `json:"reimburseComponent" swaggertype:"number" example:"150000.00"`
+	DeductionComponent   entity.Money           `json:"deductionComponent" swaggertype:"number" example:"0.00"`
+	EmployerContribution entity.Money           `json:"employerContribution" swaggertype:"number" example:"0.00"`
+	TotalTakeHomePay     entity.Money           `json:"totalTakeHomePay" swaggertype:"number" example:"5350000.00"`
+	EmployerCost         entity.Money           `json:"employerCost" swaggertype:"number" example:"5350000.00"`
+	CurrencyTotals       []PayslipCurrencyTotal `json:"currencyTotals"`
+	Details              []PayslipDetail        `json:"details"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+)
+
+type PayrollStatus struct {
+	AttendancePeriodID int64                  `json:"attendancePeriodId" example:"1"`
+	PeriodStatus       string                 `json:"periodStatus" example:"PROCESSING"`
+	PayrollRunID       int64                  `json:"payrollRunId" example:"1"`
+	Version            int64                  `json:"version" example:"1"`
+	State              string                 `json:"state" example:"PROCESSING"`
+	TotalEmployee      int64                  `json:"totalEmployee" example:"100"`
+	ProcessedEmployee  int64                  `json:"processedEmployee" example:"40"`
+	FailedEmployee     int64                  `json:"failedEmployee" example:"1"`
+	Progress           float64                `json:"progress" example:"40"`
+	StartedAt          null.Time              `json:"startedAt" swaggertype:"string" example:"2025-07-01T10:00:00Z"`
+	FinishedAt         null.Time              `json:"finishedAt" swaggertype:"string" example:"2025-07-01T10:05:00Z"`
+	ProcessError       string                 `json:"processError,omitempty" example:""`
+	Failures           []PayrollStatusFailure `json:"failures"`
+}
+
+type PayrollStatusFailure struct {
+	UserID       int64  `json:"userId" example:"11"`
+	ProcessError string `json:"processError" example:"pay component BASE_PAY: base salary is not set"`
+}
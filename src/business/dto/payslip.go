@@ -1,19 +1,46 @@
 package dto
 
-import "github.com/reyhanmichiels/go-pkg/v2/null"
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
 
 type Payslip struct {
-	StartDate          null.Date       `json:"startDate" example:"2025-06-01"`
-	EndDate            null.Date       `json:"endDate" example:"2025-06-30"`
-	BasePayComponent   float64         `json:"basePayComponent" example:"5000000.00"`
-	OvertimeComponent  float64         `json:"overtimeComponent" example:"200000.00"`
-	ReimburseComponent float64         `json:"reimburseComponent" example:"150000.00"`
-	TotalTakeHomePay   float64         `json:"totalTakeHomePay" example:"5350000.00"`
-	Details            []PayslipDetail `json:"details"`
+	StartDate            null.Date              `json:"startDate" example:"2025-06-01"`
+	EndDate              null.Date              `json:"endDate" example:"2025-06-30"`
+	Version              int64                  `json:"version" example:"1"`
+	ReportingCurrency    string                 `json:"reportingCurrency" example:"IDR"`
+	BasePayComponent     entity.Money           `json:"basePayComponent" swaggertype:"number" example:"5000000.00"`
+	OvertimeComponent    entity.Money           `json:"overtimeComponent" swaggertype:"number" example:"200000.00"`
+	AllowanceComponent   entity.Money           `json:"allowanceComponent" swaggertype:"number" example:"750000.00"`
+	AdjustmentComponent  entity.Money           `json:"adjustmentComponent" swaggertype:"number" example:"1000000.00"`
+	ReimburseComponent   entity.Money           `json:"reimburseComponent" swaggertype:"number" example:"150000.00"`
+	DeductionComponent   entity.Money           `json:"deductionComponent" swaggertype:"number" example:"0.00"`
+	EmployerContribution entity.Money           `json:"employerContribution" swaggertype:"number" example:"0.00"`
+	TotalTakeHomePay     entity.Money           `json:"totalTakeHomePay" swaggertype:"number" example:"5350000.00"`
+	YearToDate           YearToDate             `json:"yearToDate"`
+	CurrencyTotals       []PayslipCurrencyTotal `json:"currencyTotals"`
+	Details              []PayslipDetail        `json:"details"`
+}
+
+// PayslipCurrencyTotal holds the components of a payslip paid in one currency, in that currency.
+type PayslipCurrencyTotal struct {
+	Currency             string       `json:"currency" example:"USD"`
+	ExchangeRate         float64      `json:"exchangeRate" example:"16250.5"`
+	BasePayComponent     entity.Money `json:"basePayComponent" swaggertype:"number" example:"3000.00"`
+	OvertimeComponent    entity.Money `json:"overtimeComponent" swaggertype:"number" example:"120.00"`
+	AllowanceComponent   entity.Money `json:"allowanceComponent" swaggertype:"number" example:"45.00"`
+	AdjustmentComponent  entity.Money `json:"adjustmentComponent" swaggertype:"number" example:"60.00"`
+	ReimburseComponent   entity.Money `json:"reimburseComponent" swaggertype:"number" example:"90.00"`
+	DeductionComponent   entity.Money `json:"deductionComponent" swaggertype:"number" example:"0.00"`
+	EmployerContribution entity.Money `json:"employerContribution" swaggertype:"number" example:"0.00"`
+	TotalTakeHomePay     entity.Money `json:"totalTakeHomePay" swaggertype:"number" example:"3210.00"`
 }
 
 type PayslipDetail struct {
-	Type        string  `json:"type" example:"EARNING_OVERTIME"`
-	Description string  `json:"description" example:"Overtime: 2 hours on 2025-06-15"`
-	Amount      float64 `json:"amount" example:"200000.00"`
+	Type        string       `json:"type" example:"EARNING_OVERTIME"`
+	Description string       `json:"description" example:"Overtime: 2 hours on 2025-06-15"`
+	Amount      entity.Money `json:"amount" swaggertype:"number" example:"200000.00"`
+	Currency    string       `json:"currency" example:"IDR"`
+	Rate        float64      `json:"rate,omitempty" example:"1.5"`
 }
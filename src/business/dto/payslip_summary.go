@@ -1,13 +1,30 @@
 package dto
 
+import "github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+
 type PayslipSummary struct {
-	TotalEmployeeTakeHomePay float64          `json:"totalEmployeeTakeHomePay" example:"5000000.00"`
+	PayrollRunID             int64            `json:"payrollRunId" example:"1"`
+	Version                  int64            `json:"version" example:"1"`
+	RunStatus                string           `json:"runStatus" example:"PROCESSED"`
+	ReportingCurrency        string           `json:"reportingCurrency" example:"IDR"`
+	TotalEmployeeTakeHomePay entity.Money     `json:"totalEmployeeTakeHomePay" swaggertype:"number" example:"5000000.00"`
+	TotalEmployerCost        entity.Money     `json:"totalEmployerCost" swaggertype:"number" example:"5600000.00"`
 	TotalEmployee            int64            `json:"totalEmployee" example:"100"`
+	CurrencyTotals           []CurrencyPayout `json:"currencyTotals"`
 	EmployeePayouts          []EmployeePayout `json:"employeePayouts"`
 }
 
 type EmployeePayout struct {
-	ID          int64   `json:"id" example:"1"`
-	Name        string  `json:"name" example:"John Doe"`
-	TakeHomePay float64 `json:"takeHomePay" example:"5000000.00"`
+	ID           int64            `json:"id" example:"1"`
+	Name         string           `json:"name" example:"John Doe"`
+	TakeHomePay  entity.Money     `json:"takeHomePay" swaggertype:"number" example:"5000000.00"`
+	EmployerCost entity.Money     `json:"employerCost" swaggertype:"number" example:"5600000.00"`
+	Payouts      []CurrencyPayout `json:"payouts"`
+}
+
+// CurrencyPayout is the take-home pay and employer cost paid in one currency, in that currency.
+type CurrencyPayout struct {
+	Currency     string       `json:"currency" example:"USD"`
+	TakeHomePay  entity.Money `json:"takeHomePay" swaggertype:"number" example:"3210.00"`
+	EmployerCost entity.Money `json:"employerCost" swaggertype:"number" example:"3450.00"`
 }
@@ -7,5 +7,6 @@ import (
 
 type PubSubGeneratePayrollMessage struct {
 	AttendancePeriod entity.AttendancePeriod `json:"attendancePeriod"`
+	PayrollRun       entity.PayrollRun       `json:"payrollRun"`
 	LoginUser        auth.User               `json:"loginUser"`
 }
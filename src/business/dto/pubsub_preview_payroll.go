@@ -0,0 +1,12 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type PubSubPreviewPayrollMessage struct {
+	PayrollPreviewID int64                   `json:"payrollPreviewId"`
+	AttendancePeriod entity.AttendancePeriod `json:"attendancePeriod"`
+	LoginUser        auth.User               `json:"loginUser"`
+}
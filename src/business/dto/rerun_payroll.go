@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type RerunPayrollParam struct {
+	Reason string `json:"reason" example:"Overtime of June 15 was approved after the payroll run"`
+}
+
+func (r *RerunPayrollParam) Validate() error {
+	if strings.TrimSpace(r.Reason) == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "reason is required")
+	}
+
+	if len(r.Reason) > 255 {
+		return errors.NewWithCode(codes.CodeBadRequest, "reason cannot exceed 255 characters")
+	}
+
+	return nil
+}
+
+func (r *RerunPayrollParam) ToPayrollRunUpdateParam(currentTime null.Time, userID int64) entity.PayrollRunUpdateParam {
+	return entity.PayrollRunUpdateParam{
+		RunStatus:  entity.PayrollRunStatusVoided,
+		VoidReason: null.StringFrom(strings.TrimSpace(r.Reason)),
+		VoidedAt:   currentTime,
+		VoidedBy:   null.Int64From(userID),
+		UpdatedAt:  currentTime,
+		UpdatedBy:  null.Int64From(userID),
+	}
+}
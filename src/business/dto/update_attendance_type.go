@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// UpdateAttendanceTypeParam changes the pay treatment of an attendance type. The daily allowance is in the reporting
+// currency, a zero maxDaysPerPeriod pays every day of the type.
+type UpdateAttendanceTypeParam struct {
+	Name             null.String      `json:"name" swaggertype:"string" example:"Field Visit"`
+	DailyAllowance   entity.NullMoney `json:"dailyAllowance" swaggertype:"number" example:"150000"`
+	MaxDaysPerPeriod null.Int64       `json:"maxDaysPerPeriod" swaggertype:"integer" example:"0"`
+	Status           null.Int64       `json:"status" swaggertype:"integer" example:"1"`
+}
+
+func (u *UpdateAttendanceTypeParam) Validate() error {
+	if !u.Name.Valid && !u.DailyAllowance.Valid && !u.MaxDaysPerPeriod.Valid && !u.Status.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "at least one field must be updated")
+	}
+
+	if u.Name.Valid && u.Name.String == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "name cannot be empty")
+	}
+
+	if u.DailyAllowance.Valid && u.DailyAllowance.Money < 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "dailyAllowance cannot be negative")
+	}
+
+	if u.MaxDaysPerPeriod.Valid && u.MaxDaysPerPeriod.Int64 < 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "maxDaysPerPeriod cannot be negative")
+	}
+
+	if u.Status.Valid && u.Status.Int64 != 1 && u.Status.Int64 != -1 {
+		return errors.NewWithCode(codes.CodeBadRequest, "status must be 1 (active) or -1 (inactive)")
+	}
+
+	return nil
+}
+
+func (u *UpdateAttendanceTypeParam) ToAttendanceTypeUpdateParam(currentTime null.Time, userID int64) entity.AttendanceTypeUpdateParam {
+	return entity.AttendanceTypeUpdateParam{
+		Name:             u.Name,
+		DailyAllowance:   u.DailyAllowance,
+		MaxDaysPerPeriod: u.MaxDaysPerPeriod,
+		Status:           u.Status,
+		UpdatedAt:        currentTime,
+		UpdatedBy:        null.Int64From(userID),
+	}
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type UpdatePayComponentParam struct {
+	Name            null.String `json:"name" swaggertype:"string" example:"Overtime Pay"`
+	EvaluationOrder null.Int64  `json:"evaluationOrder" swaggertype:"integer" example:"2"`
+	Config          null.String `json:"config" swaggertype:"string" example:"{\"hoursPerDay\":8}"`
+	Status          null.Int64  `json:"status" swaggertype:"integer" example:"1"`
+}
+
+func (u *UpdatePayComponentParam) Validate() error {
+	if !u.Name.Valid && !u.EvaluationOrder.Valid && !u.Config.Valid && !u.Status.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "at least one field must be updated")
+	}
+
+	if u.Name.Valid && u.Name.String == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "name cannot be empty")
+	}
+
+	if u.EvaluationOrder.Valid && u.EvaluationOrder.Int64 < 1 {
+		return errors.NewWithCode(codes.CodeBadRequest, "evaluationOrder must be a positive number")
+	}
+
+	if u.Config.Valid && !json.Valid([]byte(u.Config.String)) {
+		return errors.NewWithCode(codes.CodeBadRequest, "config must be a valid JSON document")
+	}
+
+	if u.Status.Valid && u.Status.Int64 != 1 && u.Status.Int64 != -1 {
+		return errors.NewWithCode(codes.CodeBadRequest, "status must be 1 (active) or -1 (inactive)")
+	}
+
+	return nil
+}
+
+func (u *UpdatePayComponentParam) ToPayComponentUpdateParam(currentTime null.Time, userID int64) entity.PayComponentUpdateParam {
+	return entity.PayComponentUpdateParam{
+		Name:            u.Name,
+		EvaluationOrder: u.EvaluationOrder,
+		Config:          u.Config,
+		Status:          u.Status,
+		UpdatedAt:       currentTime,
+		UpdatedBy:       null.Int64From(userID),
+	}
+}
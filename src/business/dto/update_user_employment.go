@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"strconv"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type UpdateUserEmploymentParam struct {
+	HireDate null.Date `json:"hireDate" swaggertype:"string" example:"2025-06-16T00:00:00Z"`
+	// TerminationDate is the last day the employee is employed, empty while they are still employed.
+	TerminationDate null.Date `json:"terminationDate" swaggertype:"string" example:"2026-06-30T00:00:00Z"`
+}
+
+func (u *UpdateUserEmploymentParam) Validate() error {
+	if !u.HireDate.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "hireDate is required")
+	}
+
+	if u.TerminationDate.Valid && u.TerminationDate.Time.Before(u.HireDate.Time) {
+		return errors.NewWithCode(codes.CodeBadRequest, "terminationDate cannot be before hireDate")
+	}
+
+	return nil
+}
+
+func (u *UpdateUserEmploymentParam) ToUserUpdateParam(currentTime null.Time, userID int64) entity.UserUpdateParam {
+	terminationDate := u.TerminationDate
+	if !terminationDate.Valid {
+		// clears the termination date of a rehired employee
+		terminationDate = null.Date{SqlNull: true}
+	}
+
+	return entity.UserUpdateParam{
+		HireDate:        u.HireDate,
+		TerminationDate: terminationDate,
+		UpdatedAt:       currentTime,
+		UpdatedBy:       null.StringFrom(strconv.FormatInt(userID, 10)),
+	}
+}
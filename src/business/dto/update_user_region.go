@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"strconv"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type UpdateUserRegionParam struct {
+	// Region is the region whose holidays the employee observes on top of the nationwide ones, empty for none.
+	Region string `json:"region" example:"BALI"`
+}
+
+func (u *UpdateUserRegionParam) ToUserUpdateParam(currentTime null.Time, userID int64) entity.UserUpdateParam {
+	return entity.UserUpdateParam{
+		Region:    null.StringFrom(NormalizeRegion(u.Region)),
+		UpdatedAt: currentTime,
+		UpdatedBy: null.StringFrom(strconv.FormatInt(userID, 10)),
+	}
+}
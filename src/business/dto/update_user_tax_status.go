@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"strconv"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+type UpdateUserTaxStatusParam struct {
+	MaritalStatus string `json:"maritalStatus" example:"MARRIED"`
+	Dependants    int64  `json:"dependants" example:"2"`
+}
+
+func (u *UpdateUserTaxStatusParam) Validate() error {
+	if u.MaritalStatus != entity.UserTaxMaritalStatusSingle && u.MaritalStatus != entity.UserTaxMaritalStatusMarried {
+		return errors.NewWithCode(codes.CodeBadRequest, "maritalStatus must be %s or %s", entity.UserTaxMaritalStatusSingle, entity.UserTaxMaritalStatusMarried)
+	}
+
+	if u.Dependants < 0 {
+		return errors.NewWithCode(codes.CodeBadRequest, "dependants cannot be negative")
+	}
+
+	return nil
+}
+
+func (u *UpdateUserTaxStatusParam) ToUserUpdateParam(currentTime null.Time, userID int64) entity.UserUpdateParam {
+	return entity.UserUpdateParam{
+		TaxMaritalStatus: u.MaritalStatus,
+		TaxDependants:    null.Int64From(u.Dependants),
+		UpdatedAt:        currentTime,
+		UpdatedBy:        null.StringFrom(strconv.FormatInt(userID, 10)),
+	}
+}
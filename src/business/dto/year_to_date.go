@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+
+// YearToDate holds the payroll totals of an employee over a calendar year, in the reporting currency.
+type YearToDate struct {
+	Year                 int64        `json:"year" example:"2025"`
+	Currency             string       `json:"currency" example:"IDR"`
+	GrossEarnings        entity.Money `json:"grossEarnings" swaggertype:"number" example:"36000000.00"`
+	TaxDeduction         entity.Money `json:"taxDeduction" swaggertype:"number" example:"1800000.00"`
+	EmployeeContribution entity.Money `json:"employeeContribution" swaggertype:"number" example:"720000.00"`
+	EmployerContribution entity.Money `json:"employerContribution" swaggertype:"number" example:"1440000.00"`
+	TotalTakeHomePay     entity.Money `json:"totalTakeHomePay" swaggertype:"number" example:"33480000.00"`
+}
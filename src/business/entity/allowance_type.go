@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// AllowanceType is an entry of the allowance catalogue, such as a transport or meal allowance.
+// IsProrated is the default proration of the allowances assigned from it.
+type AllowanceType struct {
+	ID         int64  `db:"id" json:"id"`
+	Code       string `db:"code" json:"code"`
+	Name       string `db:"name" json:"name"`
+	IsProrated bool   `db:"is_prorated" json:"isProrated"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type AllowanceTypeInputParam struct {
+	Code       string     `db:"code" json:"code"`
+	Name       string     `db:"name" json:"name"`
+	IsProrated bool       `db:"is_prorated" json:"isProrated"`
+	CreatedAt  null.Time  `db:"created_at" json:"-"`
+	CreatedBy  null.Int64 `db:"created_by" json:"-"`
+}
+
+type AllowanceTypeUpdateParam struct {
+	Name       null.String `db:"name" json:"name"`
+	IsProrated null.Bool   `db:"is_prorated" json:"isProrated"`
+	Status     null.Int64  `db:"status" json:"status"`
+	UpdatedAt  null.Time   `db:"updated_at" json:"-"`
+	UpdatedBy  null.Int64  `db:"updated_by" json:"-"`
+}
+
+type AllowanceTypeParam struct {
+	ID          int64  `db:"id" param:"id" json:"id"`
+	Code        string `db:"code" param:"code" json:"code"`
+	QueryOption query.Option
+	BypassCache bool
+	PaginationParam
+}
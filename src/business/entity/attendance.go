@@ -1,15 +1,27 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/reyhanmichiels/go-pkg/v2/null"
 	"github.com/reyhanmichiels/go-pkg/v2/query"
 )
 
+// Attendance is the daily attendance of an employee, it is created on check-in and completed on check-out with the
+// minutes worked in between. AttendanceType is the code of the AttendanceType of where the employee worked that day.
+// The check-in coordinates are kept for audit with the office location they matched.
 type Attendance struct {
-	ID                 int64     `db:"id" json:"id"`
-	AttendancePeriodID int64     `db:"fk_attendance_period_id" json:"attendancePeriodID"`
-	UserID             int64     `db:"fk_user_id" json:"userID"`
-	AttendanceDate     null.Date `db:"attendance_date" json:"attendanceDate"`
+	ID                 int64        `db:"id" json:"id"`
+	AttendancePeriodID int64        `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64        `db:"fk_user_id" json:"userID"`
+	AttendanceDate     null.Date    `db:"attendance_date" json:"attendanceDate"`
+	AttendanceType     string       `db:"attendance_type" json:"attendanceType"`
+	CheckInAt          null.Time    `db:"check_in_at" json:"checkInAt" swaggertype:"string" example:"2022-06-21T08:55:00Z"`
+	CheckOutAt         null.Time    `db:"check_out_at" json:"checkOutAt" swaggertype:"string" example:"2022-06-21T17:30:00Z"`
+	WorkedMinutes      int64        `db:"worked_minutes" json:"workedMinutes"`
+	OfficeLocationID   null.Int64   `db:"fk_office_location_id" json:"officeLocationID" swaggertype:"integer"`
+	CheckInLatitude    null.Float64 `db:"check_in_latitude" json:"checkInLatitude" swaggertype:"number"`
+	CheckInLongitude   null.Float64 `db:"check_in_longitude" json:"checkInLongitude" swaggertype:"number"`
 
 	// Utility Column
 	Status    int64       `db:"status" json:"status"`
@@ -24,26 +36,101 @@ type Attendance struct {
 }
 
 type AttendanceInputParam struct {
-	AttendancePeriodID int64      `db:"fk_attendance_period_id" json:"attendancePeriodID"`
-	UserID             int64      `db:"fk_user_id" json:"userID"`
-	AttendanceDate     null.Date  `db:"attendance_date" json:"attendanceDate"`
-	CreatedAt          null.Time  `db:"created_at" json:"-"`
-	CreatedBy          null.Int64 `db:"created_by" json:"-"`
+	AttendancePeriodID int64        `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64        `db:"fk_user_id" json:"userID"`
+	AttendanceDate     null.Date    `db:"attendance_date" json:"attendanceDate"`
+	AttendanceType     string       `db:"attendance_type" json:"attendanceType"`
+	CheckInAt          null.Time    `db:"check_in_at" json:"checkInAt"`
+	CheckOutAt         null.Time    `db:"check_out_at" json:"checkOutAt"`
+	WorkedMinutes      int64        `db:"worked_minutes" json:"workedMinutes"`
+	OfficeLocationID   null.Int64   `db:"fk_office_location_id" json:"officeLocationID"`
+	CheckInLatitude    null.Float64 `db:"check_in_latitude" json:"checkInLatitude"`
+	CheckInLongitude   null.Float64 `db:"check_in_longitude" json:"checkInLongitude"`
+	CreatedAt          null.Time    `db:"created_at" json:"-"`
+	CreatedBy          null.Int64   `db:"created_by" json:"-"`
 }
 
 type AttendanceUpdateParam struct {
 	AttendancePeriodID int64      `db:"fk_attendance_period_id" json:"attendancePeriodID"`
 	AttendanceDate     null.Date  `db:"attendance_date" json:"attendanceDate"`
+	CheckOutAt         null.Time  `db:"check_out_at" json:"checkOutAt"`
+	WorkedMinutes      null.Int64 `db:"worked_minutes" json:"workedMinutes"`
 	Status             null.Int64 `db:"status" json:"status"`
 	UpdatedAt          null.Time  `db:"updated_at" json:"-"`
 	UpdatedBy          null.Int64 `db:"updated_by" json:"-"`
 }
 
 type AttendanceParam struct {
-	ID          int64 `db:"id" param:"id" json:"id"`
-	QueryOption query.Option
-	BypassCache bool
+	ID                 int64     `db:"id" param:"id" json:"id"`
+	AttendancePeriodID int64     `db:"fk_attendance_period_id" param:"fk_attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64     `db:"fk_user_id" param:"fk_user_id" json:"userID"`
+	UserIDs            []int64   `db:"fk_user_id" param:"fk_user_ids" json:"userIDs"`
+	AttendanceDate     null.Date `db:"attendance_date" param:"attendance_date" json:"attendanceDate"`
+	AttendanceDateGTE  null.Date `db:"attendance_date" param:"attendance_date__gte" json:"attendanceDateGTE"`
+	AttendanceDateLTE  null.Date `db:"attendance_date" param:"attendance_date__lte" json:"attendanceDateLTE"`
+	QueryOption        query.Option
+	BypassCache        bool
 	PaginationParam
 }
 
 type UserAttendanceCount map[int64]int64
+
+// WorkedMinutesUntil returns the whole minutes worked from the check-in to the given check-out time.
+func (a *Attendance) WorkedMinutesUntil(checkOutAt time.Time) int64 {
+	if !a.CheckInAt.Valid || checkOutAt.Before(a.CheckInAt.Time) {
+		return 0
+	}
+
+	return int64(checkOutAt.Sub(a.CheckInAt.Time) / time.Minute)
+}
+
+// WorkedHours returns the hours worked between the check-in and the check-out.
+func (a *Attendance) WorkedHours() float64 {
+	return float64(a.WorkedMinutes) / 60
+}
+
+// HoursBeyondShift returns the hours worked on top of the daily shift of the work schedule, which is the most
+// overtime that can be claimed for the day.
+func (a *Attendance) HoursBeyondShift(workSchedule WorkSchedule) float64 {
+	return max(0, a.WorkedHours()-workSchedule.ShiftDuration().Hours())
+}
+
+// IsCheckOutMissing reports whether the day of the attendance is over without a check-out.
+func (a *Attendance) IsCheckOutMissing(currentTime time.Time) bool {
+	return !a.CheckOutAt.Valid && a.AttendanceDate.Time.Format(time.DateOnly) < currentTime.Format(time.DateOnly)
+}
+
+// LateMinutes returns the whole minutes the check-in was after the start of the shift of the work schedule,
+// or zero when the employee checked in within the grace minutes of the schedule. The shift starts on the attendance
+// date in the time zone of the schedule, whatever zone the check-in was read in.
+func (a *Attendance) LateMinutes(workSchedule WorkSchedule) int64 {
+	if !a.CheckInAt.Valid {
+		return 0
+	}
+
+	loc := workSchedule.Location()
+	shiftStart, _ := workSchedule.ShiftOn(a.AttendanceDate.Time, loc)
+	lateMinutes := int64(a.CheckInAt.Time.In(loc).Sub(shiftStart) / time.Minute)
+	if lateMinutes <= workSchedule.GraceMinutes {
+		return 0
+	}
+
+	return lateMinutes
+}
+
+// EarlyMinutes returns the whole minutes the check-out was before the end of the shift of the work schedule,
+// or zero when the employee checked out within the grace minutes of the schedule or has not checked out.
+func (a *Attendance) EarlyMinutes(workSchedule WorkSchedule) int64 {
+	if !a.CheckInAt.Valid || !a.CheckOutAt.Valid {
+		return 0
+	}
+
+	loc := workSchedule.Location()
+	_, shiftEnd := workSchedule.ShiftOn(a.AttendanceDate.Time, loc)
+	earlyMinutes := int64(shiftEnd.Sub(a.CheckOutAt.Time.In(loc)) / time.Minute)
+	if earlyMinutes <= workSchedule.GraceMinutes {
+		return 0
+	}
+
+	return earlyMinutes
+}
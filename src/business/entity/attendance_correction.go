@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// AttendanceCorrectionStatus constants represent the possible statuses of an attendance correction request.
+const (
+	// AttendanceCorrectionStatusPending indicates that the correction is waiting for an admin to review it.
+	AttendanceCorrectionStatusPending = "PENDING"
+
+	// AttendanceCorrectionStatusApproved indicates that the correction was approved and its attendance recorded.
+	AttendanceCorrectionStatusApproved = "APPROVED"
+
+	// AttendanceCorrectionStatusRejected indicates that the correction was rejected, no attendance is recorded.
+	AttendanceCorrectionStatusRejected = "REJECTED"
+)
+
+// AttendanceCorrection is a request of an employee to record the attendance of a past workday they did not check in on.
+// Once approved, AttendanceID is the attendance recorded on behalf of the employee.
+type AttendanceCorrection struct {
+	ID                 int64       `db:"id" json:"id"`
+	AttendancePeriodID int64       `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64       `db:"fk_user_id" json:"userID"`
+	AttendanceDate     null.Date   `db:"attendance_date" json:"attendanceDate" swaggertype:"string" example:"2022-06-21"`
+	AttendanceType     string      `db:"attendance_type" json:"attendanceType"`
+	CheckInAt          null.Time   `db:"check_in_at" json:"checkInAt" swaggertype:"string" example:"2022-06-21T08:55:00Z"`
+	CheckOutAt         null.Time   `db:"check_out_at" json:"checkOutAt" swaggertype:"string" example:"2022-06-21T17:30:00Z"`
+	Reason             string      `db:"reason" json:"reason"`
+	CorrectionStatus   string      `db:"correction_status" json:"correctionStatus"`
+	ReviewNote         null.String `db:"review_note" json:"reviewNote" swaggertype:"string"`
+	ReviewedAt         null.Time   `db:"reviewed_at" json:"reviewedAt" swaggertype:"string" example:"2022-06-22T10:32:29Z"`
+	ReviewedBy         null.Int64  `db:"reviewed_by" json:"reviewedBy" swaggertype:"integer"`
+	AttendanceID       null.Int64  `db:"fk_attendance_id" json:"attendanceID" swaggertype:"integer"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type AttendanceCorrectionInputParam struct {
+	AttendancePeriodID int64      `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64      `db:"fk_user_id" json:"userID"`
+	AttendanceDate     null.Date  `db:"attendance_date" json:"attendanceDate"`
+	AttendanceType     string     `db:"attendance_type" json:"attendanceType"`
+	CheckInAt          null.Time  `db:"check_in_at" json:"checkInAt"`
+	CheckOutAt         null.Time  `db:"check_out_at" json:"checkOutAt"`
+	Reason             string     `db:"reason" json:"reason"`
+	CorrectionStatus   string     `db:"correction_status" json:"correctionStatus"`
+	CreatedAt          null.Time  `db:"created_at" json:"-"`
+	CreatedBy          null.Int64 `db:"created_by" json:"-"`
+}
+
+type AttendanceCorrectionUpdateParam struct {
+	CorrectionStatus string      `db:"correction_status" json:"correctionStatus"`
+	ReviewNote       null.String `db:"review_note" json:"reviewNote"`
+	ReviewedAt       null.Time   `db:"reviewed_at" json:"reviewedAt"`
+	ReviewedBy       null.Int64  `db:"reviewed_by" json:"reviewedBy"`
+	AttendanceID     null.Int64  `db:"fk_attendance_id" json:"attendanceID"`
+	Status           null.Int64  `db:"status" json:"status"`
+	UpdatedAt        null.Time   `db:"updated_at" json:"-"`
+	UpdatedBy        null.Int64  `db:"updated_by" json:"-"`
+}
+
+type AttendanceCorrectionParam struct {
+	ID               int64  `db:"id" param:"id" json:"id"`
+	UserID           int64  `db:"fk_user_id" param:"user_id" json:"userID"`
+	CorrectionStatus string `db:"correction_status" param:"correction_status" json:"correctionStatus"`
+	QueryOption      query.Option
+	BypassCache      bool
+	PaginationParam
+}
+
+// IsReviewed reports whether the correction was already approved or rejected.
+func (a *AttendanceCorrection) IsReviewed() bool {
+	return a.CorrectionStatus != AttendanceCorrectionStatusPending
+}
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"encoding/csv"
+	"strings"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+)
+
+// AttendanceImportFormat constants are the log formats exported by the biometric devices.
+const (
+	// AttendanceImportFormatCSV is a comma separated line of the device user ID, the date as YYYY-MM-DD and the
+	// check-in and check-out times as HH:MM, e.g. "1042,2025-06-09,08:55,17:40". The check-out may be left empty.
+	AttendanceImportFormatCSV = "CSV"
+
+	// AttendanceImportFormatFixedWidth is a line of the device user ID padded to 10 characters, the date as YYYYMMDD
+	// and the check-in and check-out times as HHMM, e.g. "1042      2025060908551740". The check-out may be left blank.
+	AttendanceImportFormatFixedWidth = "FIXED_WIDTH"
+)
+
+// the column widths of a fixed-width device log line
+const (
+	fixedWidthDeviceUserIDEnd = 10
+	fixedWidthDateEnd         = 18
+	fixedWidthCheckInEnd      = 22
+	fixedWidthCheckOutEnd     = 26
+)
+
+// AttendanceImportLine is the daily attendance of an employee read from a line of a biometric device log.
+type AttendanceImportLine struct {
+	LineNumber     int64
+	DeviceUserID   string
+	AttendanceDate null.Date
+	CheckInAt      null.Time
+	CheckOutAt     null.Time
+}
+
+// IsAttendanceImportFormat reports whether the format is a supported biometric device log format.
+func IsAttendanceImportFormat(format string) bool {
+	return format == AttendanceImportFormatCSV || format == AttendanceImportFormatFixedWidth
+}
+
+// IsAttendanceImportHeader reports whether the line is the header row a CSV device log starts with.
+func IsAttendanceImportHeader(format string, line string) bool {
+	return format == AttendanceImportFormatCSV && strings.HasPrefix(strings.ToLower(strings.TrimSpace(line)), "device_user_id")
+}
+
+// ParseAttendanceImportLine reads the attendance of a device log line, the times are in the location of the device.
+func ParseAttendanceImportLine(format string, lineNumber int64, line string, loc *time.Location) (AttendanceImportLine, error) {
+	var deviceUserID, date, checkIn, checkOut, dateLayout, timeLayout string
+
+	switch format {
+	case AttendanceImportFormatCSV:
+		fields, err := csv.NewReader(strings.NewReader(line)).Read()
+		if err != nil {
+			return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "line is not valid CSV")
+		}
+
+		if len(fields) < 3 || len(fields) > 4 {
+			return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "line must have 3 or 4 fields, got %v", len(fields))
+		}
+
+		deviceUserID, date, checkIn = fields[0], fields[1], fields[2]
+		if len(fields) == 4 {
+			checkOut = fields[3]
+		}
+		dateLayout, timeLayout = time.DateOnly, "15:04"
+	case AttendanceImportFormatFixedWidth:
+		if len(line) < fixedWidthCheckInEnd {
+			return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "line must be at least %v characters long, got %v", fixedWidthCheckInEnd, len(line))
+		}
+
+		deviceUserID = line[:fixedWidthDeviceUserIDEnd]
+		date = line[fixedWidthDeviceUserIDEnd:fixedWidthDateEnd]
+		checkIn = line[fixedWidthDateEnd:fixedWidthCheckInEnd]
+		checkOut = line[fixedWidthCheckInEnd:min(len(line), fixedWidthCheckOutEnd)]
+		dateLayout, timeLayout = "20060102", "1504"
+	default:
+		return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "unknown format %s", format)
+	}
+
+	importLine := AttendanceImportLine{
+		LineNumber:   lineNumber,
+		DeviceUserID: strings.TrimSpace(deviceUserID),
+	}
+
+	if importLine.DeviceUserID == "" {
+		return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "device user ID is empty")
+	}
+
+	attendanceDate, err := time.ParseInLocation(dateLayout, strings.TrimSpace(date), loc)
+	if err != nil {
+		return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "date %q is not a valid date", strings.TrimSpace(date))
+	}
+	importLine.AttendanceDate = null.DateFrom(attendanceDate)
+
+	checkInAt, err := parseAttendanceImportTime(attendanceDate, timeLayout, checkIn, loc)
+	if err != nil {
+		return AttendanceImportLine{}, err
+	}
+
+	if !checkInAt.Valid {
+		return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "check-in time is empty")
+	}
+	importLine.CheckInAt = checkInAt
+
+	checkOutAt, err := parseAttendanceImportTime(attendanceDate, timeLayout, checkOut, loc)
+	if err != nil {
+		return AttendanceImportLine{}, err
+	}
+
+	if checkOutAt.Valid && !checkOutAt.Time.After(checkInAt.Time) {
+		return AttendanceImportLine{}, errors.NewWithCode(codes.CodeBadRequest, "check-out time is not after the check-in time")
+	}
+	importLine.CheckOutAt = checkOutAt
+
+	return importLine, nil
+}
+
+// parseAttendanceImportTime returns the time of day on the attendance date, or an invalid time when it is blank.
+func parseAttendanceImportTime(attendanceDate time.Time, layout string, value string, loc *time.Location) (null.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return null.Time{}, nil
+	}
+
+	clock, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return null.Time{}, errors.NewWithCode(codes.CodeBadRequest, "time %q is not a valid time", value)
+	}
+
+	return null.TimeFrom(time.Date(attendanceDate.Year(), attendanceDate.Month(), attendanceDate.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)), nil
+}
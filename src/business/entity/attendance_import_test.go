@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseAttendanceImportLine(t *testing.T) {
+	checkInAt := time.Date(2025, 6, 9, 8, 55, 0, 0, time.UTC)
+	checkOutAt := time.Date(2025, 6, 9, 17, 40, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		format  string
+		line    string
+		want    AttendanceImportLine
+		wantErr string
+	}{
+		{
+			name:   "CSV",
+			format: AttendanceImportFormatCSV,
+			line:   "1042,2025-06-09,08:55,17:40",
+			want: AttendanceImportLine{
+				LineNumber:     2,
+				DeviceUserID:   "1042",
+				AttendanceDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+				CheckInAt:      null.TimeFrom(checkInAt),
+				CheckOutAt:     null.TimeFrom(checkOutAt),
+			},
+		},
+		{
+			name:   "CSV Without Check Out",
+			format: AttendanceImportFormatCSV,
+			line:   " 1042 ,2025-06-09,08:55",
+			want: AttendanceImportLine{
+				LineNumber:     2,
+				DeviceUserID:   "1042",
+				AttendanceDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+				CheckInAt:      null.TimeFrom(checkInAt),
+			},
+		},
+		{
+			name:   "Fixed Width",
+			format: AttendanceImportFormatFixedWidth,
+			line:   "1042      2025060908551740",
+			want: AttendanceImportLine{
+				LineNumber:     2,
+				DeviceUserID:   "1042",
+				AttendanceDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+				CheckInAt:      null.TimeFrom(checkInAt),
+				CheckOutAt:     null.TimeFrom(checkOutAt),
+			},
+		},
+		{
+			name:   "Fixed Width Without Check Out",
+			format: AttendanceImportFormatFixedWidth,
+			line:   "1042      202506090855    ",
+			want: AttendanceImportLine{
+				LineNumber:     2,
+				DeviceUserID:   "1042",
+				AttendanceDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+				CheckInAt:      null.TimeFrom(checkInAt),
+			},
+		},
+		{
+			name:    "CSV With Too Few Fields",
+			format:  AttendanceImportFormatCSV,
+			line:    "1042,2025-06-09",
+			wantErr: "line must have 3 or 4 fields, got 2",
+		},
+		{
+			name:    "Fixed Width Too Short",
+			format:  AttendanceImportFormatFixedWidth,
+			line:    "1042      20250609",
+			wantErr: "line must be at least 22 characters long, got 18",
+		},
+		{
+			name:    "Empty Device User ID",
+			format:  AttendanceImportFormatCSV,
+			line:    ",2025-06-09,08:55,17:40",
+			wantErr: "device user ID is empty",
+		},
+		{
+			name:    "Invalid Date",
+			format:  AttendanceImportFormatCSV,
+			line:    "1042,09/06/2025,08:55,17:40",
+			wantErr: `date "09/06/2025" is not a valid date`,
+		},
+		{
+			name:    "Invalid Time",
+			format:  AttendanceImportFormatCSV,
+			line:    "1042,2025-06-09,8.55,17:40",
+			wantErr: `time "8.55" is not a valid time`,
+		},
+		{
+			name:    "Check Out Before Check In",
+			format:  AttendanceImportFormatCSV,
+			line:    "1042,2025-06-09,17:40,08:55",
+			wantErr: "check-out time is not after the check-in time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAttendanceImportLine(tt.format, 2, tt.line, time.UTC)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -34,6 +34,9 @@ type AttendancePeriod struct {
 	EndDate      null.Date `db:"end_date" json:"endDate"`
 	PeriodStatus string    `db:"period_status" json:"periodStatus"`
 
+	// ExchangeRateFixingDate is the date the exchange rates of the payroll are fixed on, the end date when empty.
+	ExchangeRateFixingDate null.Date `db:"exchange_rate_fixing_date" json:"exchangeRateFixingDate" swaggertype:"string" example:"2022-06-30"`
+
 	// Utility Column
 	Status    int64       `db:"status" json:"status"`
 	Flag      int64       `db:"flag" json:"flag,omitempty"`
@@ -47,11 +50,12 @@ type AttendancePeriod struct {
 }
 
 type AttendancePeriodInputParam struct {
-	StartDate    null.Date  `db:"start_date" json:"startDate"`
-	EndDate      null.Date  `db:"end_date" json:"endDate"`
-	PeriodStatus string     `db:"period_status" json:"periodStatus"`
-	CreatedAt    null.Time  `db:"created_at" json:"-"`
-	CreatedBy    null.Int64 `db:"created_by" json:"-"`
+	StartDate              null.Date  `db:"start_date" json:"startDate"`
+	EndDate                null.Date  `db:"end_date" json:"endDate"`
+	PeriodStatus           string     `db:"period_status" json:"periodStatus"`
+	ExchangeRateFixingDate null.Date  `db:"exchange_rate_fixing_date" json:"exchangeRateFixingDate"`
+	CreatedAt              null.Time  `db:"created_at" json:"-"`
+	CreatedBy              null.Int64 `db:"created_by" json:"-"`
 }
 
 type AttendancePeriodUpdateParam struct {
@@ -69,22 +73,53 @@ type AttendancePeriodParam struct {
 	PeriodStatus string    `db:"period_status" param:"period_status" json:"periodStatus"`
 	EndDateLT    null.Date `db:"end_date" param:"end_date__lt" json:"endDate"`
 	StartDateLTE null.Date `db:"start_date" param:"start_date__lte" json:"startDate"`
+	EndDateGTE   null.Date `db:"end_date" param:"end_date__gte" json:"endDateGTE"`
 	QueryOption  query.Option
 	BypassCache  bool
 	PaginationParam
 }
 
-func (a *AttendancePeriod) TotalWorkingDays() int64 {
+// GetExchangeRateFixingDate returns the date the exchange rates of the payroll of the period are fixed on.
+func (a *AttendancePeriod) GetExchangeRateFixingDate() null.Date {
+	if a.ExchangeRateFixingDate.Valid {
+		return a.ExchangeRateFixingDate
+	}
+
+	return a.EndDate
+}
+
+// IsCorrectable reports whether the attendance of the period can still be corrected, which is until its payroll is processed.
+func (a *AttendancePeriod) IsCorrectable() bool {
+	return a.PeriodStatus == PeriodStatusOpen || a.PeriodStatus == PeriodStatusClosed
+}
+
+// Contains reports whether the date falls between the start and the end date of the period.
+func (a *AttendancePeriod) Contains(date time.Time) bool {
+	day := date.Format(time.DateOnly)
+	return day >= a.StartDate.Time.Format(time.DateOnly) && day <= a.EndDate.Time.Format(time.DateOnly)
+}
+
+// PayrollYear is the calendar year the payslips of the period count toward, the year the period ends in.
+func (a *AttendancePeriod) PayrollYear() int64 {
+	return int64(a.EndDate.Time.Year())
+}
+
+// TotalWorkingDays counts the days of the period an employee is scheduled to work that are not a public holiday.
+func (a *AttendancePeriod) TotalWorkingDays(workCalendar WorkCalendar, holidays HolidayCalendar) int64 {
 	var workdayCount int64
 
 	for d := a.StartDate.Time; !d.After(a.EndDate.Time); d = d.AddDate(0, 0, 1) {
-		weekday := d.Weekday()
-
-		// Check if the day is not a weekend day.
-		if weekday != time.Saturday && weekday != time.Sunday {
+		// Check if the day is a scheduled working day and not a public holiday.
+		if workCalendar.IsWorkDay(d) && !holidays.IsHoliday(d) {
 			workdayCount++
 		}
 	}
 
 	return workdayCount
 }
+
+// EmployedWorkingDays counts the working days of the period that fall within the employment of the user,
+// it is lower than TotalWorkingDays for employees who joined or left during the period.
+func (a *AttendancePeriod) EmployedWorkingDays(user User, workCalendar WorkCalendar, holidays HolidayCalendar) int64 {
+	return countEmployedWorkingDays(a.StartDate.Time, a.EndDate.Time, user, workCalendar, holidays)
+}
@@ -0,0 +1,182 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AttendancePeriod_TotalWorkingDays(t *testing.T) {
+	// June 2025 starts on a Sunday and has 21 weekdays
+	period := AttendancePeriod{
+		StartDate: null.DateFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   null.DateFrom(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)),
+	}
+
+	schedules := map[int64]WorkSchedule{
+		1: {ID: 1, Name: "Warehouse", WorkDays: "TUE,WED,THU,FRI,SAT"},
+		2: {ID: 2, Name: "Four Day Week", WorkDays: "MON,TUE,WED,THU"},
+	}
+
+	tests := []struct {
+		name        string
+		assignments []UserWorkSchedule
+		holidays    []Holiday
+		region      string
+		want        int64
+	}{
+		{
+			name: "Default Monday To Friday",
+			want: 21,
+		},
+		{
+			name:        "Tuesday To Saturday",
+			assignments: []UserWorkSchedule{{WorkScheduleID: 1, EffectiveDate: null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))}},
+			// five Mondays off, four Saturdays worked
+			want: 20,
+		},
+		{
+			name: "Schedule Changes Mid Period",
+			assignments: []UserWorkSchedule{
+				{WorkScheduleID: 2, EffectiveDate: null.DateFrom(time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC))},
+				{WorkScheduleID: 1, EffectiveDate: null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))},
+			},
+			// 10 Tuesday to Saturday days until the 15th, then 9 Monday to Thursday days
+			want: 19,
+		},
+		{
+			name: "Holidays Of Own Region Only",
+			holidays: []Holiday{
+				{HolidayDate: null.DateFrom(time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC)), Name: "Eid al-Adha"},
+				{HolidayDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)), Name: "Regional Holiday", Region: "BALI"},
+				{HolidayDate: null.DateFrom(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)), Name: "Other Regional Holiday", Region: "ACEH"},
+			},
+			region: "BALI",
+			want:   19,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workCalendar := NewWorkCalendar(tt.assignments, schedules)
+			holidays := NewHolidayCalendar(tt.holidays, tt.region)
+
+			assert.Equal(t, tt.want, period.TotalWorkingDays(workCalendar, holidays))
+		})
+	}
+}
+
+func Test_AttendancePeriod_EmployedWorkingDays(t *testing.T) {
+	// June 2025 starts on a Sunday and has 21 weekdays
+	period := AttendancePeriod{
+		StartDate: null.DateFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   null.DateFrom(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name string
+		user User
+		want int64
+	}{
+		{
+			name: "Without Employment Dates",
+			user: User{},
+			want: 21,
+		},
+		{
+			name: "Employed Before And After The Period",
+			user: User{
+				HireDate:        null.DateFrom(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				TerminationDate: null.DateFrom(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)),
+			},
+			want: 21,
+		},
+		{
+			name: "Joined Mid Period",
+			user: User{HireDate: null.DateFrom(time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC))},
+			want: 11,
+		},
+		{
+			name: "Left Mid Period",
+			user: User{TerminationDate: null.DateFrom(time.Date(2025, 6, 13, 0, 0, 0, 0, time.UTC))},
+			want: 10,
+		},
+		{
+			name: "Joined And Left Within The Period",
+			user: User{
+				HireDate:        null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+				TerminationDate: null.DateFrom(time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC)),
+			},
+			want: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, period.EmployedWorkingDays(tt.user, NewWorkCalendar(nil, nil), HolidayCalendar{}))
+		})
+	}
+}
+
+func Test_AttendancePeriod_SalarySegments(t *testing.T) {
+	// June 2025 starts on a Sunday and has 21 weekdays
+	period := AttendancePeriod{
+		StartDate: null.DateFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   null.DateFrom(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)),
+	}
+
+	user := User{BaseSalary: NewMoney(1000000)}
+
+	tests := []struct {
+		name     string
+		salaries []UserSalary
+		want     []SalarySegment
+	}{
+		{
+			name: "Without Salary History",
+			want: []SalarySegment{
+				{BaseSalary: NewMoney(1000000), StartDate: period.StartDate, EndDate: period.EndDate, WorkingDays: 21},
+			},
+		},
+		{
+			name: "Salary Effective Before The Period",
+			salaries: []UserSalary{
+				{BaseSalary: NewMoney(2000000), EffectiveDate: null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))},
+				{BaseSalary: NewMoney(1500000), EffectiveDate: null.DateFrom(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))},
+			},
+			want: []SalarySegment{
+				{BaseSalary: NewMoney(2000000), StartDate: period.StartDate, EndDate: period.EndDate, WorkingDays: 21},
+			},
+		},
+		{
+			name: "Salary Changes Mid Period",
+			salaries: []UserSalary{
+				{BaseSalary: NewMoney(3000000), EffectiveDate: null.DateFrom(time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC))},
+				{BaseSalary: NewMoney(2000000), EffectiveDate: null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))},
+				{BaseSalary: NewMoney(4000000), EffectiveDate: null.DateFrom(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))},
+			},
+			want: []SalarySegment{
+				{
+					BaseSalary:  NewMoney(2000000),
+					StartDate:   period.StartDate,
+					EndDate:     null.DateFrom(time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)),
+					WorkingDays: 10,
+				},
+				{
+					BaseSalary:  NewMoney(3000000),
+					StartDate:   null.DateFrom(time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC)),
+					EndDate:     period.EndDate,
+					WorkingDays: 11,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, period.SalarySegments(user, tt.salaries, NewWorkCalendar(nil, nil), HolidayCalendar{}))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Attendance_LateAndEarlyMinutes(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workSchedule := WorkSchedule{
+		Name:         "Office",
+		ShiftStart:   "09:00",
+		ShiftEnd:     "17:00",
+		TimeZone:     "Asia/Jakarta",
+		GraceMinutes: 10,
+	}
+
+	nightSchedule := WorkSchedule{
+		Name:       "Night Warehouse",
+		ShiftStart: "22:00",
+		ShiftEnd:   "06:00",
+		TimeZone:   "Asia/Jakarta",
+	}
+
+	attendanceDate := null.DateFrom(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name         string
+		workSchedule WorkSchedule
+		attendance   Attendance
+		wantLate     int64
+		wantEarly    int64
+	}{
+		{
+			// the database reads the timestamps back in UTC, 02:30 UTC is 09:30 in Jakarta
+			name:         "Check In Read In UTC",
+			workSchedule: workSchedule,
+			attendance: Attendance{
+				AttendanceDate: attendanceDate,
+				CheckInAt:      null.TimeFrom(time.Date(2025, 6, 2, 2, 30, 0, 0, time.UTC)),
+				CheckOutAt:     null.TimeFrom(time.Date(2025, 6, 2, 9, 15, 0, 0, time.UTC)),
+			},
+			wantLate:  30,
+			wantEarly: 45,
+		},
+		{
+			name:         "Check In Read In Office Time",
+			workSchedule: workSchedule,
+			attendance: Attendance{
+				AttendanceDate: attendanceDate,
+				CheckInAt:      null.TimeFrom(time.Date(2025, 6, 2, 9, 5, 0, 0, jakarta)),
+				CheckOutAt:     null.TimeFrom(time.Date(2025, 6, 2, 17, 0, 0, 0, jakarta)),
+			},
+			wantLate:  0,
+			wantEarly: 0,
+		},
+		{
+			// 10:00 UTC is 17:00 in Jakarta, a check-out on time rather than seven hours early
+			name:         "Check Out On Time Read In UTC",
+			workSchedule: workSchedule,
+			attendance: Attendance{
+				AttendanceDate: attendanceDate,
+				CheckInAt:      null.TimeFrom(time.Date(2025, 6, 2, 2, 5, 0, 0, time.UTC)),
+				CheckOutAt:     null.TimeFrom(time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)),
+			},
+			wantLate:  0,
+			wantEarly: 0,
+		},
+		{
+			// the night shift ends at 06:00 in Jakarta on the next day, which is 23:00 UTC on the attendance date
+			name:         "Night Shift Check Out",
+			workSchedule: nightSchedule,
+			attendance: Attendance{
+				AttendanceDate: attendanceDate,
+				CheckInAt:      null.TimeFrom(time.Date(2025, 6, 2, 15, 20, 0, 0, time.UTC)),
+				CheckOutAt:     null.TimeFrom(time.Date(2025, 6, 2, 22, 0, 0, 0, time.UTC)),
+			},
+			wantLate:  20,
+			wantEarly: 60,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantLate, tt.attendance.LateMinutes(tt.workSchedule))
+			assert.Equal(t, tt.wantEarly, tt.attendance.EarlyMinutes(tt.workSchedule))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// AttendanceTypeCode constants are where an employee worked on the day of an attendance.
+const (
+	// AttendanceTypeCodeOffice is a day worked at an office location, the check-in is geofenced.
+	AttendanceTypeCodeOffice = "OFFICE"
+
+	// AttendanceTypeCodeWorkFromHome is a day worked from home.
+	AttendanceTypeCodeWorkFromHome = "WFH"
+
+	// AttendanceTypeCodeFieldVisit is a day spent visiting a client or a site.
+	AttendanceTypeCodeFieldVisit = "FIELD_VISIT"
+
+	// AttendanceTypeCodeBusinessTravel is a day spent travelling on business.
+	AttendanceTypeCodeBusinessTravel = "BUSINESS_TRAVEL"
+)
+
+// AttendanceType is the pay treatment of the attendances of a type. DailyAllowance is paid on top of the base pay
+// for every paid day of the type and is in the reporting currency. The days of the type beyond MaxDaysPerPeriod in an
+// attendance period are recorded but not paid, a zero MaxDaysPerPeriod leaves the days uncapped.
+type AttendanceType struct {
+	ID               int64  `db:"id" json:"id"`
+	Code             string `db:"code" json:"code"`
+	Name             string `db:"name" json:"name"`
+	DailyAllowance   Money  `db:"daily_allowance" json:"dailyAllowance" swaggertype:"number"`
+	MaxDaysPerPeriod int64  `db:"max_days_per_period" json:"maxDaysPerPeriod"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type AttendanceTypeInputParam struct {
+	Code             string     `db:"code" json:"code"`
+	Name             string     `db:"name" json:"name"`
+	DailyAllowance   Money      `db:"daily_allowance" json:"dailyAllowance"`
+	MaxDaysPerPeriod int64      `db:"max_days_per_period" json:"maxDaysPerPeriod"`
+	CreatedAt        null.Time  `db:"created_at" json:"-"`
+	CreatedBy        null.Int64 `db:"created_by" json:"-"`
+}
+
+type AttendanceTypeUpdateParam struct {
+	Name             null.String `db:"name" json:"name"`
+	DailyAllowance   NullMoney   `db:"daily_allowance" json:"dailyAllowance"`
+	MaxDaysPerPeriod null.Int64  `db:"max_days_per_period" json:"maxDaysPerPeriod"`
+	Status           null.Int64  `db:"status" json:"status"`
+	UpdatedAt        null.Time   `db:"updated_at" json:"-"`
+	UpdatedBy        null.Int64  `db:"updated_by" json:"-"`
+}
+
+type AttendanceTypeParam struct {
+	ID          int64  `db:"id" param:"id" json:"id"`
+	Code        string `db:"code" param:"code" json:"code"`
+	QueryOption query.Option
+	BypassCache bool
+	PaginationParam
+}
+
+// IsCapped reports whether only some days of the type are paid in an attendance period.
+func (a *AttendanceType) IsCapped() bool {
+	return a.MaxDaysPerPeriod > 0
+}
+
+// PaidDays returns how many of the days attended with the type in an attendance period are paid.
+func (a *AttendanceType) PaidDays(days int64) int64 {
+	if !a.IsCapped() {
+		return days
+	}
+
+	return min(days, a.MaxDaysPerPeriod)
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"regexp"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// ReportingCurrency is the local currency. Tax brackets and the amounts in pay component configs are
+// expressed in it, and amounts in every other currency are converted to it for reporting.
+const ReportingCurrency = "IDR"
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// IsValidCurrency reports whether the code is an ISO 4217 style three letter currency code.
+func IsValidCurrency(currency string) bool {
+	return currencyPattern.MatchString(currency)
+}
+
+// ExchangeRate is the amount of the reporting currency one unit of Currency was worth on FixingDate.
+// A payroll converts with the latest rate of every currency fixed on or before the fixing date of its
+// attendance period, so recomputing an old period always converts at the same rates.
+type ExchangeRate struct {
+	ID         int64     `db:"id" json:"id"`
+	Currency   string    `db:"currency" json:"currency"`
+	Rate       float64   `db:"rate" json:"rate"`
+	FixingDate null.Date `db:"fixing_date" json:"fixingDate" swaggertype:"string" example:"2022-01-31"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type ExchangeRateInputParam struct {
+	Currency   string     `db:"currency" json:"currency"`
+	Rate       float64    `db:"rate" json:"rate"`
+	FixingDate null.Date  `db:"fixing_date" json:"fixingDate"`
+	CreatedAt  null.Time  `db:"created_at" json:"-"`
+	CreatedBy  null.Int64 `db:"created_by" json:"-"`
+}
+
+type ExchangeRateUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+}
+
+type ExchangeRateParam struct {
+	ID            int64     `db:"id" param:"id" json:"id"`
+	Currency      string    `db:"currency" param:"currency" json:"currency"`
+	FixingDate    null.Date `db:"fixing_date" param:"fixing_date" json:"fixingDate"`
+	FixingDateLTE null.Date `db:"fixing_date" param:"fixing_date__lte" json:"fixingDateLTE"`
+	QueryOption   query.Option
+	BypassCache   bool
+	PaginationParam
+}
+
+// GetEffectiveExchangeRates picks the latest rate of every currency out of rates sorted by fixing date
+// descending. The reporting currency always converts to itself at 1.
+func GetEffectiveExchangeRates(rates []ExchangeRate) map[string]float64 {
+	effective := map[string]float64{ReportingCurrency: 1}
+	for _, rate := range rates {
+		if _, ok := effective[rate.Currency]; ok {
+			continue
+		}
+
+		effective[rate.Currency] = rate.Rate
+	}
+
+	return effective
+}
@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// Holiday is a public holiday of the holiday calendar. A holiday without a region is observed nationwide,
+// a regional holiday is only observed by the employees of that region.
+type Holiday struct {
+	ID          int64     `db:"id" json:"id"`
+	HolidayDate null.Date `db:"holiday_date" json:"holidayDate" swaggertype:"string" example:"2022-08-17"`
+	Name        string    `db:"name" json:"name"`
+	Region      string    `db:"region" json:"region"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type HolidayInputParam struct {
+	HolidayDate null.Date  `db:"holiday_date" json:"holidayDate"`
+	Name        string     `db:"name" json:"name"`
+	Region      string     `db:"region" json:"region"`
+	CreatedAt   null.Time  `db:"created_at" json:"-"`
+	CreatedBy   null.Int64 `db:"created_by" json:"-"`
+}
+
+type HolidayUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+	DeletedAt null.Time  `db:"deleted_at" json:"-"`
+	DeletedBy null.Int64 `db:"deleted_by" json:"-"`
+}
+
+type HolidayParam struct {
+	ID             int64     `db:"id" param:"id" json:"id"`
+	HolidayDate    null.Date `db:"holiday_date" param:"holiday_date" json:"holidayDate"`
+	HolidayDateGTE null.Date `db:"holiday_date" param:"holiday_date__gte" json:"holidayDateGTE"`
+	HolidayDateLTE null.Date `db:"holiday_date" param:"holiday_date__lte" json:"holidayDateLTE"`
+	Regions        []string  `db:"region" param:"region" json:"regions"`
+	QueryOption    query.Option
+	BypassCache    bool
+	PaginationParam
+}
+
+// IsObservedIn reports whether the holiday is a day off for the employees of the given region.
+func (h *Holiday) IsObservedIn(region string) bool {
+	return h.Region == "" || h.Region == region
+}
+
+// HolidayCalendar is the set of public holidays observed by an employee, keyed by date.
+type HolidayCalendar map[string]Holiday
+
+// NewHolidayCalendar returns the nationwide holidays and the holidays of the given region.
+func NewHolidayCalendar(holidays []Holiday, region string) HolidayCalendar {
+	calendar := make(HolidayCalendar)
+	for _, holiday := range holidays {
+		if holiday.IsObservedIn(region) {
+			calendar[holiday.HolidayDate.Time.Format(time.DateOnly)] = holiday
+		}
+	}
+
+	return calendar
+}
+
+// IsHoliday reports whether the date is a public holiday of the calendar.
+func (h HolidayCalendar) IsHoliday(date time.Time) bool {
+	_, ok := h[date.Format(time.DateOnly)]
+	return ok
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// LoanStatus constants represent the possible statuses of an employee loan.
+const (
+	// LoanStatusActive indicates that the loan still has an outstanding balance to be repaid by payroll.
+	LoanStatusActive = "ACTIVE"
+
+	// LoanStatusPaidOff indicates that the whole principal of the loan has been repaid.
+	LoanStatusPaidOff = "PAID_OFF"
+)
+
+// Loan is a loan or salary advance given to an employee, repaid by a fixed instalment withheld from
+// every payroll of a period ending on or after StartDate until RemainingBalance reaches zero.
+// Amounts are in the salary currency the employee had when the loan was given.
+type Loan struct {
+	ID               int64     `db:"id" json:"id"`
+	UserID           int64     `db:"fk_user_id" json:"userID"`
+	Description      string    `db:"description" json:"description"`
+	Principal        Money     `db:"principal" json:"principal" swaggertype:"number"`
+	InstalmentCount  int64     `db:"instalment_count" json:"instalmentCount"`
+	InstalmentAmount Money     `db:"instalment_amount" json:"instalmentAmount" swaggertype:"number"`
+	RemainingBalance Money     `db:"remaining_balance" json:"remainingBalance" swaggertype:"number"`
+	Currency         string    `db:"currency" json:"currency"`
+	StartDate        null.Date `db:"start_date" json:"startDate" swaggertype:"string" example:"2022-01-01"`
+	LoanStatus       string    `db:"loan_status" json:"loanStatus"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type LoanInputParam struct {
+	UserID           int64      `db:"fk_user_id" json:"userID"`
+	Description      string     `db:"description" json:"description"`
+	Principal        Money      `db:"principal" json:"principal"`
+	InstalmentCount  int64      `db:"instalment_count" json:"instalmentCount"`
+	InstalmentAmount Money      `db:"instalment_amount" json:"instalmentAmount"`
+	RemainingBalance Money      `db:"remaining_balance" json:"remainingBalance"`
+	Currency         string     `db:"currency" json:"currency"`
+	StartDate        null.Date  `db:"start_date" json:"startDate"`
+	LoanStatus       string     `db:"loan_status" json:"loanStatus"`
+	CreatedAt        null.Time  `db:"created_at" json:"-"`
+	CreatedBy        null.Int64 `db:"created_by" json:"-"`
+}
+
+type LoanUpdateParam struct {
+	RemainingBalance NullMoney  `db:"remaining_balance" json:"remainingBalance"`
+	LoanStatus       string     `db:"loan_status" json:"loanStatus"`
+	Status           null.Int64 `db:"status" json:"status"`
+	UpdatedAt        null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy        null.Int64 `db:"updated_by" json:"-"`
+}
+
+type LoanParam struct {
+	ID           int64     `db:"id" param:"id" json:"id"`
+	UserID       int64     `db:"fk_user_id" param:"user_id" json:"userID"`
+	LoanStatus   string    `db:"loan_status" param:"loan_status" json:"loanStatus"`
+	StartDateLTE null.Date `db:"start_date" param:"start_date__lte" json:"startDateLTE"`
+	QueryOption  query.Option
+	BypassCache  bool
+	PaginationParam
+}
+
+// DueInstalment returns the instalment withheld by the next payroll, the last instalment
+// only takes what is left of the balance.
+func (l *Loan) DueInstalment() Money {
+	return min(l.InstalmentAmount, l.RemainingBalance)
+}
+
+// CalculateLoanInstalment spreads the principal evenly over the instalments, rounded up to the cent
+// so the loan is always repaid within instalmentCount payrolls.
+func CalculateLoanInstalment(principal Money, instalmentCount int64) Money {
+	if instalmentCount <= 0 {
+		return principal
+	}
+
+	instalment := principal / Money(instalmentCount)
+	if principal%Money(instalmentCount) != 0 {
+		instalment++
+	}
+
+	return instalment
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// LoanRepaymentStatus constants represent the possible statuses of a loan repayment.
+const (
+	// LoanRepaymentStatusPaid indicates that the instalment was withheld from the payslip of the run.
+	LoanRepaymentStatusPaid = "PAID"
+
+	// LoanRepaymentStatusReversed indicates that the payroll run was voided and the instalment was added back to the loan balance.
+	LoanRepaymentStatusReversed = "REVERSED"
+)
+
+// LoanRepayment is an instalment of a loan withheld by a payroll run.
+type LoanRepayment struct {
+	ID              int64  `db:"id" json:"id"`
+	LoanID          int64  `db:"fk_loan_id" json:"loanID"`
+	UserID          int64  `db:"fk_user_id" json:"userID"`
+	PayrollRunID    int64  `db:"fk_payroll_run_id" json:"payrollRunID"`
+	PayslipID       int64  `db:"fk_payslip_id" json:"payslipID"`
+	Amount          Money  `db:"amount" json:"amount" swaggertype:"number"`
+	Currency        string `db:"currency" json:"currency"`
+	RepaymentStatus string `db:"repayment_status" json:"repaymentStatus"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type LoanRepaymentInputParam struct {
+	LoanID          int64      `db:"fk_loan_id" json:"loanID"`
+	UserID          int64      `db:"fk_user_id" json:"userID"`
+	PayrollRunID    int64      `db:"fk_payroll_run_id" json:"payrollRunID"`
+	PayslipID       int64      `db:"fk_payslip_id" json:"payslipID"`
+	Amount          Money      `db:"amount" json:"amount"`
+	Currency        string     `db:"currency" json:"currency"`
+	RepaymentStatus string     `db:"repayment_status" json:"repaymentStatus"`
+	CreatedAt       null.Time  `db:"created_at" json:"-"`
+	CreatedBy       null.Int64 `db:"created_by" json:"-"`
+}
+
+type LoanRepaymentUpdateParam struct {
+	RepaymentStatus string     `db:"repayment_status" json:"repaymentStatus"`
+	Status          null.Int64 `db:"status" json:"status"`
+	UpdatedAt       null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy       null.Int64 `db:"updated_by" json:"-"`
+}
+
+type LoanRepaymentParam struct {
+	ID              int64  `db:"id" param:"id" json:"id"`
+	LoanID          int64  `db:"fk_loan_id" param:"loan_id" json:"loanID"`
+	PayrollRunID    int64  `db:"fk_payroll_run_id" param:"payroll_run_id" json:"payrollRunID"`
+	RepaymentStatus string `db:"repayment_status" param:"repayment_status" json:"repaymentStatus"`
+	QueryOption     query.Option
+	BypassCache     bool
+	PaginationParam
+}
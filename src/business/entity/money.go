@@ -0,0 +1,268 @@
+package entity
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+)
+
+// MoneyScale is the number of decimal places every amount of money is kept at,
+// it matches the DECIMAL(15,2) columns amounts are stored in.
+const MoneyScale = 2
+
+const moneyUnit = 100
+
+var moneyPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// Money is an exact amount of currency held as a whole number of cents.
+// Amounts are added and subtracted as integers, so they never drift. Multiplying or dividing
+// an amount rounds the exact result once, half away from zero, back to the cent.
+type Money int64
+
+// NewMoney returns the amount of whole currency units.
+func NewMoney(units int64) Money {
+	return Money(units * moneyUnit)
+}
+
+// ParseMoney parses a decimal such as "1500000.50". More decimal places than MoneyScale are
+// rejected rather than rounded, so no amount silently changes on its way in.
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+
+	if !moneyPattern.MatchString(value) {
+		return 0, fmt.Errorf("invalid amount of money %q", value)
+	}
+
+	rat, _ := new(big.Rat).SetString(value)
+
+	cents := new(big.Rat).Mul(rat, big.NewRat(moneyUnit, 1))
+	if !cents.IsInt() {
+		return 0, fmt.Errorf("amount of money %q has more than %d decimal places", value, MoneyScale)
+	}
+
+	if !cents.Num().IsInt64() {
+		return 0, fmt.Errorf("amount of money %q is out of range", value)
+	}
+
+	return Money(cents.Num().Int64()), nil
+}
+
+// MulDiv returns the amount multiplied by numerator and divided by denominator, rounded once
+// at the end. Doing both in one step keeps a prorated amount from picking up rounding drift.
+func (m Money) MulDiv(numerator, denominator float64) Money {
+	if denominator == 0 {
+		return 0
+	}
+
+	result := new(big.Rat).SetInt64(int64(m))
+	result.Mul(result, decimalRat(numerator))
+	result.Quo(result, decimalRat(denominator))
+
+	return roundRat(result)
+}
+
+// MulRate returns the amount multiplied by a rate such as 0.05, rounded to the cent.
+func (m Money) MulRate(rate float64) Money {
+	return m.MulDiv(rate, 1)
+}
+
+// Float64 returns the amount in currency units, for ratios and display only.
+func (m Money) Float64() float64 {
+	return float64(m) / moneyUnit
+}
+
+// String formats the amount with exactly MoneyScale decimal places.
+func (m Money) String() string {
+	sign := ""
+	cents := int64(m)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/moneyUnit, cents%moneyUnit)
+}
+
+func (m *Money) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*m = 0
+	case int64:
+		*m = NewMoney(v)
+	case float64:
+		parsed, err := ParseMoney(strconv.FormatFloat(v, 'f', MoneyScale, 64))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case []byte:
+		parsed, err := ParseMoney(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case string:
+		parsed, err := ParseMoney(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	default:
+		return fmt.Errorf("cannot scan %T into money", value)
+	}
+
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(b, &number); err != nil {
+		return fmt.Errorf("invalid amount of money %s", string(b))
+	}
+
+	parsed, err := ParseMoney(number.String())
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// NullMoney is an amount of money that may be missing, it is stored as NULL. A param holding one has to be passed to
+// the query builder through SQLUpdateParam or SQLQueryParam.
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+func NullMoneyFrom(m Money) NullMoney {
+	return NullMoney{Money: m, Valid: true}
+}
+
+func (n *NullMoney) Scan(value interface{}) error {
+	if value == nil {
+		*n = NullMoney{}
+		return nil
+	}
+
+	n.Valid = true
+	return n.Money.Scan(value)
+}
+
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Money.Value()
+}
+
+func (n NullMoney) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return n.Money.MarshalJSON()
+}
+
+func (n *NullMoney) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = NullMoney{}
+		return nil
+	}
+
+	if err := n.Money.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+var (
+	nullMoneyType  = reflect.TypeOf(NullMoney{})
+	nullStringType = reflect.TypeOf(null.String{})
+)
+
+// SQLUpdateParam returns a pointer to the update param for the query builder to write. The builder only writes the
+// null types of go-pkg and walks into any other struct for tagged fields, so it would drop a NullMoney column without
+// an error. Every NullMoney field is passed on as a null.String of its exact decimal instead, the same text a Money is
+// stored as, and left out of the update when it is not valid.
+func SQLUpdateParam(updateParam interface{}) interface{} {
+	return sqlParam(updateParam)
+}
+
+// SQLQueryParam returns a pointer to the filter param for the query builder to read. A plain Money filter of zero is
+// skipped by the builder like any zero value, so an amount filter is a NullMoney passed on the same way as in
+// SQLUpdateParam, and filters on zero as long as it is valid.
+func SQLQueryParam(param interface{}) interface{} {
+	return sqlParam(param)
+}
+
+func sqlParam(param interface{}) interface{} {
+	value := reflect.ValueOf(param)
+
+	fields := make([]reflect.StructField, value.NumField())
+	for i := range fields {
+		fields[i] = value.Type().Field(i)
+		if fields[i].Type == nullMoneyType {
+			fields[i].Type = nullStringType
+		}
+	}
+
+	sqlParam := reflect.New(reflect.StructOf(fields)).Elem()
+	for i := range fields {
+		field := value.Field(i)
+		if field.Type() != nullMoneyType {
+			sqlParam.Field(i).Set(field)
+			continue
+		}
+
+		if amount := field.Interface().(NullMoney); amount.Valid {
+			sqlParam.Field(i).Set(reflect.ValueOf(null.StringFrom(amount.Money.String())))
+		}
+	}
+
+	return sqlParam.Addr().Interface()
+}
+
+// decimalRat converts a float to the decimal it was written as, so that 0.1 stays exactly one tenth.
+func decimalRat(f float64) *big.Rat {
+	rat, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	return rat
+}
+
+// roundRat rounds an amount of cents half away from zero.
+func roundRat(cents *big.Rat) Money {
+	quotient, remainder := new(big.Int).QuoRem(cents.Num(), cents.Denom(), new(big.Int))
+
+	// round away from zero when the remainder is at least half of the denominator
+	twiceRemainder := new(big.Int).Abs(remainder)
+	twiceRemainder.Lsh(twiceRemainder, 1)
+	if twiceRemainder.Cmp(cents.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(cents.Sign())))
+	}
+
+	return Money(quotient.Int64())
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseMoney(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Money
+		wantErr bool
+	}{
+		{name: "Whole Amount", value: "1500000", want: 150000000},
+		{name: "Two Decimal Places", value: "1500000.05", want: 150000005},
+		{name: "One Decimal Place", value: "0.5", want: 50},
+		{name: "Negative Amount", value: "-12.30", want: -1230},
+		{name: "Too Many Decimal Places", value: "1.005", wantErr: true},
+		{name: "Exponent", value: "1e3", wantErr: true},
+		{name: "Not A Number", value: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseMoney() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_Money_MulDiv(t *testing.T) {
+	tests := []struct {
+		name        string
+		money       Money
+		numerator   float64
+		denominator float64
+		want        Money
+	}{
+		{name: "Prorate Without Drift", money: NewMoney(10000000), numerator: 7, denominator: 21, want: 333333333},
+		{name: "Round Half Up", money: 5, numerator: 1, denominator: 2, want: 3},
+		{name: "Round Half Away From Zero", money: -5, numerator: 1, denominator: 2, want: -3},
+		{name: "Decimal Rate Is Exact", money: NewMoney(12000000), numerator: 0.037, denominator: 1, want: NewMoney(444000)},
+		{name: "Zero Denominator", money: NewMoney(100), numerator: 1, denominator: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.money.MulDiv(tt.numerator, tt.denominator))
+		})
+	}
+}
+
+func Test_Money_JSON(t *testing.T) {
+	type payload struct {
+		Amount     Money     `json:"amount"`
+		UpperBound NullMoney `json:"upperBound"`
+	}
+
+	b, err := json.Marshal(payload{Amount: 150000005})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":1500000.05,"upperBound":null}`, string(b))
+
+	var got payload
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":1500000.05,"upperBound":60000000}`), &got))
+	assert.Equal(t, payload{Amount: 150000005, UpperBound: NullMoneyFrom(NewMoney(60000000))}, got)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":0.001}`), &got))
+}
+
+func Test_Money_Scan(t *testing.T) {
+	var got Money
+	assert.NoError(t, got.Scan([]byte("1500000.50")))
+	assert.Equal(t, Money(150000050), got)
+
+	value, err := got.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "1500000.50", value)
+
+	var upperBound NullMoney
+	assert.NoError(t, upperBound.Scan(nil))
+	assert.False(t, upperBound.Valid)
+}
+
+func Test_SQLUpdateParam(t *testing.T) {
+	type updateParam struct {
+		Amount    NullMoney   `db:"amount"`
+		Balance   NullMoney   `db:"balance"`
+		Status    null.Int64  `db:"status"`
+		UpdatedBy null.String `db:"updated_by"`
+	}
+
+	got := reflect.ValueOf(SQLUpdateParam(updateParam{
+		Amount:    NullMoneyFrom(0),
+		Status:    null.Int64From(1),
+		UpdatedBy: null.StringFrom("1"),
+	})).Elem()
+
+	assert.Equal(t, `db:"amount"`, string(got.Type().Field(0).Tag))
+	assert.Equal(t, null.StringFrom("0.00"), got.Field(0).Interface())
+	assert.Equal(t, null.String{}, got.Field(1).Interface())
+	assert.Equal(t, null.Int64From(1), got.Field(2).Interface())
+	assert.Equal(t, null.StringFrom("1"), got.Field(3).Interface())
+}
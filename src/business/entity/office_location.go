@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// earthRadiusMeters is the mean radius of the earth the distance between coordinates is measured on.
+const earthRadiusMeters = 6371000
+
+// OfficeLocation is an office employees can check in at, anywhere within RadiusMeters of its coordinates.
+type OfficeLocation struct {
+	ID           int64   `db:"id" json:"id"`
+	Name         string  `db:"name" json:"name"`
+	Latitude     float64 `db:"latitude" json:"latitude" example:"-6.2253"`
+	Longitude    float64 `db:"longitude" json:"longitude" example:"106.7993"`
+	RadiusMeters int64   `db:"radius_meters" json:"radiusMeters" example:"150"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type OfficeLocationInputParam struct {
+	Name         string     `db:"name" json:"name"`
+	Latitude     float64    `db:"latitude" json:"latitude"`
+	Longitude    float64    `db:"longitude" json:"longitude"`
+	RadiusMeters int64      `db:"radius_meters" json:"radiusMeters"`
+	CreatedAt    null.Time  `db:"created_at" json:"-"`
+	CreatedBy    null.Int64 `db:"created_by" json:"-"`
+}
+
+type OfficeLocationUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+	DeletedAt null.Time  `db:"deleted_at" json:"-"`
+	DeletedBy null.Int64 `db:"deleted_by" json:"-"`
+}
+
+type OfficeLocationParam struct {
+	ID          int64   `db:"id" param:"id" json:"id"`
+	IDs         []int64 `db:"id" param:"ids" json:"ids"`
+	QueryOption query.Option
+	BypassCache bool
+	PaginationParam
+}
+
+// DistanceMeters returns the great-circle distance from the office location to the coordinates.
+func (o *OfficeLocation) DistanceMeters(latitude, longitude float64) float64 {
+	lat1 := o.Latitude * math.Pi / 180
+	lat2 := latitude * math.Pi / 180
+	deltaLat := (latitude - o.Latitude) * math.Pi / 180
+	deltaLng := (longitude - o.Longitude) * math.Pi / 180
+
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLng/2)*math.Sin(deltaLng/2)
+	return earthRadiusMeters * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// MatchOfficeLocation returns the nearest of the office locations whose radius the coordinates are within.
+func MatchOfficeLocation(officeLocations []OfficeLocation, latitude, longitude float64) (OfficeLocation, bool) {
+	var (
+		matched     OfficeLocation
+		ok          bool
+		minDistance float64
+	)
+
+	for _, officeLocation := range officeLocations {
+		distance := officeLocation.DistanceMeters(latitude, longitude)
+		if distance > float64(officeLocation.RadiusMeters) {
+			continue
+		}
+
+		if !ok || distance < minDistance {
+			matched, ok, minDistance = officeLocation, true, distance
+		}
+	}
+
+	return matched, ok
+}
@@ -5,6 +5,18 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/query"
 )
 
+// OvertimeDayType constants classify the day an overtime was worked on, each day type has its own rate table.
+const (
+	// OvertimeDayTypeWorkday is a scheduled working day.
+	OvertimeDayTypeWorkday = "WORKDAY"
+
+	// OvertimeDayTypeRestDay is a weekly rest day, such as a weekend.
+	OvertimeDayTypeRestDay = "REST_DAY"
+
+	// OvertimeDayTypePublicHoliday is a public holiday.
+	OvertimeDayTypePublicHoliday = "PUBLIC_HOLIDAY"
+)
+
 type Overtime struct {
 	ID           int64      `db:"id" json:"id"`
 	UserID       int64      `db:"fk_user_id" json:"userID"`
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// PayComponentCode constants identify the payroll rule that evaluates a pay component.
+const (
+	// PayComponentCodeBasePay pays the prorated base salary for every attended workday.
+	PayComponentCodeBasePay = "BASE_PAY"
+
+	// PayComponentCodeOvertime pays every approved overtime claim in the period.
+	PayComponentCodeOvertime = "OVERTIME"
+
+	// PayComponentCodeAllowance pays every recurring allowance assigned to the employee for the period.
+	PayComponentCodeAllowance = "ALLOWANCE"
+
+	// PayComponentCodeEarningAdjustment pays every one-off earning adjustment of the employee in the period.
+	PayComponentCodeEarningAdjustment = "EARNING_ADJUSTMENT"
+
+	// PayComponentCodeReimbursement pays back every approved reimbursement in the period.
+	PayComponentCodeReimbursement = "REIMBURSEMENT"
+
+	// PayComponentCodeTax withholds the income tax of the period from the taxable earnings.
+	PayComponentCodeTax = "TAX"
+
+	// PayComponentCodePension withholds the employee share of the pension contribution and records the employer share.
+	PayComponentCodePension = "PENSION"
+
+	// PayComponentCodeHealthInsurance withholds the employee share of the health insurance contribution and records the employer share.
+	PayComponentCodeHealthInsurance = "HEALTH_INSURANCE"
+
+	// PayComponentCodeAttendancePenalty withholds the penalties of the late arrivals and early departures of the employee.
+	PayComponentCodeAttendancePenalty = "ATTENDANCE_PENALTY"
+
+	// PayComponentCodeDeductionAdjustment withholds every one-off deduction adjustment of the employee in the period.
+	PayComponentCodeDeductionAdjustment = "DEDUCTION_ADJUSTMENT"
+
+	// PayComponentCodeLoanRepayment withholds the due instalment of every active loan of the employee.
+	PayComponentCodeLoanRepayment = "LOAN_REPAYMENT"
+)
+
+type PayComponent struct {
+	ID              int64       `db:"id" json:"id"`
+	Code            string      `db:"code" json:"code"`
+	Name            string      `db:"name" json:"name"`
+	ItemType        string      `db:"item_type" json:"itemType"`
+	EvaluationOrder int64       `db:"evaluation_order" json:"evaluationOrder"`
+	Config          null.String `db:"config" json:"config" swaggertype:"string"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type PayComponentInputParam struct {
+	Code            string      `db:"code" json:"code"`
+	Name            string      `db:"name" json:"name"`
+	ItemType        string      `db:"item_type" json:"itemType"`
+	EvaluationOrder int64       `db:"evaluation_order" json:"evaluationOrder"`
+	Config          null.String `db:"config" json:"config"`
+	CreatedAt       null.Time   `db:"created_at" json:"-"`
+	CreatedBy       null.Int64  `db:"created_by" json:"-"`
+}
+
+type PayComponentUpdateParam struct {
+	Name            null.String `db:"name" json:"name"`
+	EvaluationOrder null.Int64  `db:"evaluation_order" json:"evaluationOrder"`
+	Config          null.String `db:"config" json:"config"`
+	Status          null.Int64  `db:"status" json:"status"`
+	UpdatedAt       null.Time   `db:"updated_at" json:"-"`
+	UpdatedBy       null.Int64  `db:"updated_by" json:"-"`
+}
+
+type PayComponentParam struct {
+	ID              int64  `db:"id" param:"id" json:"id"`
+	Code            string `db:"code" param:"code" json:"code"`
+	EvaluationOrder int64  `db:"evaluation_order" param:"evaluation_order" json:"evaluationOrder"`
+	QueryOption     query.Option
+	BypassCache     bool
+	PaginationParam
+}
@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// PayrollAdjustmentType constants represent the direction of a payroll adjustment.
+const (
+	// PayrollAdjustmentTypeEarning is paid to the employee on top of the payslip, such as a one-off bonus or a correction.
+	PayrollAdjustmentTypeEarning = "EARNING"
+
+	// PayrollAdjustmentTypeDeduction is withheld from the take home pay, such as a clawback of an overpayment.
+	PayrollAdjustmentTypeDeduction = "DEDUCTION"
+)
+
+// PayrollAdjustment is a one-off earning or deduction of an employee in an attendance period.
+// The amount is in the salary currency the employee had when the adjustment was made.
+type PayrollAdjustment struct {
+	ID                 int64  `db:"id" json:"id"`
+	AttendancePeriodID int64  `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64  `db:"fk_user_id" json:"userID"`
+	AdjustmentType     string `db:"adjustment_type" json:"adjustmentType"`
+	Amount             Money  `db:"amount" json:"amount" swaggertype:"number"`
+	Currency           string `db:"currency" json:"currency"`
+	Reason             string `db:"reason" json:"reason"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type PayrollAdjustmentInputParam struct {
+	AttendancePeriodID int64      `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64      `db:"fk_user_id" json:"userID"`
+	AdjustmentType     string     `db:"adjustment_type" json:"adjustmentType"`
+	Amount             Money      `db:"amount" json:"amount"`
+	Currency           string     `db:"currency" json:"currency"`
+	Reason             string     `db:"reason" json:"reason"`
+	CreatedAt          null.Time  `db:"created_at" json:"-"`
+	CreatedBy          null.Int64 `db:"created_by" json:"-"`
+}
+
+type PayrollAdjustmentUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+	DeletedAt null.Time  `db:"deleted_at" json:"-"`
+	DeletedBy null.Int64 `db:"deleted_by" json:"-"`
+}
+
+type PayrollAdjustmentParam struct {
+	ID                 int64 `db:"id" param:"id" json:"id"`
+	AttendancePeriodID int64 `db:"fk_attendance_period_id" param:"attendance_period_id" json:"attendancePeriodID"`
+	UserID             int64 `db:"fk_user_id" param:"user_id" json:"userID"`
+	QueryOption        query.Option
+	BypassCache        bool
+	PaginationParam
+}
+
+// ItemType returns the payslip item type the adjustment is paid as.
+func (p *PayrollAdjustment) ItemType() string {
+	if p.AdjustmentType == PayrollAdjustmentTypeDeduction {
+		return PayslipItemTypeDeductionAdjustment
+	}
+
+	return PayslipItemTypeEarningAdjustment
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// PayrollPreviewStatus constants represent the possible statuses of a stored payroll preview.
+const (
+	// PayrollPreviewStatusPending indicates that the preview is still being calculated in the background.
+	PayrollPreviewStatusPending = "PENDING"
+
+	// PayrollPreviewStatusCompleted indicates that the preview has been calculated and its result can be downloaded.
+	PayrollPreviewStatusCompleted = "COMPLETED"
+
+	// PayrollPreviewStatusFailed indicates that there was an error while calculating the preview.
+	PayrollPreviewStatusFailed = "FAILED"
+)
+
+// PayrollPreview is a stored snapshot of a payroll calculated without writing any payslip.
+// Result holds the calculated preview as JSON once the preview is completed.
+type PayrollPreview struct {
+	ID                 int64       `db:"id" json:"id"`
+	AttendancePeriodID int64       `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	PreviewStatus      string      `db:"preview_status" json:"previewStatus"`
+	TotalEmployee      int64       `db:"total_employee" json:"totalEmployee"`
+	Result             null.String `db:"result" json:"result" swaggertype:"string"`
+	PreviewError       null.String `db:"preview_error" json:"previewError" swaggertype:"string"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type PayrollPreviewInputParam struct {
+	AttendancePeriodID int64      `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	PreviewStatus      string     `db:"preview_status" json:"previewStatus"`
+	TotalEmployee      int64      `db:"total_employee" json:"totalEmployee"`
+	CreatedAt          null.Time  `db:"created_at" json:"-"`
+	CreatedBy          null.Int64 `db:"created_by" json:"-"`
+}
+
+type PayrollPreviewUpdateParam struct {
+	PreviewStatus string      `db:"preview_status" json:"previewStatus"`
+	Result        null.String `db:"result" json:"result"`
+	PreviewError  null.String `db:"preview_error" json:"previewError"`
+	Status        null.Int64  `db:"status" json:"status"`
+	UpdatedAt     null.Time   `db:"updated_at" json:"-"`
+	UpdatedBy     null.Int64  `db:"updated_by" json:"-"`
+}
+
+type PayrollPreviewParam struct {
+	ID                 int64  `db:"id" param:"id" json:"id"`
+	AttendancePeriodID int64  `db:"fk_attendance_period_id" param:"attendance_period_id" json:"attendancePeriodID"`
+	PreviewStatus      string `db:"preview_status" param:"preview_status" json:"previewStatus"`
+	QueryOption        query.Option
+	BypassCache        bool
+	PaginationParam
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// PayrollRunStatus constants represent the possible statuses of a payroll run.
+const (
+	// PayrollRunStatusProcessing indicates that the payslips of the run are being calculated.
+	PayrollRunStatusProcessing = "PROCESSING"
+
+	// PayrollRunStatusProcessed indicates that every payslip of the run has been created.
+	PayrollRunStatusProcessed = "PROCESSED"
+
+	// PayrollRunStatusProcessError indicates that there was an error while creating the payslips of the run.
+	PayrollRunStatusProcessError = "PROCESS_ERROR"
+
+	// PayrollRunStatusVoided indicates that the run has been superseded by a newer version, its payslips are kept as history.
+	PayrollRunStatusVoided = "VOIDED"
+)
+
+// PayrollRun is one version of the payroll of an attendance period. Every payslip belongs to a run,
+// re-running a payroll voids the current run and creates the next version next to it.
+type PayrollRun struct {
+	ID                 int64       `db:"id" json:"id"`
+	AttendancePeriodID int64       `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	Version            int64       `db:"version" json:"version"`
+	RunStatus          string      `db:"run_status" json:"runStatus"`
+	ProcessError       null.String `db:"process_error" json:"processError" swaggertype:"string"`
+	TotalEmployee      int64       `db:"total_employee" json:"totalEmployee"`
+	ProcessedEmployee  int64       `db:"processed_employee" json:"processedEmployee"`
+	FailedEmployee     int64       `db:"failed_employee" json:"failedEmployee"`
+	StartedAt          null.Time   `db:"started_at" json:"startedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	FinishedAt         null.Time   `db:"finished_at" json:"finishedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	VoidReason         null.String `db:"void_reason" json:"voidReason" swaggertype:"string"`
+	VoidedAt           null.Time   `db:"voided_at" json:"voidedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	VoidedBy           null.Int64  `db:"voided_by" json:"voidedBy" swaggertype:"integer"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type PayrollRunInputParam struct {
+	AttendancePeriodID int64      `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	Version            int64      `db:"version" json:"version"`
+	RunStatus          string     `db:"run_status" json:"runStatus"`
+	CreatedAt          null.Time  `db:"created_at" json:"-"`
+	CreatedBy          null.Int64 `db:"created_by" json:"-"`
+}
+
+type PayrollRunUpdateParam struct {
+	RunStatus         string      `db:"run_status" json:"runStatus"`
+	ProcessError      null.String `db:"process_error" json:"processError"`
+	TotalEmployee     null.Int64  `db:"total_employee" json:"totalEmployee"`
+	ProcessedEmployee null.Int64  `db:"processed_employee" json:"processedEmployee"`
+	FailedEmployee    null.Int64  `db:"failed_employee" json:"failedEmployee"`
+	StartedAt         null.Time   `db:"started_at" json:"startedAt"`
+	FinishedAt        null.Time   `db:"finished_at" json:"finishedAt"`
+	VoidReason        null.String `db:"void_reason" json:"voidReason"`
+	VoidedAt          null.Time   `db:"voided_at" json:"voidedAt"`
+	VoidedBy          null.Int64  `db:"voided_by" json:"voidedBy"`
+	Status            null.Int64  `db:"status" json:"status"`
+	UpdatedAt         null.Time   `db:"updated_at" json:"-"`
+	UpdatedBy         null.Int64  `db:"updated_by" json:"-"`
+}
+
+type PayrollRunParam struct {
+	ID                 int64  `db:"id" param:"id" json:"id"`
+	AttendancePeriodID int64  `db:"fk_attendance_period_id" param:"attendance_period_id" json:"attendancePeriodID"`
+	Version            int64  `db:"version" param:"version" json:"version"`
+	RunStatus          string `db:"run_status" param:"run_status" json:"runStatus"`
+	QueryOption        query.Option
+	BypassCache        bool
+	PaginationParam
+}
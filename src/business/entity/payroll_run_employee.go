@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// PayrollRunEmployeeStatus constants represent the outcome of generating the payslip of one employee in a payroll run.
+const (
+	// PayrollRunEmployeeStatusSuccess indicates that the payslip of the employee has been created.
+	PayrollRunEmployeeStatusSuccess = "SUCCESS"
+
+	// PayrollRunEmployeeStatusFailed indicates that the payslip of the employee could not be created, resuming the run retries it.
+	PayrollRunEmployeeStatusFailed = "FAILED"
+)
+
+// PayrollRunEmployee records whether the payslip of an employee was generated in a payroll run,
+// so a failed run can be resumed for the missing employees only.
+type PayrollRunEmployee struct {
+	ID             int64       `db:"id" json:"id"`
+	PayrollRunID   int64       `db:"fk_payroll_run_id" json:"payrollRunID"`
+	UserID         int64       `db:"fk_user_id" json:"userID"`
+	PayslipID      null.Int64  `db:"fk_payslip_id" json:"payslipID" swaggertype:"integer"`
+	EmployeeStatus string      `db:"employee_status" json:"employeeStatus"`
+	ProcessError   null.String `db:"process_error" json:"processError" swaggertype:"string"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type PayrollRunEmployeeInputParam struct {
+	PayrollRunID   int64       `db:"fk_payroll_run_id" json:"payrollRunID"`
+	UserID         int64       `db:"fk_user_id" json:"userID"`
+	PayslipID      null.Int64  `db:"fk_payslip_id" json:"payslipID"`
+	EmployeeStatus string      `db:"employee_status" json:"employeeStatus"`
+	ProcessError   null.String `db:"process_error" json:"processError"`
+	CreatedAt      null.Time   `db:"created_at" json:"-"`
+	CreatedBy      null.Int64  `db:"created_by" json:"-"`
+}
+
+type PayrollRunEmployeeUpdateParam struct {
+	PayslipID      null.Int64  `db:"fk_payslip_id" json:"payslipID"`
+	EmployeeStatus string      `db:"employee_status" json:"employeeStatus"`
+	ProcessError   null.String `db:"process_error" json:"processError"`
+	Status         null.Int64  `db:"status" json:"status"`
+	UpdatedAt      null.Time   `db:"updated_at" json:"-"`
+	UpdatedBy      null.Int64  `db:"updated_by" json:"-"`
+}
+
+type PayrollRunEmployeeParam struct {
+	ID             int64  `db:"id" param:"id" json:"id"`
+	PayrollRunID   int64  `db:"fk_payroll_run_id" param:"payroll_run_id" json:"payrollRunID"`
+	UserID         int64  `db:"fk_user_id" param:"user_id" json:"userID"`
+	EmployeeStatus string `db:"employee_status" param:"employee_status" json:"employeeStatus"`
+	QueryOption    query.Option
+	BypassCache    bool
+	PaginationParam
+}
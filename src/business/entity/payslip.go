@@ -6,13 +6,20 @@ import (
 )
 
 type Payslip struct {
-	ID                     int64   `db:"id" json:"id"`
-	UserID                 int64   `db:"fk_user_id" json:"userID"`
-	AttendancePeriodID     int64   `db:"fk_attendance_period_id" json:"attendancePeriodID"`
-	BasePayComponent       float64 `db:"base_pay_component" json:"basePayComponent"`
-	OvertimeComponent      float64 `db:"overtime_component" json:"overtimeComponent"`
-	ReimbursementComponent float64 `db:"reimbursement_component" json:"reimbursementComponent"`
-	TotalTakeHomePay       float64 `db:"total_take_home_pay" json:"totalTakeHomePay"`
+	ID                     int64 `db:"id" json:"id"`
+	UserID                 int64 `db:"fk_user_id" json:"userID"`
+	AttendancePeriodID     int64 `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	PayrollRunID           int64 `db:"fk_payroll_run_id" json:"payrollRunID"`
+	BasePayComponent       Money `db:"base_pay_component" json:"basePayComponent" swaggertype:"number"`
+	OvertimeComponent      Money `db:"overtime_component" json:"overtimeComponent" swaggertype:"number"`
+	AllowanceComponent     Money `db:"allowance_component" json:"allowanceComponent" swaggertype:"number"`
+	AdjustmentComponent    Money `db:"adjustment_component" json:"adjustmentComponent" swaggertype:"number"`
+	ReimbursementComponent Money `db:"reimbursement_component" json:"reimbursementComponent" swaggertype:"number"`
+	DeductionComponent     Money `db:"deduction_component" json:"deductionComponent" swaggertype:"number"`
+	TaxDeduction           Money `db:"tax_deduction" json:"taxDeduction" swaggertype:"number"`
+	EmployeeContribution   Money `db:"employee_contribution" json:"employeeContribution" swaggertype:"number"`
+	EmployerContribution   Money `db:"employer_contribution" json:"employerContribution" swaggertype:"number"`
+	TotalTakeHomePay       Money `db:"total_take_home_pay" json:"totalTakeHomePay" swaggertype:"number"`
 
 	// Utility Column
 	Status    int64       `db:"status" json:"status"`
@@ -27,33 +34,57 @@ type Payslip struct {
 }
 
 type PayslipInputParam struct {
-	UserID                 int64        `db:"fk_user_id" json:"userID"`
-	AttendancePeriodID     int64        `db:"fk_attendance_period_id" json:"attendancePeriodID"`
-	BasePayComponent       null.Float64 `db:"base_pay_component" json:"basePayComponent"`
-	OvertimeComponent      null.Float64 `db:"overtime_component" json:"overtimeComponent"`
-	ReimbursementComponent null.Float64 `db:"reimbursement_component" json:"reimbursementComponent"`
-	TotalTakeHomePay       null.Float64 `db:"total_take_home_pay" json:"totalTakeHomePay"`
-	CreatedAt              null.Time    `db:"created_at" json:"-"`
-	CreatedBy              null.Int64   `db:"created_by" json:"-"`
+	UserID                 int64      `db:"fk_user_id" json:"userID"`
+	AttendancePeriodID     int64      `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	PayrollRunID           int64      `db:"fk_payroll_run_id" json:"payrollRunID"`
+	BasePayComponent       Money      `db:"base_pay_component" json:"basePayComponent"`
+	OvertimeComponent      Money      `db:"overtime_component" json:"overtimeComponent"`
+	AllowanceComponent     Money      `db:"allowance_component" json:"allowanceComponent"`
+	AdjustmentComponent    Money      `db:"adjustment_component" json:"adjustmentComponent"`
+	ReimbursementComponent Money      `db:"reimbursement_component" json:"reimbursementComponent"`
+	DeductionComponent     Money      `db:"deduction_component" json:"deductionComponent"`
+	TaxDeduction           Money      `db:"tax_deduction" json:"taxDeduction"`
+	EmployeeContribution   Money      `db:"employee_contribution" json:"employeeContribution"`
+	EmployerContribution   Money      `db:"employer_contribution" json:"employerContribution"`
+	TotalTakeHomePay       Money      `db:"total_take_home_pay" json:"totalTakeHomePay"`
+	CreatedAt              null.Time  `db:"created_at" json:"-"`
+	CreatedBy              null.Int64 `db:"created_by" json:"-"`
 }
 
 type PayslipUpdateParam struct {
-	UserID                 null.Int64   `db:"fk_user_id" json:"userID"`
-	AttendancePeriodID     null.Int64   `db:"fk_attendance_period_id" json:"attendancePeriodID"`
-	BasePayComponent       null.Float64 `db:"base_pay_component" json:"basePayComponent"`
-	OvertimeComponent      null.Float64 `db:"overtime_component" json:"overtimeComponent"`
-	ReimbursementComponent null.Float64 `db:"reimbursement_component" json:"reimbursementComponent"`
-	TotalTakeHomePay       null.Float64 `db:"total_take_home_pay" json:"totalTakeHomePay"`
-	Status                 null.Int64   `db:"status" json:"status"`
-	UpdatedAt              null.Time    `db:"updated_at" json:"-"`
-	UpdatedBy              null.Int64   `db:"updated_by" json:"-"`
+	UserID                 null.Int64 `db:"fk_user_id" json:"userID"`
+	AttendancePeriodID     null.Int64 `db:"fk_attendance_period_id" json:"attendancePeriodID"`
+	BasePayComponent       NullMoney  `db:"base_pay_component" json:"basePayComponent"`
+	OvertimeComponent      NullMoney  `db:"overtime_component" json:"overtimeComponent"`
+	AllowanceComponent     NullMoney  `db:"allowance_component" json:"allowanceComponent"`
+	AdjustmentComponent    NullMoney  `db:"adjustment_component" json:"adjustmentComponent"`
+	ReimbursementComponent NullMoney  `db:"reimbursement_component" json:"reimbursementComponent"`
+	DeductionComponent     NullMoney  `db:"deduction_component" json:"deductionComponent"`
+	EmployerContribution   NullMoney  `db:"employer_contribution" json:"employerContribution"`
+	TotalTakeHomePay       NullMoney  `db:"total_take_home_pay" json:"totalTakeHomePay"`
+	Status                 null.Int64 `db:"status" json:"status"`
+	UpdatedAt              null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy              null.Int64 `db:"updated_by" json:"-"`
 }
 
 type PayslipParam struct {
 	ID                 int64 `db:"id" param:"id" json:"id"`
 	AttendancePeriodID int64 `db:"fk_attendance_period_id" param:"attendance_period_id"`
+	PayrollRunID       int64 `db:"fk_payroll_run_id" param:"payroll_run_id"`
 	UserID             int64 `db:"fk_user_id" param:"user_id" `
 	QueryOption        query.Option
 	BypassCache        bool
 	PaginationParam
 }
+
+// EmployerCost is everything the employer pays for the payslip: the take home pay,
+// the deductions it pays out on behalf of the employee, and its own contributions.
+func (p *Payslip) EmployerCost() Money {
+	return p.TotalTakeHomePay + p.DeductionComponent + p.EmployerContribution
+}
+
+// GrossEarnings is the taxable pay of the payslip, every earning before deductions. Reimbursements are
+// not earnings.
+func (p *Payslip) GrossEarnings() Money {
+	return p.BasePayComponent + p.OvertimeComponent + p.AllowanceComponent + p.AdjustmentComponent
+}
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// PayslipCurrencyTotal holds the components of a payslip paid in one currency, in that currency.
+// The components of the payslip itself are the sum of these totals converted to the reporting
+// currency at ExchangeRate.
+type PayslipCurrencyTotal struct {
+	ID                     int64   `db:"id" json:"id"`
+	PayslipID              int64   `db:"fk_payslip_id" json:"payslipID"`
+	PayrollRunID           int64   `db:"fk_payroll_run_id" json:"payrollRunID"`
+	Currency               string  `db:"currency" json:"currency"`
+	ExchangeRate           float64 `db:"exchange_rate" json:"exchangeRate"`
+	BasePayComponent       Money   `db:"base_pay_component" json:"basePayComponent" swaggertype:"number"`
+	OvertimeComponent      Money   `db:"overtime_component" json:"overtimeComponent" swaggertype:"number"`
+	AllowanceComponent     Money   `db:"allowance_component" json:"allowanceComponent" swaggertype:"number"`
+	AdjustmentComponent    Money   `db:"adjustment_component" json:"adjustmentComponent" swaggertype:"number"`
+	ReimbursementComponent Money   `db:"reimbursement_component" json:"reimbursementComponent" swaggertype:"number"`
+	DeductionComponent     Money   `db:"deduction_component" json:"deductionComponent" swaggertype:"number"`
+	EmployerContribution   Money   `db:"employer_contribution" json:"employerContribution" swaggertype:"number"`
+	TotalTakeHomePay       Money   `db:"total_take_home_pay" json:"totalTakeHomePay" swaggertype:"number"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type PayslipCurrencyTotalInputParam struct {
+	PayslipID              int64      `db:"fk_payslip_id" json:"payslipID"`
+	PayrollRunID           int64      `db:"fk_payroll_run_id" json:"payrollRunID"`
+	Currency               string     `db:"currency" json:"currency"`
+	ExchangeRate           float64    `db:"exchange_rate" json:"exchangeRate"`
+	BasePayComponent       Money      `db:"base_pay_component" json:"basePayComponent"`
+	OvertimeComponent      Money      `db:"overtime_component" json:"overtimeComponent"`
+	AllowanceComponent     Money      `db:"allowance_component" json:"allowanceComponent"`
+	AdjustmentComponent    Money      `db:"adjustment_component" json:"adjustmentComponent"`
+	ReimbursementComponent Money      `db:"reimbursement_component" json:"reimbursementComponent"`
+	DeductionComponent     Money      `db:"deduction_component" json:"deductionComponent"`
+	EmployerContribution   Money      `db:"employer_contribution" json:"employerContribution"`
+	TotalTakeHomePay       Money      `db:"total_take_home_pay" json:"totalTakeHomePay"`
+	CreatedAt              null.Time  `db:"created_at" json:"-"`
+	CreatedBy              null.Int64 `db:"created_by" json:"-"`
+}
+
+type PayslipCurrencyTotalUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+}
+
+type PayslipCurrencyTotalParam struct {
+	ID           int64  `db:"id" param:"id" json:"id"`
+	PayslipID    int64  `db:"fk_payslip_id" param:"payslip_id" json:"payslipID"`
+	PayrollRunID int64  `db:"fk_payroll_run_id" param:"payroll_run_id" json:"payrollRunID"`
+	Currency     string `db:"currency" param:"currency" json:"currency"`
+	QueryOption  query.Option
+	BypassCache  bool
+	PaginationParam
+}
+
+func (p *PayslipCurrencyTotalInputParam) EmployerCost() Money {
+	return p.TotalTakeHomePay + p.DeductionComponent + p.EmployerContribution
+}
+
+func (p *PayslipCurrencyTotal) EmployerCost() Money {
+	return p.TotalTakeHomePay + p.DeductionComponent + p.EmployerContribution
+}
@@ -1,23 +1,52 @@
 package entity
 
 import (
+	"strings"
+
 	"github.com/reyhanmichiels/go-pkg/v2/null"
 	"github.com/reyhanmichiels/go-pkg/v2/query"
 )
 
 // PayslipItemType enum constants
 const (
-	PayslipItemTypeEarningBasePay  = "EARNING_BASE_PAY"
-	PayslipItemTypeEarningOvertime = "EARNING_OVERTIME"
-	PayslipItemTypeReimbursement   = "REIMBURSEMENT"
+	PayslipItemTypeEarningBasePay    = "EARNING_BASE_PAY"
+	PayslipItemTypeEarningOvertime   = "EARNING_OVERTIME"
+	PayslipItemTypeEarningAllowance  = "EARNING_ALLOWANCE"
+	PayslipItemTypeEarningAdjustment = "EARNING_ADJUSTMENT"
+	PayslipItemTypeReimbursement     = "REIMBURSEMENT"
+	PayslipItemTypeDeductionTax      = "DEDUCTION_TAX"
+
+	PayslipItemTypeDeductionContribution      = "DEDUCTION_CONTRIBUTION"
+	PayslipItemTypeDeductionAdjustment        = "DEDUCTION_ADJUSTMENT"
+	PayslipItemTypeDeductionLoan              = "DEDUCTION_LOAN"
+	PayslipItemTypeDeductionAttendancePenalty = "DEDUCTION_ATTENDANCE_PENALTY"
+	PayslipItemTypeEmployerContribution       = "EMPLOYER_CONTRIBUTION"
 )
 
+// IsPayslipItemTypeEarning reports whether the item type is an earning, earnings are taxable income.
+func IsPayslipItemTypeEarning(itemType string) bool {
+	return strings.HasPrefix(itemType, "EARNING_")
+}
+
+// IsPayslipItemTypeDeduction reports whether the item type is a deduction, deductions are subtracted from the take home pay.
+func IsPayslipItemTypeDeduction(itemType string) bool {
+	return strings.HasPrefix(itemType, "DEDUCTION_")
+}
+
+// IsPayslipItemTypeEmployerCost reports whether the item type is paid by the employer on top of the payslip,
+// such lines are informational and do not change the take home pay.
+func IsPayslipItemTypeEmployerCost(itemType string) bool {
+	return strings.HasPrefix(itemType, "EMPLOYER_")
+}
+
 type PayslipDetail struct {
-	ID          int64   `db:"id" json:"id"`
-	PayslipID   int64   `db:"fk_payslip_id" json:"payslipID"`
-	ItemType    string  `db:"item_type" json:"itemType"`
-	Description string  `db:"description" json:"description"`
-	Amount      float64 `db:"amount" json:"amount"`
+	ID          int64        `db:"id" json:"id"`
+	PayslipID   int64        `db:"fk_payslip_id" json:"payslipID"`
+	ItemType    string       `db:"item_type" json:"itemType"`
+	Description string       `db:"description" json:"description"`
+	Amount      Money        `db:"amount" json:"amount" swaggertype:"number"`
+	Currency    string       `db:"currency" json:"currency"`
+	Rate        null.Float64 `db:"rate" json:"rate"`
 
 	// Utility Column
 	Status    int64       `db:"status" json:"status"`
@@ -35,7 +64,9 @@ type PayslipDetailInputParam struct {
 	PayslipID   int64        `db:"fk_payslip_id" json:"payslipID"`
 	ItemType    string       `db:"item_type" json:"itemType"`
 	Description string       `db:"description" json:"description"`
-	Amount      null.Float64 `db:"amount" json:"amount"`
+	Amount      Money        `db:"amount" json:"amount"`
+	Currency    string       `db:"currency" json:"currency"`
+	Rate        null.Float64 `db:"rate" json:"rate"`
 	CreatedAt   null.Time    `db:"created_at" json:"-"`
 	CreatedBy   null.Int64   `db:"created_by" json:"-"`
 }
@@ -44,7 +75,8 @@ type PayslipDetailUpdateParam struct {
 	PayslipID   null.Int64   `db:"fk_payslip_id" json:"payslipID"`
 	ItemType    null.String  `db:"item_type" json:"itemType"`
 	Description null.String  `db:"description" json:"description"`
-	Amount      null.Float64 `db:"amount" json:"amount"`
+	Amount      NullMoney    `db:"amount" json:"amount"`
+	Rate        null.Float64 `db:"rate" json:"rate"`
 	Status      null.Int64   `db:"status" json:"status"`
 	UpdatedAt   null.Time    `db:"updated_at" json:"-"`
 	UpdatedBy   null.Int64   `db:"updated_by" json:"-"`
@@ -8,11 +8,13 @@ const (
 // queue name
 const (
 	QueuePayrollCalculation = "employee-payroll-service.payroll-calculation"
+	QueuePayrollPreview     = "employee-payroll-service.payroll-preview"
 )
 
 // routing key
 const (
 	RoutingKeyPayrollCalculate = "payroll.calculate"
+	RoutingKeyPayrollPreview   = "payroll.preview"
 )
 
 type PubSubMessage struct {
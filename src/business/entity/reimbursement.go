@@ -9,7 +9,8 @@ type Reimbursement struct {
 	ID                int64      `db:"id" json:"id"`
 	UserID            int64      `db:"fk_user_id" json:"userID"`
 	Description       string     `db:"description" json:"description"`
-	Amount            float64    `db:"amount" json:"amount"`
+	Amount            Money      `db:"amount" json:"amount" swaggertype:"number"`
+	Currency          string     `db:"currency" json:"currency"`
 	ReimbursementDate null.Date  `db:"reimbursement_date" json:"reimbursementDate" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
 	ApprovedDate      null.Date  `db:"approved_date" json:"approvedDate"`
 	ApprovedBy        null.Int64 `db:"approved_by" json:"approvedBy"`
@@ -29,7 +30,8 @@ type Reimbursement struct {
 type ReimbursementInputParam struct {
 	UserID            int64      `db:"fk_user_id" json:"userID"`
 	Description       string     `db:"description" json:"description"`
-	Amount            float64    `db:"amount" json:"amount"`
+	Amount            Money      `db:"amount" json:"amount" swaggertype:"number"`
+	Currency          string     `db:"currency" json:"currency"`
 	ReimbursementDate null.Date  `db:"reimbursement_date" json:"reimbursementDate" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
 	ApprovedDate      null.Date  `db:"approved_date" json:"approvedDate"`
 	ApprovedBy        null.Int64 `db:"approved_by" json:"approvedBy"`
@@ -39,7 +41,7 @@ type ReimbursementInputParam struct {
 
 type ReimbursementUpdateParam struct {
 	Description  string     `db:"description" json:"description"`
-	Amount       float64    `db:"amount" json:"amount"`
+	Amount       NullMoney  `db:"amount" json:"amount" swaggertype:"number"`
 	ApprovedDate null.Date  `db:"approved_date" json:"approvedDate"`
 	ApprovedBy   null.Int64 `db:"approved_by" json:"approvedBy"`
 	Status       null.Int64 `db:"status" json:"status"`
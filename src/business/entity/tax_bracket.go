@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// TaxBracket taxes the part of the annual taxable income between LowerBound and UpperBound at Rate.
+// Brackets sharing the same EffectiveDate form one version of the tax table, a version applies
+// to every payroll period ending on or after its effective date until a newer version takes over.
+type TaxBracket struct {
+	ID            int64     `db:"id" json:"id"`
+	EffectiveDate null.Date `db:"effective_date" json:"effectiveDate" swaggertype:"string" example:"2022-01-01"`
+	LowerBound    Money     `db:"lower_bound" json:"lowerBound" swaggertype:"number"`
+	UpperBound    NullMoney `db:"upper_bound" json:"upperBound" swaggertype:"number"`
+	Rate          float64   `db:"rate" json:"rate"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type TaxBracketInputParam struct {
+	EffectiveDate null.Date    `db:"effective_date" json:"effectiveDate"`
+	LowerBound    Money        `db:"lower_bound" json:"lowerBound"`
+	UpperBound    NullMoney    `db:"upper_bound" json:"upperBound"`
+	Rate          null.Float64 `db:"rate" json:"rate"`
+	CreatedAt     null.Time    `db:"created_at" json:"-"`
+	CreatedBy     null.Int64   `db:"created_by" json:"-"`
+}
+
+type TaxBracketUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+}
+
+type TaxBracketParam struct {
+	ID               int64     `db:"id" param:"id" json:"id"`
+	EffectiveDate    null.Date `db:"effective_date" param:"effective_date" json:"effectiveDate"`
+	EffectiveDateLTE null.Date `db:"effective_date" param:"effective_date__lte" json:"effectiveDateLTE"`
+	LowerBound       NullMoney `db:"lower_bound" param:"lower_bound" json:"lowerBound"`
+	QueryOption      query.Option
+	BypassCache      bool
+	PaginationParam
+}
+
+// GetEffectiveTaxBrackets picks the latest version out of brackets sorted by effective date descending.
+func GetEffectiveTaxBrackets(brackets []TaxBracket) []TaxBracket {
+	var effective []TaxBracket
+	for _, bracket := range brackets {
+		if len(effective) > 0 && !bracket.EffectiveDate.Time.Equal(effective[0].EffectiveDate.Time) {
+			break
+		}
+
+		effective = append(effective, bracket)
+	}
+
+	return effective
+}
+
+// CalculateProgressiveTax taxes every slice of the income at the rate of the bracket it falls in.
+func CalculateProgressiveTax(brackets []TaxBracket, income Money) Money {
+	var tax Money
+	for _, bracket := range brackets {
+		if income <= bracket.LowerBound {
+			continue
+		}
+
+		upper := income
+		if bracket.UpperBound.Valid && bracket.UpperBound.Money < upper {
+			upper = bracket.UpperBound.Money
+		}
+
+		tax += (upper - bracket.LowerBound).MulRate(bracket.Rate)
+	}
+
+	return tax
+}
@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/reyhanmichiels/go-pkg/v2/auth"
 	"github.com/reyhanmichiels/go-pkg/v2/null"
 	"github.com/reyhanmichiels/go-pkg/v2/query"
@@ -11,23 +13,36 @@ const (
 	RoleIDUser  = 2
 )
 
+// UserTaxMaritalStatus constants are the marital statuses an employee can be taxed under.
+const (
+	UserTaxMaritalStatusSingle  = "SINGLE"
+	UserTaxMaritalStatusMarried = "MARRIED"
+)
+
 type User struct {
-	ID           int64       `db:"id" json:"id"`
-	RoleID       int64       `db:"fk_role_id" json:"roleID"`
-	Name         string      `db:"name" json:"name"`
-	Email        string      `db:"email" json:"email"`
-	Password     string      `db:"password" json:"password"`
-	BaseSalary   float64     `db:"base_salary" json:"baseSalary"`
-	RefreshToken null.String `db:"refresh_token" json:"refreshToken" swaggertype:"string"`
-	Status       int64       `db:"status" json:"status"`
-	Flag         int64       `db:"flag" json:"flag,omitempty"`
-	Meta         null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
-	CreatedAt    null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	CreatedBy    null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
-	UpdatedAt    null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	UpdatedBy    null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
-	DeletedAt    null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	DeletedBy    null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+	ID               int64       `db:"id" json:"id"`
+	RoleID           int64       `db:"fk_role_id" json:"roleID"`
+	Name             string      `db:"name" json:"name"`
+	Email            string      `db:"email" json:"email"`
+	Password         string      `db:"password" json:"password"`
+	BaseSalary       Money       `db:"base_salary" json:"baseSalary" swaggertype:"number"`
+	Currency         string      `db:"currency" json:"currency"`
+	TaxMaritalStatus string      `db:"tax_marital_status" json:"taxMaritalStatus"`
+	TaxDependants    int64       `db:"tax_dependants" json:"taxDependants"`
+	Region           string      `db:"region" json:"region"`
+	DeviceUserID     null.String `db:"device_user_id" json:"deviceUserID" swaggertype:"string"`
+	HireDate         null.Date   `db:"hire_date" json:"hireDate" swaggertype:"string" example:"2022-01-01"`
+	TerminationDate  null.Date   `db:"termination_date" json:"terminationDate" swaggertype:"string" example:"2022-12-31"`
+	RefreshToken     null.String `db:"refresh_token" json:"refreshToken" swaggertype:"string"`
+	Status           int64       `db:"status" json:"status"`
+	Flag             int64       `db:"flag" json:"flag,omitempty"`
+	Meta             null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt        null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy        null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt        null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy        null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt        null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy        null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
 }
 
 type UserInputParam struct {
@@ -41,17 +56,24 @@ type UserInputParam struct {
 }
 
 type UserUpdateParam struct {
-	Name         string      `db:"name" json:"name"`
-	RefreshToken string      `db:"refresh_token" json:"refreshToken"`
-	UpdatedAt    null.Time   `db:"updated_at" json:""`
-	UpdatedBy    null.String `db:"updated_by" json:""`
+	Name             string      `db:"name" json:"name"`
+	RefreshToken     string      `db:"refresh_token" json:"refreshToken"`
+	TaxMaritalStatus string      `db:"tax_marital_status" json:"taxMaritalStatus"`
+	TaxDependants    null.Int64  `db:"tax_dependants" json:"taxDependants"`
+	Region           null.String `db:"region" json:"region"`
+	DeviceUserID     null.String `db:"device_user_id" json:"deviceUserID"`
+	HireDate         null.Date   `db:"hire_date" json:"hireDate"`
+	TerminationDate  null.Date   `db:"termination_date" json:"terminationDate"`
+	UpdatedAt        null.Time   `db:"updated_at" json:""`
+	UpdatedBy        null.String `db:"updated_by" json:""`
 }
 
 type UserParam struct {
-	ID           int64  `db:"id" uri:"user_id" param:"id"`
-	Email        string `db:"email" param:"email"`
-	RefreshToken string `db:"refresh_token" param:"refresh_token"`
-	RoleID       int64  `db:"fk_role_id" param:"role_id"`
+	ID            int64    `db:"id" uri:"user_id" param:"id"`
+	Email         string   `db:"email" param:"email"`
+	RefreshToken  string   `db:"refresh_token" param:"refresh_token"`
+	RoleID        int64    `db:"fk_role_id" param:"role_id"`
+	DeviceUserIDs []string `db:"device_user_id" param:"device_user_ids"`
 	PaginationParam
 	QueryOption query.Option
 	BypassCache bool
@@ -81,3 +103,22 @@ func (u *User) ConvertToUserAuth() auth.User {
 		RoleID: u.RoleID,
 	}
 }
+
+// IsEmployedOn reports whether the date falls within the employment of the user, an empty hire or
+// termination date leaves that side of the employment open.
+func (u *User) IsEmployedOn(date time.Time) bool {
+	if u.HireDate.Valid && date.Before(u.HireDate.Time) {
+		return false
+	}
+
+	return !u.TerminationDate.Valid || !date.After(u.TerminationDate.Time)
+}
+
+// IsEmployedBetween reports whether the employment of the user overlaps any day between startDate and endDate.
+func (u *User) IsEmployedBetween(startDate, endDate null.Date) bool {
+	if u.HireDate.Valid && u.HireDate.Time.After(endDate.Time) {
+		return false
+	}
+
+	return !u.TerminationDate.Valid || !u.TerminationDate.Time.Before(startDate.Time)
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// UserAllowance assigns an allowance of the catalogue to an employee, paid every period from EffectiveDate
+// until EndDate, or indefinitely when EndDate is empty. The amount is in the salary currency of the employee
+// and is prorated by attendance when IsProrated is set.
+type UserAllowance struct {
+	ID              int64     `db:"id" json:"id"`
+	UserID          int64     `db:"fk_user_id" json:"userID"`
+	AllowanceTypeID int64     `db:"fk_allowance_type_id" json:"allowanceTypeID"`
+	Amount          Money     `db:"amount" json:"amount" swaggertype:"number"`
+	IsProrated      bool      `db:"is_prorated" json:"isProrated"`
+	EffectiveDate   null.Date `db:"effective_date" json:"effectiveDate" swaggertype:"string" example:"2022-01-01"`
+	EndDate         null.Date `db:"end_date" json:"endDate" swaggertype:"string" example:"2022-12-31"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type UserAllowanceInputParam struct {
+	UserID          int64      `db:"fk_user_id" json:"userID"`
+	AllowanceTypeID int64      `db:"fk_allowance_type_id" json:"allowanceTypeID"`
+	Amount          Money      `db:"amount" json:"amount"`
+	IsProrated      bool       `db:"is_prorated" json:"isProrated"`
+	EffectiveDate   null.Date  `db:"effective_date" json:"effectiveDate"`
+	EndDate         null.Date  `db:"end_date" json:"endDate"`
+	CreatedAt       null.Time  `db:"created_at" json:"-"`
+	CreatedBy       null.Int64 `db:"created_by" json:"-"`
+}
+
+type UserAllowanceUpdateParam struct {
+	EndDate   null.Date  `db:"end_date" json:"endDate"`
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+}
+
+type UserAllowanceParam struct {
+	ID               int64     `db:"id" param:"id" json:"id"`
+	UserID           int64     `db:"fk_user_id" param:"user_id" json:"userID"`
+	AllowanceTypeID  int64     `db:"fk_allowance_type_id" param:"allowance_type_id" json:"allowanceTypeID"`
+	EffectiveDateLTE null.Date `db:"effective_date" param:"effective_date__lte" json:"effectiveDateLTE"`
+	QueryOption      query.Option
+	BypassCache      bool
+	PaginationParam
+}
+
+// IsEffectiveBetween reports whether the allowance is paid for any day between startDate and endDate,
+// an empty endDate is open ended.
+func (u *UserAllowance) IsEffectiveBetween(startDate, endDate null.Date) bool {
+	if endDate.Valid && u.EffectiveDate.Time.After(endDate.Time) {
+		return false
+	}
+
+	return !u.EndDate.Valid || !u.EndDate.Time.Before(startDate.Time)
+}
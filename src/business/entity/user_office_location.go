@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// UserOfficeLocation allows an employee to check in at an office location, an employee without any can check in
+// at every office location.
+type UserOfficeLocation struct {
+	ID               int64 `db:"id" json:"id"`
+	UserID           int64 `db:"fk_user_id" json:"userID"`
+	OfficeLocationID int64 `db:"fk_office_location_id" json:"officeLocationID"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type UserOfficeLocationInputParam struct {
+	UserID           int64      `db:"fk_user_id" json:"userID"`
+	OfficeLocationID int64      `db:"fk_office_location_id" json:"officeLocationID"`
+	CreatedAt        null.Time  `db:"created_at" json:"-"`
+	CreatedBy        null.Int64 `db:"created_by" json:"-"`
+}
+
+type UserOfficeLocationUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+	DeletedAt null.Time  `db:"deleted_at" json:"-"`
+	DeletedBy null.Int64 `db:"deleted_by" json:"-"`
+}
+
+type UserOfficeLocationParam struct {
+	ID               int64 `db:"id" param:"id" json:"id"`
+	UserID           int64 `db:"fk_user_id" param:"user_id" json:"userID"`
+	OfficeLocationID int64 `db:"fk_office_location_id" param:"office_location_id" json:"officeLocationID"`
+	QueryOption      query.Option
+	BypassCache      bool
+	PaginationParam
+}
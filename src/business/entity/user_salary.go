@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// UserSalary is the base salary of an employee from EffectiveDate on, until the next salary of the
+// employee takes effect. The amount is in the salary currency of the employee.
+type UserSalary struct {
+	ID            int64     `db:"id" json:"id"`
+	UserID        int64     `db:"fk_user_id" json:"userID"`
+	BaseSalary    Money     `db:"base_salary" json:"baseSalary" swaggertype:"number"`
+	EffectiveDate null.Date `db:"effective_date" json:"effectiveDate" swaggertype:"string" example:"2022-01-01"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type UserSalaryInputParam struct {
+	UserID        int64      `db:"fk_user_id" json:"userID"`
+	BaseSalary    Money      `db:"base_salary" json:"baseSalary"`
+	EffectiveDate null.Date  `db:"effective_date" json:"effectiveDate"`
+	CreatedAt     null.Time  `db:"created_at" json:"-"`
+	CreatedBy     null.Int64 `db:"created_by" json:"-"`
+}
+
+type UserSalaryUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+}
+
+type UserSalaryParam struct {
+	ID               int64     `db:"id" param:"id" json:"id"`
+	UserID           int64     `db:"fk_user_id" param:"user_id" json:"userID"`
+	EffectiveDateLTE null.Date `db:"effective_date" param:"effective_date__lte" json:"effectiveDateLTE"`
+	QueryOption      query.Option
+	BypassCache      bool
+	PaginationParam
+}
+
+// SalarySegment is a stretch of an attendance period paid at a single base salary.
+// WorkingDays are the working days of the segment within the employment of the employee.
+type SalarySegment struct {
+	BaseSalary  Money
+	StartDate   null.Date
+	EndDate     null.Date
+	WorkingDays int64
+}
+
+// SalarySegments splits the period at every salary change of the user. The salary effective on the start of
+// the period opens the first segment, the base salary of the user is used when none is effective yet.
+func (a *AttendancePeriod) SalarySegments(user User, salaries []UserSalary, workCalendar WorkCalendar, holidays HolidayCalendar) []SalarySegment {
+	sorted := make([]UserSalary, len(salaries))
+	copy(sorted, salaries)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveDate.Time.Before(sorted[j].EffectiveDate.Time)
+	})
+
+	current := SalarySegment{BaseSalary: user.BaseSalary, StartDate: a.StartDate}
+	var changes []UserSalary
+	for _, salary := range sorted {
+		if salary.EffectiveDate.Time.After(a.StartDate.Time) {
+			if !salary.EffectiveDate.Time.After(a.EndDate.Time) {
+				changes = append(changes, salary)
+			}
+			continue
+		}
+
+		current.BaseSalary = salary.BaseSalary
+	}
+
+	segments := make([]SalarySegment, 0, len(changes)+1)
+	for _, change := range changes {
+		current.EndDate = null.DateFrom(change.EffectiveDate.Time.AddDate(0, 0, -1))
+		segments = append(segments, current)
+		current = SalarySegment{BaseSalary: change.BaseSalary, StartDate: change.EffectiveDate}
+	}
+	current.EndDate = a.EndDate
+	segments = append(segments, current)
+
+	for i := range segments {
+		segments[i].WorkingDays = countEmployedWorkingDays(segments[i].StartDate.Time, segments[i].EndDate.Time, user, workCalendar, holidays)
+	}
+
+	return segments
+}
+
+func countEmployedWorkingDays(startDate, endDate time.Time, user User, workCalendar WorkCalendar, holidays HolidayCalendar) int64 {
+	var workdayCount int64
+
+	for d := startDate; !d.After(endDate); d = d.AddDate(0, 0, 1) {
+		if user.IsEmployedOn(d) && workCalendar.IsWorkDay(d) && !holidays.IsHoliday(d) {
+			workdayCount++
+		}
+	}
+
+	return workdayCount
+}
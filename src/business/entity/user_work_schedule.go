@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// UserWorkSchedule assigns a work schedule to an employee from EffectiveDate on,
+// until the next assignment of the employee takes effect.
+type UserWorkSchedule struct {
+	ID             int64     `db:"id" json:"id"`
+	UserID         int64     `db:"fk_user_id" json:"userID"`
+	WorkScheduleID int64     `db:"fk_work_schedule_id" json:"workScheduleID"`
+	EffectiveDate  null.Date `db:"effective_date" json:"effectiveDate" swaggertype:"string" example:"2022-01-01"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type UserWorkScheduleInputParam struct {
+	UserID         int64      `db:"fk_user_id" json:"userID"`
+	WorkScheduleID int64      `db:"fk_work_schedule_id" json:"workScheduleID"`
+	EffectiveDate  null.Date  `db:"effective_date" json:"effectiveDate"`
+	CreatedAt      null.Time  `db:"created_at" json:"-"`
+	CreatedBy      null.Int64 `db:"created_by" json:"-"`
+}
+
+type UserWorkScheduleUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+}
+
+type UserWorkScheduleParam struct {
+	ID               int64     `db:"id" param:"id" json:"id"`
+	UserID           int64     `db:"fk_user_id" param:"user_id" json:"userID"`
+	EffectiveDate    null.Date `db:"effective_date" param:"effective_date" json:"effectiveDate"`
+	EffectiveDateLTE null.Date `db:"effective_date" param:"effective_date__lte" json:"effectiveDateLTE"`
+	QueryOption      query.Option
+	BypassCache      bool
+	PaginationParam
+}
+
+// WorkCalendar tells the days an employee is scheduled to work from the work schedules assigned to them,
+// the days before their first assignment follow the DefaultWorkSchedule.
+type WorkCalendar struct {
+	assignments []UserWorkSchedule
+	schedules   map[int64]WorkSchedule
+}
+
+// NewWorkCalendar returns the work calendar of the given assignments of an employee.
+func NewWorkCalendar(assignments []UserWorkSchedule, schedules map[int64]WorkSchedule) WorkCalendar {
+	sorted := make([]UserWorkSchedule, len(assignments))
+	copy(sorted, assignments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveDate.Time.Before(sorted[j].EffectiveDate.Time)
+	})
+
+	return WorkCalendar{
+		assignments: sorted,
+		schedules:   schedules,
+	}
+}
+
+// GetWorkSchedule returns the work schedule in effect on the date.
+func (w WorkCalendar) GetWorkSchedule(date time.Time) WorkSchedule {
+	schedule := DefaultWorkSchedule
+	for _, assignment := range w.assignments {
+		if assignment.EffectiveDate.Time.After(date) {
+			break
+		}
+
+		if assigned, ok := w.schedules[assignment.WorkScheduleID]; ok {
+			schedule = assigned
+		}
+	}
+
+	return schedule
+}
+
+// IsWorkDay reports whether the employee is scheduled to work on the date.
+func (w WorkCalendar) IsWorkDay(date time.Time) bool {
+	schedule := w.GetWorkSchedule(date)
+	return schedule.IsWorkDay(date.Weekday())
+}
@@ -0,0 +1,193 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	_ "time/tzdata" // the time zones of the work schedules are loaded even where the host has no zoneinfo
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// workDayCodes maps the day codes a work schedule lists its working days with to their weekday.
+var workDayCodes = map[string]time.Weekday{
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+	"SUN": time.Sunday,
+}
+
+// WorkSchedulePenaltyType constants represent how the late arrivals and early departures on a work schedule are penalised.
+const (
+	// WorkSchedulePenaltyTypeNone does not penalise late arrivals or early departures.
+	WorkSchedulePenaltyTypeNone = "NONE"
+
+	// WorkSchedulePenaltyTypePerMinute withholds PenaltyPerMinute for every minute late or early.
+	WorkSchedulePenaltyTypePerMinute = "PER_MINUTE"
+
+	// WorkSchedulePenaltyTypeHalfDay withholds half a day of base pay for every HalfDayAfterLates lates in the period.
+	WorkSchedulePenaltyTypeHalfDay = "HALF_DAY"
+)
+
+// DefaultTimeZone is the time zone of the offices, the shifts of a work schedule are in it unless the schedule names
+// another one.
+const DefaultTimeZone = "Asia/Jakarta"
+
+// shiftTimeLayout is the layout of the clock times a work schedule shift starts and ends at.
+const shiftTimeLayout = "15:04"
+
+// DefaultWorkSchedule is the Monday to Friday, nine to five week of the employees who have no work schedule assigned,
+// late arrivals and early departures are not penalised on it.
+var DefaultWorkSchedule = WorkSchedule{
+	Name:        "Monday to Friday",
+	WorkDays:    "MON,TUE,WED,THU,FRI",
+	ShiftStart:  "09:00",
+	ShiftEnd:    "17:00",
+	TimeZone:    DefaultTimeZone,
+	PenaltyType: WorkSchedulePenaltyTypeNone,
+}
+
+// WorkSchedule is a work schedule template, WorkDays lists the day codes of its working days, such as "TUE,WED,THU,FRI,SAT".
+// ShiftStart and ShiftEnd are the clock times of the daily shift, such as "09:00", a shift ending before it starts ends
+// on the next day. TimeZone is the IANA time zone the clock times are in, such as "Asia/Jakarta".
+// A check-in more than GraceMinutes after the shift start is a late arrival and a check-out more than GraceMinutes
+// before the shift end is an early departure, both are penalised by the PenaltyType of the schedule. PenaltyPerMinute
+// is in the reporting currency.
+type WorkSchedule struct {
+	ID                int64  `db:"id" json:"id"`
+	Name              string `db:"name" json:"name"`
+	WorkDays          string `db:"work_days" json:"workDays"`
+	ShiftStart        string `db:"shift_start" json:"shiftStart" example:"09:00"`
+	ShiftEnd          string `db:"shift_end" json:"shiftEnd" example:"17:00"`
+	TimeZone          string `db:"time_zone" json:"timeZone" example:"Asia/Jakarta"`
+	GraceMinutes      int64  `db:"grace_minutes" json:"graceMinutes" example:"10"`
+	PenaltyType       string `db:"penalty_type" json:"penaltyType" example:"PER_MINUTE"`
+	PenaltyPerMinute  Money  `db:"penalty_per_minute" json:"penaltyPerMinute" swaggertype:"number" example:"2000"`
+	HalfDayAfterLates int64  `db:"half_day_after_lates" json:"halfDayAfterLates" example:"3"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type WorkScheduleInputParam struct {
+	Name              string     `db:"name" json:"name"`
+	WorkDays          string     `db:"work_days" json:"workDays"`
+	ShiftStart        string     `db:"shift_start" json:"shiftStart"`
+	ShiftEnd          string     `db:"shift_end" json:"shiftEnd"`
+	TimeZone          string     `db:"time_zone" json:"timeZone"`
+	GraceMinutes      int64      `db:"grace_minutes" json:"graceMinutes"`
+	PenaltyType       string     `db:"penalty_type" json:"penaltyType"`
+	PenaltyPerMinute  Money      `db:"penalty_per_minute" json:"penaltyPerMinute"`
+	HalfDayAfterLates int64      `db:"half_day_after_lates" json:"halfDayAfterLates"`
+	CreatedAt         null.Time  `db:"created_at" json:"-"`
+	CreatedBy         null.Int64 `db:"created_by" json:"-"`
+}
+
+type WorkScheduleUpdateParam struct {
+	Status    null.Int64 `db:"status" json:"status"`
+	UpdatedAt null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy null.Int64 `db:"updated_by" json:"-"`
+}
+
+type WorkScheduleParam struct {
+	ID          int64   `db:"id" param:"id" json:"id"`
+	IDs         []int64 `db:"id" param:"ids" json:"ids"`
+	QueryOption query.Option
+	BypassCache bool
+	PaginationParam
+}
+
+// IsWorkDay reports whether the weekday is a working day of the schedule.
+func (w *WorkSchedule) IsWorkDay(weekday time.Weekday) bool {
+	for _, code := range strings.Split(w.WorkDays, ",") {
+		if day, ok := workDayCodes[code]; ok && day == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsWorkDayCode reports whether the code is one of the day codes a work schedule can list.
+func IsWorkDayCode(code string) bool {
+	_, ok := workDayCodes[code]
+	return ok
+}
+
+// ShiftDuration returns the length of the daily shift of the schedule.
+func (w *WorkSchedule) ShiftDuration() time.Duration {
+	start, err := time.Parse(shiftTimeLayout, w.ShiftStart)
+	if err != nil {
+		return 0
+	}
+
+	end, err := time.Parse(shiftTimeLayout, w.ShiftEnd)
+	if err != nil {
+		return 0
+	}
+
+	if !end.After(start) {
+		end = end.Add(24 * time.Hour)
+	}
+
+	return end.Sub(start)
+}
+
+// EndsNextDay reports whether the shift of the schedule ends on the day after it starts.
+func (w *WorkSchedule) EndsNextDay() bool {
+	start, err := time.Parse(shiftTimeLayout, w.ShiftStart)
+	if err != nil {
+		return false
+	}
+
+	return start.Add(w.ShiftDuration()).Day() != start.Day()
+}
+
+// Location returns the time zone of the shift of the schedule, UTC when the schedule names no known time zone.
+func (w *WorkSchedule) Location() *time.Location {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// IsTimeZone reports whether the value is an IANA time zone a work schedule can be in, such as "Asia/Jakarta".
+func IsTimeZone(value string) bool {
+	_, err := time.LoadLocation(value)
+	return err == nil && value != "" && value != "Local"
+}
+
+// ShiftOn returns when the shift of the schedule starts and ends on the date, in the given location.
+func (w *WorkSchedule) ShiftOn(date time.Time, loc *time.Location) (time.Time, time.Time) {
+	start, err := time.Parse(shiftTimeLayout, w.ShiftStart)
+	if err != nil {
+		return time.Time{}, time.Time{}
+	}
+
+	shiftStart := time.Date(date.Year(), date.Month(), date.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+	return shiftStart, shiftStart.Add(w.ShiftDuration())
+}
+
+// IsPenalised reports whether late arrivals and early departures on the schedule are penalised.
+func (w *WorkSchedule) IsPenalised() bool {
+	return w.PenaltyType == WorkSchedulePenaltyTypePerMinute || w.PenaltyType == WorkSchedulePenaltyTypeHalfDay
+}
+
+// IsShiftTime reports whether the value is a clock time a shift can start or end at, such as "09:00".
+func IsShiftTime(value string) bool {
+	_, err := time.Parse(shiftTimeLayout, value)
+	return err == nil && len(value) == len(shiftTimeLayout)
+}
@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+)
+
+// YearToDateTotal accumulates the payslips of an employee in a calendar year, in the reporting currency.
+// A payslip counts toward the year its attendance period ends in, and is taken off again when its
+// payroll run is voided.
+type YearToDateTotal struct {
+	ID                   int64 `db:"id" json:"id"`
+	UserID               int64 `db:"fk_user_id" json:"userID"`
+	Year                 int64 `db:"year" json:"year"`
+	GrossEarnings        Money `db:"gross_earnings" json:"grossEarnings" swaggertype:"number"`
+	TaxDeduction         Money `db:"tax_deduction" json:"taxDeduction" swaggertype:"number"`
+	EmployeeContribution Money `db:"employee_contribution" json:"employeeContribution" swaggertype:"number"`
+	EmployerContribution Money `db:"employer_contribution" json:"employerContribution" swaggertype:"number"`
+	TotalTakeHomePay     Money `db:"total_take_home_pay" json:"totalTakeHomePay" swaggertype:"number"`
+
+	// Utility Column
+	Status    int64       `db:"status" json:"status"`
+	Flag      int64       `db:"flag" json:"flag,omitempty"`
+	Meta      null.String `db:"meta" json:"meta,omitempty" swaggertype:"string"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.Int64  `db:"created_by" json:"createdBy" swaggertype:"integer"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.Int64  `db:"updated_by" json:"updatedBy" swaggertype:"integer"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.Int64  `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"integer"`
+}
+
+type YearToDateTotalInputParam struct {
+	UserID               int64      `db:"fk_user_id" json:"userID"`
+	Year                 int64      `db:"year" json:"year"`
+	GrossEarnings        Money      `db:"gross_earnings" json:"grossEarnings"`
+	TaxDeduction         Money      `db:"tax_deduction" json:"taxDeduction"`
+	EmployeeContribution Money      `db:"employee_contribution" json:"employeeContribution"`
+	EmployerContribution Money      `db:"employer_contribution" json:"employerContribution"`
+	TotalTakeHomePay     Money      `db:"total_take_home_pay" json:"totalTakeHomePay"`
+	CreatedAt            null.Time  `db:"created_at" json:"-"`
+	CreatedBy            null.Int64 `db:"created_by" json:"-"`
+}
+
+type YearToDateTotalUpdateParam struct {
+	GrossEarnings        NullMoney  `db:"gross_earnings" json:"grossEarnings"`
+	TaxDeduction         NullMoney  `db:"tax_deduction" json:"taxDeduction"`
+	EmployeeContribution NullMoney  `db:"employee_contribution" json:"employeeContribution"`
+	EmployerContribution NullMoney  `db:"employer_contribution" json:"employerContribution"`
+	TotalTakeHomePay     NullMoney  `db:"total_take_home_pay" json:"totalTakeHomePay"`
+	Status               null.Int64 `db:"status" json:"status"`
+	UpdatedAt            null.Time  `db:"updated_at" json:"-"`
+	UpdatedBy            null.Int64 `db:"updated_by" json:"-"`
+}
+
+type YearToDateTotalParam struct {
+	ID          int64 `db:"id" param:"id" json:"id"`
+	UserID      int64 `db:"fk_user_id" param:"user_id" json:"userID"`
+	Year        int64 `db:"year" param:"year" json:"year"`
+	QueryOption query.Option
+	BypassCache bool
+	PaginationParam
+}
+
+// Add counts the payslip toward the totals.
+func (y *YearToDateTotal) Add(payslip Payslip) {
+	y.GrossEarnings += payslip.GrossEarnings()
+	y.TaxDeduction += payslip.TaxDeduction
+	y.EmployeeContribution += payslip.EmployeeContribution
+	y.EmployerContribution += payslip.EmployerContribution
+	y.TotalTakeHomePay += payslip.TotalTakeHomePay
+}
+
+// Subtract takes the payslip off the totals.
+func (y *YearToDateTotal) Subtract(payslip Payslip) {
+	y.GrossEarnings -= payslip.GrossEarnings()
+	y.TaxDeduction -= payslip.TaxDeduction
+	y.EmployeeContribution -= payslip.EmployeeContribution
+	y.EmployerContribution -= payslip.EmployerContribution
+	y.TotalTakeHomePay -= payslip.TotalTakeHomePay
+}
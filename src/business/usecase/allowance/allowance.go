@@ -0,0 +1,229 @@
+package allowance
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	allowanceTypeDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/allowance_type"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	userAllowanceDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_allowance"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetAllowanceTypes(ctx context.Context) ([]entity.AllowanceType, error)
+	CreateAllowanceType(ctx context.Context, inputParam dto.CreateAllowanceTypeParam) (entity.AllowanceType, error)
+	GetUserAllowances(ctx context.Context, userID int64) ([]entity.UserAllowance, error)
+	CreateUserAllowance(ctx context.Context, userID int64, inputParam dto.CreateUserAllowanceParam) (entity.UserAllowance, error)
+	EndUserAllowance(ctx context.Context, userAllowanceID int64, inputParam dto.EndUserAllowanceParam) error
+}
+
+type allowance struct {
+	auth             auth.Interface
+	allowanceTypeDom allowanceTypeDom.Interface
+	userAllowanceDom userAllowanceDom.Interface
+	userDom          userDom.Interface
+}
+
+type InitParam struct {
+	Auth          auth.Interface
+	AllowanceType allowanceTypeDom.Interface
+	UserAllowance userAllowanceDom.Interface
+	User          userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &allowance{
+		auth:             param.Auth,
+		allowanceTypeDom: param.AllowanceType,
+		userAllowanceDom: param.UserAllowance,
+		userDom:          param.User,
+	}
+}
+
+func (a *allowance) GetAllowanceTypes(ctx context.Context) ([]entity.AllowanceType, error) {
+	allowanceTypes, _, err := a.allowanceTypeDom.GetList(
+		ctx,
+		entity.AllowanceTypeParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"code"},
+			},
+		},
+	)
+	if err != nil {
+		return allowanceTypes, err
+	}
+
+	return allowanceTypes, nil
+}
+
+func (a *allowance) CreateAllowanceType(ctx context.Context, inputParam dto.CreateAllowanceTypeParam) (entity.AllowanceType, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.AllowanceType{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.AllowanceType{}, err
+	}
+
+	allowanceType, err := a.allowanceTypeDom.Create(ctx, inputParam.ToAllowanceTypeInputParam(null.TimeFrom(Now()), loginUser.ID))
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLUniqueConstraint:
+			return entity.AllowanceType{}, errors.NewWithCode(codes.CodeConflict, "allowance type %s already exists", inputParam.Code)
+		default:
+			return entity.AllowanceType{}, err
+		}
+	}
+
+	return allowanceType, nil
+}
+
+func (a *allowance) GetUserAllowances(ctx context.Context, userID int64) ([]entity.UserAllowance, error) {
+	userAllowances, _, err := a.userAllowanceDom.GetList(
+		ctx,
+		entity.UserAllowanceParam{
+			UserID: userID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return userAllowances, err
+	}
+
+	return userAllowances, nil
+}
+
+// CreateUserAllowance assigns an allowance to an employee. An employee gets an allowance type at most once
+// on any day, so a new amount is assigned by ending the current assignment first.
+func (a *allowance) CreateUserAllowance(ctx context.Context, userID int64, inputParam dto.CreateUserAllowanceParam) (entity.UserAllowance, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.UserAllowance{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.UserAllowance{}, err
+	}
+
+	_, err = a.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID: userID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.UserAllowance{}, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return entity.UserAllowance{}, err
+		}
+	}
+
+	allowanceType, err := a.allowanceTypeDom.Get(
+		ctx,
+		entity.AllowanceTypeParam{
+			ID: inputParam.AllowanceTypeID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.UserAllowance{}, errors.NewWithCode(codes.CodeNotFound, "allowance type not found")
+		default:
+			return entity.UserAllowance{}, err
+		}
+	}
+
+	userAllowances, _, err := a.userAllowanceDom.GetList(
+		ctx,
+		entity.UserAllowanceParam{
+			UserID:          userID,
+			AllowanceTypeID: allowanceType.ID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			BypassCache: true,
+		},
+	)
+	if err != nil {
+		return entity.UserAllowance{}, err
+	}
+
+	for _, userAllowance := range userAllowances {
+		if userAllowance.IsEffectiveBetween(inputParam.EffectiveDate, inputParam.EndDate) {
+			return entity.UserAllowance{}, errors.NewWithCode(codes.CodeConflict, "user already has %s effective from %s", allowanceType.Name, userAllowance.EffectiveDate.Time.Format(time.DateOnly))
+		}
+	}
+
+	return a.userAllowanceDom.Create(ctx, inputParam.ToUserAllowanceInputParam(allowanceType, userID, null.TimeFrom(Now()), loginUser.ID))
+}
+
+// EndUserAllowance stops paying an allowance after the given date, the periods it was already paid in are untouched.
+func (a *allowance) EndUserAllowance(ctx context.Context, userAllowanceID int64, inputParam dto.EndUserAllowanceParam) error {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	userAllowance, err := a.userAllowanceDom.Get(
+		ctx,
+		entity.UserAllowanceParam{
+			ID: userAllowanceID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeNotFound, "user allowance not found")
+		default:
+			return err
+		}
+	}
+
+	if inputParam.EndDate.Time.Before(userAllowance.EffectiveDate.Time) {
+		return errors.NewWithCode(codes.CodeBadRequest, "endDate cannot be before effectiveDate")
+	}
+
+	return a.userAllowanceDom.Update(
+		ctx,
+		inputParam.ToUserAllowanceUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+		entity.UserAllowanceParam{
+			ID: userAllowanceID,
+		},
+	)
+}
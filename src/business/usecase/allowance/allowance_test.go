@@ -0,0 +1,407 @@
+package allowance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_allowance_type "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/allowance_type"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_allowance "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_allowance"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_allowance_CreateAllowanceType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAllowanceTypeDom := mock_allowance_type.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:          mockAuth,
+		AllowanceType: mockAllowanceTypeDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockInputParam := dto.CreateAllowanceTypeParam{
+		Code:       "TRANSPORT",
+		Name:       "Transport Allowance",
+		IsProrated: true,
+	}
+
+	mockAllowanceType := entity.AllowanceType{
+		ID:         1,
+		Code:       "TRANSPORT",
+		Name:       "Transport Allowance",
+		IsProrated: true,
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateAllowanceTypeParam
+		mockFunc func()
+		want     entity.AllowanceType
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockAllowanceTypeDom.EXPECT().Create(
+					context.Background(),
+					mockInputParam.ToAllowanceTypeInputParam(null.TimeFrom(mockTime), mockLoginUser.ID),
+				).Return(mockAllowanceType, nil)
+			},
+			want:    mockAllowanceType,
+			wantErr: false,
+		},
+		{
+			name:  "Code Already Exists",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockAllowanceTypeDom.EXPECT().Create(context.Background(), gomock.Any()).Return(entity.AllowanceType{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, "duplicate"))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name:  "Invalid Code",
+			input: dto.CreateAllowanceTypeParam{Code: "transport", Name: "Transport Allowance"},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateAllowanceType(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("allowance.CreateAllowanceType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_allowance_CreateUserAllowance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAllowanceTypeDom := mock_allowance_type.NewMockInterface(ctrl)
+	mockUserAllowanceDom := mock_user_allowance.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:          mockAuth,
+		AllowanceType: mockAllowanceTypeDom,
+		UserAllowance: mockUserAllowanceDom,
+		User:          mockUserDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockUserID := int64(10)
+	mockUserParam := entity.UserParam{
+		ID: mockUserID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockAllowanceType := entity.AllowanceType{
+		ID:         1,
+		Code:       "TRANSPORT",
+		Name:       "Transport Allowance",
+		IsProrated: true,
+	}
+	mockAllowanceTypeParam := entity.AllowanceTypeParam{
+		ID: mockAllowanceType.ID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockUserAllowanceParam := entity.UserAllowanceParam{
+		UserID:          mockUserID,
+		AllowanceTypeID: mockAllowanceType.ID,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		BypassCache: true,
+	}
+
+	mockInputParam := dto.CreateUserAllowanceParam{
+		AllowanceTypeID: mockAllowanceType.ID,
+		Amount:          entity.NewMoney(500000),
+		IsProrated:      null.BoolFrom(false),
+		EffectiveDate:   null.DateFrom(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	mockUserAllowance := entity.UserAllowance{
+		ID:              5,
+		UserID:          mockUserID,
+		AllowanceTypeID: mockAllowanceType.ID,
+		Amount:          entity.NewMoney(500000),
+		EffectiveDate:   mockInputParam.EffectiveDate,
+	}
+
+	mockEndedUserAllowance := entity.UserAllowance{
+		ID:              4,
+		UserID:          mockUserID,
+		AllowanceTypeID: mockAllowanceType.ID,
+		Amount:          entity.NewMoney(400000),
+		EffectiveDate:   null.DateFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:         null.DateFrom(time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)),
+	}
+
+	mockOpenUserAllowance := mockEndedUserAllowance
+	mockOpenUserAllowance.EndDate = null.Date{}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateUserAllowanceParam
+		mockFunc func()
+		want     entity.UserAllowance
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success After Previous Allowance Ended",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockAllowanceTypeDom.EXPECT().Get(ctx, mockAllowanceTypeParam).Return(mockAllowanceType, nil)
+				mockUserAllowanceDom.EXPECT().GetList(ctx, mockUserAllowanceParam).Return([]entity.UserAllowance{mockEndedUserAllowance}, nil, nil)
+				mockUserAllowanceDom.EXPECT().Create(ctx, entity.UserAllowanceInputParam{
+					UserID:          mockUserID,
+					AllowanceTypeID: mockAllowanceType.ID,
+					Amount:          entity.NewMoney(500000),
+					IsProrated:      false,
+					EffectiveDate:   mockInputParam.EffectiveDate,
+					CreatedAt:       null.TimeFrom(mockTime),
+					CreatedBy:       null.Int64From(mockLoginUser.ID),
+				}).Return(mockUserAllowance, nil)
+			},
+			want:    mockUserAllowance,
+			wantErr: false,
+		},
+		{
+			name:  "Overlapping Allowance",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockAllowanceTypeDom.EXPECT().Get(ctx, mockAllowanceTypeParam).Return(mockAllowanceType, nil)
+				mockUserAllowanceDom.EXPECT().GetList(ctx, mockUserAllowanceParam).Return([]entity.UserAllowance{mockOpenUserAllowance}, nil, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name:  "Allowance Type Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockAllowanceTypeDom.EXPECT().Get(ctx, mockAllowanceTypeParam).Return(entity.AllowanceType{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "User Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name: "End Date Before Effective Date",
+			input: dto.CreateUserAllowanceParam{
+				AllowanceTypeID: mockAllowanceType.ID,
+				Amount:          entity.NewMoney(500000),
+				EffectiveDate:   mockInputParam.EffectiveDate,
+				EndDate:         null.DateFrom(time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateUserAllowance(ctx, mockUserID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("allowance.CreateUserAllowance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_allowance_EndUserAllowance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockUserAllowanceDom := mock_user_allowance.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:          mockAuth,
+		UserAllowance: mockUserAllowanceDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockUserAllowanceID := int64(5)
+	mockUserAllowanceParam := entity.UserAllowanceParam{
+		ID: mockUserAllowanceID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockUserAllowance := entity.UserAllowance{
+		ID:            mockUserAllowanceID,
+		EffectiveDate: null.DateFrom(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	mockInputParam := dto.EndUserAllowanceParam{
+		EndDate: null.DateFrom(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.EndUserAllowanceParam
+		mockFunc func()
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserAllowanceDom.EXPECT().Get(ctx, mockUserAllowanceParam).Return(mockUserAllowance, nil)
+				mockUserAllowanceDom.EXPECT().Update(
+					ctx,
+					mockInputParam.ToUserAllowanceUpdateParam(null.TimeFrom(mockTime), mockLoginUser.ID),
+					entity.UserAllowanceParam{ID: mockUserAllowanceID},
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "End Date Before Effective Date",
+			input: dto.EndUserAllowanceParam{EndDate: null.DateFrom(time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC))},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserAllowanceDom.EXPECT().Get(ctx, mockUserAllowanceParam).Return(mockUserAllowance, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "User Allowance Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserAllowanceDom.EXPECT().Get(ctx, mockUserAllowanceParam).Return(entity.UserAllowance{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.EndUserAllowance(ctx, mockUserAllowanceID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("allowance.EndUserAllowance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
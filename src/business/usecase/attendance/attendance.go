@@ -10,46 +10,122 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/null"
 	"github.com/reyhanmichiels/go-pkg/v2/query"
 	attendance_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance"
+	attendanceCorrectionDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_correction"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_period"
+	attendanceTypeDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_type"
+	holidayDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/holiday"
+	officeLocationDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/office_location"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/transactor"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	userOfficeLocationDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_office_location"
+	userWorkScheduleDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_work_schedule"
+	workScheduleDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 )
 
 var Now = time.Now
 
 type Interface interface {
-	Create(ctx context.Context) error
+	CheckIn(ctx context.Context, inputParam dto.CheckInParam) (dto.AttendanceRecord, error)
+	CheckOut(ctx context.Context) (dto.AttendanceRecord, error)
+	CreateCorrection(ctx context.Context, inputParam dto.CreateAttendanceCorrectionParam) (entity.AttendanceCorrection, error)
+	GetMyCorrections(ctx context.Context) ([]entity.AttendanceCorrection, error)
+	GetCorrections(ctx context.Context, correctionStatus string) ([]entity.AttendanceCorrection, error)
+	ApproveCorrection(ctx context.Context, attendanceCorrectionID int64) (entity.AttendanceCorrection, error)
+	RejectCorrection(ctx context.Context, attendanceCorrectionID int64, inputParam dto.RejectAttendanceCorrectionParam) (entity.AttendanceCorrection, error)
+	ImportAttendances(ctx context.Context, inputParam dto.ImportAttendanceParam) (dto.AttendanceImportReport, error)
+	ImportAttendancesCommand(ctx context.Context, inputParam dto.ImportAttendanceParam) (dto.AttendanceImportReport, error)
+	UpdateUserDevice(ctx context.Context, userID int64, inputParam dto.UpdateUserDeviceParam) error
+	GetMyAttendances(ctx context.Context, inputParam dto.GetAttendancesParam) (dto.AttendanceHistory, *entity.Pagination, error)
+	GetUserAttendances(ctx context.Context, userID int64, inputParam dto.GetAttendancesParam) (dto.AttendanceHistory, *entity.Pagination, error)
+	GetAttendanceTypes(ctx context.Context) ([]entity.AttendanceType, error)
+	UpdateAttendanceType(ctx context.Context, attendanceTypeID int64, inputParam dto.UpdateAttendanceTypeParam) error
 }
 
 type attendance struct {
-	attendancePeriodDom attendance_period.Interface
-	attendanceDom       attendance_dom.Interface
-	auth                auth.Interface
+	attendancePeriodDom     attendance_period.Interface
+	attendanceDom           attendance_dom.Interface
+	holidayDom              holidayDom.Interface
+	userDom                 userDom.Interface
+	workScheduleDom         workScheduleDom.Interface
+	userWorkScheduleDom     userWorkScheduleDom.Interface
+	officeLocationDom       officeLocationDom.Interface
+	userOfficeLocationDom   userOfficeLocationDom.Interface
+	attendanceCorrectionDom attendanceCorrectionDom.Interface
+	attendanceTypeDom       attendanceTypeDom.Interface
+	transactor              transactor.Interface
+	auth                    auth.Interface
 }
 
 type InitParam struct {
-	AttendancePeriod attendance_period.Interface
-	Attendance       attendance_dom.Interface
-	Auth             auth.Interface
+	AttendancePeriod     attendance_period.Interface
+	Attendance           attendance_dom.Interface
+	Holiday              holidayDom.Interface
+	User                 userDom.Interface
+	WorkSchedule         workScheduleDom.Interface
+	UserWorkSchedule     userWorkScheduleDom.Interface
+	OfficeLocation       officeLocationDom.Interface
+	UserOfficeLocation   userOfficeLocationDom.Interface
+	AttendanceCorrection attendanceCorrectionDom.Interface
+	AttendanceType       attendanceTypeDom.Interface
+	Transactor           transactor.Interface
+	Auth                 auth.Interface
 }
 
 func Init(param InitParam) Interface {
 	return &attendance{
-		attendancePeriodDom: param.AttendancePeriod,
-		attendanceDom:       param.Attendance,
-		auth:                param.Auth,
+		attendancePeriodDom:     param.AttendancePeriod,
+		attendanceDom:           param.Attendance,
+		holidayDom:              param.Holiday,
+		userDom:                 param.User,
+		workScheduleDom:         param.WorkSchedule,
+		userWorkScheduleDom:     param.UserWorkSchedule,
+		officeLocationDom:       param.OfficeLocation,
+		userOfficeLocationDom:   param.UserOfficeLocation,
+		attendanceCorrectionDom: param.AttendanceCorrection,
+		attendanceTypeDom:       param.AttendanceType,
+		transactor:              param.Transactor,
+		auth:                    param.Auth,
 	}
 }
 
-func (a *attendance) Create(ctx context.Context) error {
+// CheckIn records the attendance of the user for today with the attendance type they work at, starting from the
+// current time. Once office locations are defined, the user has to check in to an OFFICE attendance within one of the
+// office locations they are allowed at.
+func (a *attendance) CheckIn(ctx context.Context, inputParam dto.CheckInParam) (dto.AttendanceRecord, error) {
 	loginUser, err := a.auth.GetUserAuthInfo(ctx)
 	if err != nil {
-		return err
+		return dto.AttendanceRecord{}, err
 	}
 
-	// check if current time is not weekday
+	if err := inputParam.Validate(); err != nil {
+		return dto.AttendanceRecord{}, err
+	}
+
+	if err := a.checkAttendanceType(ctx, inputParam.AttendanceType); err != nil {
+		return dto.AttendanceRecord{}, err
+	}
+
+	// check if current date is a working day of the work schedule of the user
 	currentTime := null.TimeFrom(Now())
-	if currentTime.Time.Weekday() == time.Saturday || currentTime.Time.Weekday() == time.Sunday {
-		return errors.NewWithCode(codes.CodeBadRequest, "attendance cannot be submitted on weekend")
+	workSchedule, err := a.getWorkSchedule(ctx, loginUser.ID, currentTime.Time)
+	if err != nil {
+		return dto.AttendanceRecord{}, err
+	}
+
+	if !workSchedule.IsWorkDay(currentTime.Time.Weekday()) {
+		return dto.AttendanceRecord{}, errors.NewWithCode(codes.CodeBadRequest, "attendance cannot be submitted on a day off of work schedule %s", workSchedule.Name)
+	}
+
+	// check if current date is not a public holiday of the user
+	holiday, isHoliday, err := a.getObservedHoliday(ctx, loginUser.ID, currentTime.Time)
+	if err != nil {
+		return dto.AttendanceRecord{}, err
+	}
+
+	if isHoliday {
+		return dto.AttendanceRecord{}, errors.NewWithCode(codes.CodeBadRequest, "attendance cannot be submitted on public holiday %s", holiday.Name)
 	}
 
 	// find the current attendance period
@@ -65,19 +141,33 @@ func (a *attendance) Create(ctx context.Context) error {
 	if err != nil {
 		switch errors.GetCode(err) {
 		case codes.CodeSQLRecordDoesNotExist:
-			return errors.NewWithCode(codes.CodeNotFound, "no open attendance period found")
+			return dto.AttendanceRecord{}, errors.NewWithCode(codes.CodeNotFound, "no open attendance period found")
 		default:
-			return err
+			return dto.AttendanceRecord{}, err
 		}
 	}
 
-	// submit attendance
-	_, err = a.attendanceDom.Create(
+	// check if the user is within an office location they are allowed at, only an office attendance is geofenced
+	var officeLocationID null.Int64
+	if inputParam.AttendanceType == entity.AttendanceTypeCodeOffice {
+		officeLocationID, err = a.matchOfficeLocation(ctx, loginUser.ID, inputParam)
+		if err != nil {
+			return dto.AttendanceRecord{}, err
+		}
+	}
+
+	// check in
+	attendance, err := a.attendanceDom.Create(
 		ctx,
 		entity.AttendanceInputParam{
 			AttendancePeriodID: attendancePeriod.ID,
 			UserID:             loginUser.ID,
 			AttendanceDate:     null.DateFrom(currentTime.Time),
+			AttendanceType:     inputParam.AttendanceType,
+			CheckInAt:          currentTime,
+			OfficeLocationID:   officeLocationID,
+			CheckInLatitude:    inputParam.Latitude,
+			CheckInLongitude:   inputParam.Longitude,
 			CreatedAt:          currentTime,
 			CreatedBy:          null.Int64From(loginUser.ID),
 		},
@@ -85,11 +175,306 @@ func (a *attendance) Create(ctx context.Context) error {
 	if err != nil {
 		switch errors.GetCode(err) {
 		case codes.CodeSQLUniqueConstraint:
-			return errors.NewWithCode(codes.CodeConflict, "attendance already submitted for today")
+			return dto.AttendanceRecord{}, errors.NewWithCode(codes.CodeConflict, "already checked in for today")
 		default:
-			return err
+			return dto.AttendanceRecord{}, err
 		}
 	}
 
-	return nil
+	return a.toAttendanceRecord(attendance, currentTime.Time), nil
+}
+
+// CheckOut completes the attendance of the user for today with the current time and the minutes worked since the
+// check-in. An employee on an overnight shift checks out on the day after they checked in, so without an attendance
+// today the open attendance of yesterday is completed when the shift of that day ends on the next day.
+func (a *attendance) CheckOut(ctx context.Context) (dto.AttendanceRecord, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return dto.AttendanceRecord{}, err
+	}
+
+	currentTime := null.TimeFrom(Now())
+	attendance, err := a.getCheckOutAttendance(ctx, loginUser.ID, currentTime.Time)
+	if err != nil {
+		return dto.AttendanceRecord{}, err
+	}
+
+	if attendance.CheckOutAt.Valid {
+		return dto.AttendanceRecord{}, errors.NewWithCode(codes.CodeConflict, "already checked out for today")
+	}
+
+	attendance.CheckOutAt = currentTime
+	attendance.WorkedMinutes = attendance.WorkedMinutesUntil(currentTime.Time)
+
+	err = a.attendanceDom.Update(
+		ctx,
+		entity.AttendanceUpdateParam{
+			CheckOutAt:    attendance.CheckOutAt,
+			WorkedMinutes: null.Int64From(attendance.WorkedMinutes),
+			UpdatedAt:     currentTime,
+			UpdatedBy:     null.Int64From(loginUser.ID),
+		},
+		entity.AttendanceParam{
+			ID: attendance.ID,
+		},
+	)
+	if err != nil {
+		return dto.AttendanceRecord{}, err
+	}
+
+	return a.toAttendanceRecord(attendance, currentTime.Time), nil
+}
+
+// getCheckOutAttendance returns the attendance of the user to check out, today's or the open attendance of an
+// overnight shift that started yesterday.
+func (a *attendance) getCheckOutAttendance(ctx context.Context, userID int64, currentTime time.Time) (entity.Attendance, error) {
+	attendances, _, err := a.attendanceDom.GetList(
+		ctx,
+		entity.AttendanceParam{
+			UserID:            userID,
+			AttendanceDateGTE: null.DateFrom(currentTime.AddDate(0, 0, -1)),
+			AttendanceDateLTE: null.DateFrom(currentTime),
+			BypassCache:       true,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-attendance_date"},
+			},
+		},
+	)
+	if err != nil {
+		return entity.Attendance{}, err
+	}
+
+	for _, attendance := range attendances {
+		if attendance.AttendanceDate.Time.Format(time.DateOnly) == currentTime.Format(time.DateOnly) {
+			return attendance, nil
+		}
+	}
+
+	for _, attendance := range attendances {
+		if attendance.CheckOutAt.Valid {
+			continue
+		}
+
+		workSchedule, err := a.getWorkSchedule(ctx, userID, attendance.AttendanceDate.Time)
+		if err != nil {
+			return entity.Attendance{}, err
+		}
+
+		if workSchedule.EndsNextDay() {
+			return attendance, nil
+		}
+	}
+
+	return entity.Attendance{}, errors.NewWithCode(codes.CodeNotFound, "no check-in found for today")
+}
+
+func (a *attendance) toAttendanceRecord(attendance entity.Attendance, currentTime time.Time) dto.AttendanceRecord {
+	return dto.AttendanceRecord{
+		ID:                 attendance.ID,
+		AttendancePeriodID: attendance.AttendancePeriodID,
+		AttendanceDate:     attendance.AttendanceDate,
+		AttendanceType:     attendance.AttendanceType,
+		CheckInAt:          attendance.CheckInAt,
+		CheckOutAt:         attendance.CheckOutAt,
+		WorkedHours:        attendance.WorkedHours(),
+		MissingCheckOut:    attendance.IsCheckOutMissing(currentTime),
+		OfficeLocationID:   attendance.OfficeLocationID,
+	}
+}
+
+// matchOfficeLocation returns the office location the check-in coordinates are within. The check-in is not
+// geofenced until office locations are defined.
+func (a *attendance) matchOfficeLocation(ctx context.Context, userID int64, inputParam dto.CheckInParam) (null.Int64, error) {
+	officeLocations, err := a.getAllowedOfficeLocations(ctx, userID)
+	if err != nil {
+		return null.Int64{}, err
+	}
+
+	if len(officeLocations) == 0 {
+		return null.Int64{}, nil
+	}
+
+	if !inputParam.HasCoordinates() {
+		return null.Int64{}, errors.NewWithCode(codes.CodeBadRequest, "latitude and longitude are required to check in")
+	}
+
+	officeLocation, ok := entity.MatchOfficeLocation(officeLocations, inputParam.Latitude.Float64, inputParam.Longitude.Float64)
+	if !ok {
+		return null.Int64{}, errors.NewWithCode(codes.CodeBadRequest, "check-in location is outside of the office locations you are allowed at")
+	}
+
+	return null.Int64From(officeLocation.ID), nil
+}
+
+// getAllowedOfficeLocations returns the office locations assigned to the user, or every office location when
+// they have none assigned.
+func (a *attendance) getAllowedOfficeLocations(ctx context.Context, userID int64) ([]entity.OfficeLocation, error) {
+	userOfficeLocations, _, err := a.userOfficeLocationDom.GetList(
+		ctx,
+		entity.UserOfficeLocationParam{
+			UserID: userID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	param := entity.OfficeLocationParam{
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	for _, userOfficeLocation := range userOfficeLocations {
+		param.IDs = append(param.IDs, userOfficeLocation.OfficeLocationID)
+	}
+
+	officeLocations, _, err := a.officeLocationDom.GetList(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	return officeLocations, nil
+}
+
+// getWorkSchedule returns the work schedule the user is assigned on the given date,
+// or the DefaultWorkSchedule when they have none. A deactivated schedule is skipped for the one assigned before it,
+// the same way payroll counts the working days of the user.
+func (a *attendance) getWorkSchedule(ctx context.Context, userID int64, date time.Time) (entity.WorkSchedule, error) {
+	userWorkSchedule, err := a.userWorkScheduleDom.Get(
+		ctx,
+		entity.UserWorkScheduleParam{
+			UserID:           userID,
+			EffectiveDateLTE: null.DateFrom(date),
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.DefaultWorkSchedule, nil
+		default:
+			return entity.WorkSchedule{}, err
+		}
+	}
+
+	workSchedule, err := a.workScheduleDom.Get(
+		ctx,
+		entity.WorkScheduleParam{
+			ID: userWorkSchedule.WorkScheduleID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return a.getWorkCalendarSchedule(ctx, userID, date)
+		default:
+			return entity.WorkSchedule{}, err
+		}
+	}
+
+	return workSchedule, nil
+}
+
+// getWorkCalendarSchedule returns the work schedule in effect on the given date from every work schedule assignment
+// of the user, only the active work schedules are in effect.
+func (a *attendance) getWorkCalendarSchedule(ctx context.Context, userID int64, date time.Time) (entity.WorkSchedule, error) {
+	userWorkSchedules, _, err := a.userWorkScheduleDom.GetList(
+		ctx,
+		entity.UserWorkScheduleParam{
+			UserID:           userID,
+			EffectiveDateLTE: null.DateFrom(date),
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return entity.WorkSchedule{}, err
+	}
+
+	workScheduleIDs := make([]int64, 0, len(userWorkSchedules))
+	for _, userWorkSchedule := range userWorkSchedules {
+		workScheduleIDs = append(workScheduleIDs, userWorkSchedule.WorkScheduleID)
+	}
+
+	workScheduleList, _, err := a.workScheduleDom.GetList(
+		ctx,
+		entity.WorkScheduleParam{
+			IDs: workScheduleIDs,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return entity.WorkSchedule{}, err
+	}
+
+	workSchedules := make(map[int64]entity.WorkSchedule, len(workScheduleList))
+	for _, workSchedule := range workScheduleList {
+		workSchedules[workSchedule.ID] = workSchedule
+	}
+
+	return entity.NewWorkCalendar(userWorkSchedules, workSchedules).GetWorkSchedule(date), nil
+}
+
+// getObservedHoliday returns the public holiday the user observes on the given date, if any.
+func (a *attendance) getObservedHoliday(ctx context.Context, userID int64, date time.Time) (entity.Holiday, bool, error) {
+	holidays, _, err := a.holidayDom.GetList(
+		ctx,
+		entity.HolidayParam{
+			HolidayDate: null.DateFrom(date),
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return entity.Holiday{}, false, err
+	}
+
+	if len(holidays) == 0 {
+		return entity.Holiday{}, false, nil
+	}
+
+	// the region of the user only matters when there is a holiday to observe
+	user, err := a.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID: userID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		return entity.Holiday{}, false, err
+	}
+
+	holiday, ok := entity.NewHolidayCalendar(holidays, user.Region)[date.Format(time.DateOnly)]
+	return holiday, ok, nil
 }
@@ -0,0 +1,330 @@
+package attendance
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// CreateCorrection files a request of the user to record the attendance of a past workday they did not check in on.
+// The workday has to belong to an attendance period whose payroll is not processed yet.
+func (a *attendance) CreateCorrection(ctx context.Context, inputParam dto.CreateAttendanceCorrectionParam) (entity.AttendanceCorrection, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	if err := a.checkAttendanceType(ctx, inputParam.AttendanceType); err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	currentTime := null.TimeFrom(Now())
+	attendanceDate := inputParam.CheckInAt.Time
+	if attendanceDate.Format(time.DateOnly) >= currentTime.Time.Format(time.DateOnly) {
+		return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeBadRequest, "attendance can only be corrected for a past workday")
+	}
+
+	// check if the date is a working day of the work schedule of the user
+	workSchedule, err := a.getWorkSchedule(ctx, loginUser.ID, attendanceDate)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	if !workSchedule.IsWorkDay(attendanceDate.Weekday()) {
+		return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeBadRequest, "attendance cannot be corrected on a day off of work schedule %s", workSchedule.Name)
+	}
+
+	// check if the date is not a public holiday of the user
+	holiday, isHoliday, err := a.getObservedHoliday(ctx, loginUser.ID, attendanceDate)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	if isHoliday {
+		return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeBadRequest, "attendance cannot be corrected on public holiday %s", holiday.Name)
+	}
+
+	// find the attendance period of the date
+	attendancePeriod, err := a.attendancePeriodDom.Get(
+		ctx,
+		entity.AttendancePeriodParam{
+			StartDateLTE: null.DateFrom(attendanceDate),
+			EndDateGTE:   null.DateFrom(attendanceDate),
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeNotFound, "no attendance period found for %s", attendanceDate.Format(time.DateOnly))
+		default:
+			return entity.AttendanceCorrection{}, err
+		}
+	}
+
+	if !attendancePeriod.IsCorrectable() {
+		return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeBadRequest, "attendance period with status %s can no longer be corrected", attendancePeriod.PeriodStatus)
+	}
+
+	// check if the user did not check in on the date already
+	_, err = a.attendanceDom.Get(
+		ctx,
+		entity.AttendanceParam{
+			UserID:         loginUser.ID,
+			AttendanceDate: null.DateFrom(attendanceDate),
+			BypassCache:    true,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err == nil {
+		return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeConflict, "attendance is already recorded for %s", attendanceDate.Format(time.DateOnly))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	attendanceCorrection, err := a.attendanceCorrectionDom.Create(
+		ctx,
+		inputParam.ToAttendanceCorrectionInputParam(currentTime, loginUser.ID, attendancePeriod.ID),
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLUniqueConstraint:
+			return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeConflict, "a correction for %s is already pending", attendanceDate.Format(time.DateOnly))
+		default:
+			return entity.AttendanceCorrection{}, err
+		}
+	}
+
+	return attendanceCorrection, nil
+}
+
+// GetMyCorrections returns the attendance corrections the user filed, the latest first.
+func (a *attendance) GetMyCorrections(ctx context.Context) ([]entity.AttendanceCorrection, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attendanceCorrections, _, err := a.attendanceCorrectionDom.GetList(
+		ctx,
+		entity.AttendanceCorrectionParam{
+			UserID: loginUser.ID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-attendance_date"},
+			},
+		},
+	)
+	if err != nil {
+		return attendanceCorrections, err
+	}
+
+	return attendanceCorrections, nil
+}
+
+// GetCorrections returns the attendance corrections of every employee for the admin to review, optionally only the
+// ones with the given correction status.
+func (a *attendance) GetCorrections(ctx context.Context, correctionStatus string) ([]entity.AttendanceCorrection, error) {
+	switch correctionStatus {
+	case "", entity.AttendanceCorrectionStatusPending, entity.AttendanceCorrectionStatusApproved, entity.AttendanceCorrectionStatusRejected:
+	default:
+		return nil, errors.NewWithCode(codes.CodeBadRequest, "status must be one of %s, %s or %s", entity.AttendanceCorrectionStatusPending, entity.AttendanceCorrectionStatusApproved, entity.AttendanceCorrectionStatusRejected)
+	}
+
+	attendanceCorrections, _, err := a.attendanceCorrectionDom.GetList(
+		ctx,
+		entity.AttendanceCorrectionParam{
+			CorrectionStatus: correctionStatus,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"attendance_date"},
+			},
+		},
+	)
+	if err != nil {
+		return attendanceCorrections, err
+	}
+
+	return attendanceCorrections, nil
+}
+
+// ApproveCorrection records the attendance of the correction on behalf of the employee, created by the approving
+// admin. The attendance period has to still be correctable, its payroll would otherwise miss the attendance.
+func (a *attendance) ApproveCorrection(ctx context.Context, attendanceCorrectionID int64) (entity.AttendanceCorrection, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	attendanceCorrection, err := a.getPendingCorrection(ctx, attendanceCorrectionID)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	attendancePeriod, err := a.attendancePeriodDom.Get(
+		ctx,
+		entity.AttendancePeriodParam{
+			ID:          attendanceCorrection.AttendancePeriodID,
+			BypassCache: true,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	if !attendancePeriod.IsCorrectable() {
+		return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeBadRequest, "attendance period with status %s can no longer be corrected", attendancePeriod.PeriodStatus)
+	}
+
+	currentTime := null.TimeFrom(Now())
+	attendance := entity.Attendance{CheckInAt: attendanceCorrection.CheckInAt}
+	var workedMinutes int64
+	if attendanceCorrection.CheckOutAt.Valid {
+		workedMinutes = attendance.WorkedMinutesUntil(attendanceCorrection.CheckOutAt.Time)
+	}
+
+	err = a.transactor.Execute(ctx, "txApproveAttendanceCorrection", sql.TxOptions{}, func(ctx context.Context) error {
+		attendance, err := a.attendanceDom.Create(
+			ctx,
+			entity.AttendanceInputParam{
+				AttendancePeriodID: attendanceCorrection.AttendancePeriodID,
+				UserID:             attendanceCorrection.UserID,
+				AttendanceDate:     attendanceCorrection.AttendanceDate,
+				AttendanceType:     attendanceCorrection.AttendanceType,
+				CheckInAt:          attendanceCorrection.CheckInAt,
+				CheckOutAt:         attendanceCorrection.CheckOutAt,
+				WorkedMinutes:      workedMinutes,
+				CreatedAt:          currentTime,
+				CreatedBy:          null.Int64From(loginUser.ID),
+			},
+		)
+		if err != nil {
+			switch errors.GetCode(err) {
+			case codes.CodeSQLUniqueConstraint:
+				return errors.NewWithCode(codes.CodeConflict, "attendance is already recorded for %s", attendanceCorrection.AttendanceDate.Time.Format(time.DateOnly))
+			default:
+				return err
+			}
+		}
+
+		attendanceCorrection.CorrectionStatus = entity.AttendanceCorrectionStatusApproved
+		attendanceCorrection.ReviewedAt = currentTime
+		attendanceCorrection.ReviewedBy = null.Int64From(loginUser.ID)
+		attendanceCorrection.AttendanceID = null.Int64From(attendance.ID)
+
+		return a.attendanceCorrectionDom.Update(
+			ctx,
+			entity.AttendanceCorrectionUpdateParam{
+				CorrectionStatus: attendanceCorrection.CorrectionStatus,
+				ReviewedAt:       attendanceCorrection.ReviewedAt,
+				ReviewedBy:       attendanceCorrection.ReviewedBy,
+				AttendanceID:     attendanceCorrection.AttendanceID,
+				UpdatedAt:        currentTime,
+				UpdatedBy:        null.Int64From(loginUser.ID),
+			},
+			entity.AttendanceCorrectionParam{
+				ID: attendanceCorrection.ID,
+			},
+		)
+	})
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	return attendanceCorrection, nil
+}
+
+// RejectCorrection rejects the correction with the note of the admin, no attendance is recorded.
+func (a *attendance) RejectCorrection(ctx context.Context, attendanceCorrectionID int64, inputParam dto.RejectAttendanceCorrectionParam) (entity.AttendanceCorrection, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	attendanceCorrection, err := a.getPendingCorrection(ctx, attendanceCorrectionID)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	currentTime := null.TimeFrom(Now())
+	attendanceCorrection.CorrectionStatus = entity.AttendanceCorrectionStatusRejected
+	attendanceCorrection.ReviewNote = null.StringFrom(inputParam.ReviewNote)
+	attendanceCorrection.ReviewedAt = currentTime
+	attendanceCorrection.ReviewedBy = null.Int64From(loginUser.ID)
+
+	err = a.attendanceCorrectionDom.Update(
+		ctx,
+		entity.AttendanceCorrectionUpdateParam{
+			CorrectionStatus: attendanceCorrection.CorrectionStatus,
+			ReviewNote:       attendanceCorrection.ReviewNote,
+			ReviewedAt:       attendanceCorrection.ReviewedAt,
+			ReviewedBy:       attendanceCorrection.ReviewedBy,
+			UpdatedAt:        currentTime,
+			UpdatedBy:        null.Int64From(loginUser.ID),
+		},
+		entity.AttendanceCorrectionParam{
+			ID: attendanceCorrection.ID,
+		},
+	)
+	if err != nil {
+		return entity.AttendanceCorrection{}, err
+	}
+
+	return attendanceCorrection, nil
+}
+
+// getPendingCorrection returns the attendance correction that is still waiting to be reviewed.
+func (a *attendance) getPendingCorrection(ctx context.Context, attendanceCorrectionID int64) (entity.AttendanceCorrection, error) {
+	attendanceCorrection, err := a.attendanceCorrectionDom.Get(
+		ctx,
+		entity.AttendanceCorrectionParam{
+			ID:          attendanceCorrectionID,
+			BypassCache: true,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeNotFound, "attendance correction not found")
+		default:
+			return entity.AttendanceCorrection{}, err
+		}
+	}
+
+	if attendanceCorrection.IsReviewed() {
+		return entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeConflict, "attendance correction is already %s", attendanceCorrection.CorrectionStatus)
+	}
+
+	return attendanceCorrection, nil
+}
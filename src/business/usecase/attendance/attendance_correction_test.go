@@ -0,0 +1,526 @@
+package attendance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	attendance_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance"
+	mock_attendance_correction "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_correction"
+	attendance_period_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_period"
+	mock_attendance_type "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_type"
+	mock_holiday "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/holiday"
+	mock_transactor "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/transactor"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_attendance_CreateCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendanceDom := attendance_dom.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := attendance_period_dom.NewMockInterface(ctrl)
+	mockAttendanceCorrectionDom := mock_attendance_correction.NewMockInterface(ctrl)
+	mockHolidayDom := mock_holiday.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+	mockUserWorkScheduleDom := mock_user_work_schedule.NewMockInterface(ctrl)
+	mockAttendanceTypeDom := mock_attendance_type.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		AttendancePeriod:     mockAttendancePeriodDom,
+		Attendance:           mockAttendanceDom,
+		AttendanceCorrection: mockAttendanceCorrectionDom,
+		Holiday:              mockHolidayDom,
+		User:                 mockUserDom,
+		UserWorkSchedule:     mockUserWorkScheduleDom,
+		AttendanceType:       mockAttendanceTypeDom,
+		Auth:                 mockAuth,
+	})
+
+	mockTime := time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC) // A Friday
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:    1,
+		Name:  "Test User",
+		Email: "test@example.com",
+	}
+
+	mockCheckInAt := time.Date(2023, 10, 4, 8, 55, 0, 0, time.UTC) // A Wednesday
+	mockParam := dto.CreateAttendanceCorrectionParam{
+		AttendanceType: entity.AttendanceTypeCodeFieldVisit,
+		CheckInAt:      null.TimeFrom(mockCheckInAt),
+		CheckOutAt:     null.TimeFrom(mockCheckInAt.Add(8*time.Hour + 35*time.Minute)),
+		Reason:         "Forgot to check in after the client visit",
+	}
+
+	mockAttendanceTypeParam := entity.AttendanceTypeParam{
+		Code: entity.AttendanceTypeCodeFieldVisit,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	mockAttendanceType := entity.AttendanceType{
+		ID:   3,
+		Code: entity.AttendanceTypeCodeFieldVisit,
+	}
+
+	mockUserWorkScheduleParam := entity.UserWorkScheduleParam{
+		UserID:           mockLoginUser.ID,
+		EffectiveDateLTE: null.DateFrom(mockCheckInAt),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-effective_date"},
+		},
+	}
+	mockNoWorkScheduleErr := errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "")
+
+	mockHolidayParam := entity.HolidayParam{
+		HolidayDate: null.DateFrom(mockCheckInAt),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		StartDateLTE: null.DateFrom(mockCheckInAt),
+		EndDateGTE:   null.DateFrom(mockCheckInAt),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	mockAttendancePeriod := entity.AttendancePeriod{
+		ID:           3,
+		PeriodStatus: entity.PeriodStatusClosed,
+	}
+
+	mockAttendanceParam := entity.AttendanceParam{
+		UserID:         mockLoginUser.ID,
+		AttendanceDate: null.DateFrom(mockCheckInAt),
+		BypassCache:    true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	mockNoAttendanceErr := errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "")
+
+	mockInputParam := mockParam.ToAttendanceCorrectionInputParam(null.TimeFrom(mockTime), mockLoginUser.ID, mockAttendancePeriod.ID)
+	mockAttendanceCorrection := entity.AttendanceCorrection{
+		ID:                 7,
+		AttendancePeriodID: mockAttendancePeriod.ID,
+		UserID:             mockLoginUser.ID,
+		AttendanceDate:     mockInputParam.AttendanceDate,
+		AttendanceType:     mockParam.AttendanceType,
+		CheckInAt:          mockParam.CheckInAt,
+		CheckOutAt:         mockParam.CheckOutAt,
+		Reason:             mockParam.Reason,
+		CorrectionStatus:   entity.AttendanceCorrectionStatusPending,
+	}
+
+	tests := []struct {
+		name     string
+		param    dto.CreateAttendanceCorrectionParam
+		mockFunc func()
+		want     entity.AttendanceCorrection
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockAttendanceDom.EXPECT().Get(gomock.Any(), mockAttendanceParam).Return(entity.Attendance{}, mockNoAttendanceErr)
+				mockAttendanceCorrectionDom.EXPECT().Create(gomock.Any(), mockInputParam).Return(mockAttendanceCorrection, nil)
+			},
+			want: mockAttendanceCorrection,
+		},
+		{
+			name: "Missing Reason",
+			param: dto.CreateAttendanceCorrectionParam{
+				CheckInAt: mockParam.CheckInAt,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Check Out Before Check In",
+			param: dto.CreateAttendanceCorrectionParam{
+				CheckInAt:  mockParam.CheckInAt,
+				CheckOutAt: null.TimeFrom(mockCheckInAt.Add(-time.Hour)),
+				Reason:     mockParam.Reason,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Unavailable Attendance Type",
+			param: dto.CreateAttendanceCorrectionParam{
+				AttendanceType: entity.AttendanceTypeCodeBusinessTravel,
+				CheckInAt:      mockParam.CheckInAt,
+				Reason:         mockParam.Reason,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), entity.AttendanceTypeParam{
+					Code: entity.AttendanceTypeCodeBusinessTravel,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(entity.AttendanceType{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Correction For Today",
+			param: dto.CreateAttendanceCorrectionParam{
+				AttendanceType: mockParam.AttendanceType,
+				CheckInAt:      null.TimeFrom(time.Date(2023, 10, 6, 8, 0, 0, 0, time.UTC)),
+				Reason:         mockParam.Reason,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Correction On Day Off",
+			param: dto.CreateAttendanceCorrectionParam{
+				AttendanceType: mockParam.AttendanceType,
+				CheckInAt:      null.TimeFrom(time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)), // A Sunday
+				Reason:         mockParam.Reason,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), gomock.Any()).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Correction On Public Holiday",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{
+					{Name: "Company Day", HolidayDate: null.DateFrom(mockCheckInAt)},
+				}, nil, nil)
+				mockUserDom.EXPECT().Get(gomock.Any(), entity.UserParam{
+					ID: mockLoginUser.ID,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(entity.User{ID: mockLoginUser.ID}, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "No Attendance Period Found",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), mockAttendancePeriodParam).Return(entity.AttendancePeriod{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Attendance Period Already Processed",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), mockAttendancePeriodParam).Return(entity.AttendancePeriod{
+					ID:           mockAttendancePeriod.ID,
+					PeriodStatus: entity.PeriodStatusProcessed,
+				}, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Attendance Already Recorded",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockAttendanceDom.EXPECT().Get(gomock.Any(), mockAttendanceParam).Return(entity.Attendance{ID: 9}, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name:  "Correction Already Pending",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockAttendanceTypeParam).Return(mockAttendanceType, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockAttendanceDom.EXPECT().Get(gomock.Any(), mockAttendanceParam).Return(entity.Attendance{}, mockNoAttendanceErr)
+				mockAttendanceCorrectionDom.EXPECT().Create(gomock.Any(), mockInputParam).Return(entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateCorrection(context.Background(), tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendance.CreateCorrection() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errCode != 0 {
+					assert.Equal(t, tt.errCode, errors.GetCode(err))
+				}
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_attendance_ApproveCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendanceDom := attendance_dom.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := attendance_period_dom.NewMockInterface(ctrl)
+	mockAttendanceCorrectionDom := mock_attendance_correction.NewMockInterface(ctrl)
+	mockTransactor := mock_transactor.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		AttendancePeriod:     mockAttendancePeriodDom,
+		Attendance:           mockAttendanceDom,
+		AttendanceCorrection: mockAttendanceCorrectionDom,
+		Transactor:           mockTransactor,
+		Auth:                 mockAuth,
+	})
+
+	mockTime := time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC)
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+	mockLoginUser := auth.User{
+		ID:    99,
+		Name:  "Admin",
+		Email: "admin@example.com",
+	}
+
+	mockCheckInAt := time.Date(2023, 10, 4, 8, 55, 0, 0, time.UTC)
+	mockAttendanceCorrection := entity.AttendanceCorrection{
+		ID:                 7,
+		AttendancePeriodID: 3,
+		UserID:             1,
+		AttendanceDate:     null.DateFrom(mockCheckInAt),
+		AttendanceType:     entity.AttendanceTypeCodeFieldVisit,
+		CheckInAt:          null.TimeFrom(mockCheckInAt),
+		CheckOutAt:         null.TimeFrom(mockCheckInAt.Add(8*time.Hour + 35*time.Minute)),
+		Reason:             "Forgot to check in after the client visit",
+		CorrectionStatus:   entity.AttendanceCorrectionStatusPending,
+	}
+	mockAttendanceCorrectionParam := entity.AttendanceCorrectionParam{
+		ID:          mockAttendanceCorrection.ID,
+		BypassCache: true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		ID:          mockAttendanceCorrection.AttendancePeriodID,
+		BypassCache: true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	mockAttendancePeriod := entity.AttendancePeriod{
+		ID:           mockAttendanceCorrection.AttendancePeriodID,
+		PeriodStatus: entity.PeriodStatusOpen,
+	}
+
+	mockAttendanceInputParam := entity.AttendanceInputParam{
+		AttendancePeriodID: mockAttendanceCorrection.AttendancePeriodID,
+		UserID:             mockAttendanceCorrection.UserID,
+		AttendanceDate:     mockAttendanceCorrection.AttendanceDate,
+		AttendanceType:     mockAttendanceCorrection.AttendanceType,
+		CheckInAt:          mockAttendanceCorrection.CheckInAt,
+		CheckOutAt:         mockAttendanceCorrection.CheckOutAt,
+		WorkedMinutes:      515,
+		CreatedAt:          null.TimeFrom(mockTime),
+		CreatedBy:          null.Int64From(mockLoginUser.ID),
+	}
+
+	mockUpdateParam := entity.AttendanceCorrectionUpdateParam{
+		CorrectionStatus: entity.AttendanceCorrectionStatusApproved,
+		ReviewedAt:       null.TimeFrom(mockTime),
+		ReviewedBy:       null.Int64From(mockLoginUser.ID),
+		AttendanceID:     null.Int64From(12),
+		UpdatedAt:        null.TimeFrom(mockTime),
+		UpdatedBy:        null.Int64From(mockLoginUser.ID),
+	}
+
+	mockApprovedCorrection := mockAttendanceCorrection
+	mockApprovedCorrection.CorrectionStatus = entity.AttendanceCorrectionStatusApproved
+	mockApprovedCorrection.ReviewedAt = null.TimeFrom(mockTime)
+	mockApprovedCorrection.ReviewedBy = null.Int64From(mockLoginUser.ID)
+	mockApprovedCorrection.AttendanceID = null.Int64From(12)
+
+	mockExecute := func() {
+		mockTransactor.EXPECT().Execute(ctx, "txApproveAttendanceCorrection", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+				return callback(ctx)
+			},
+		)
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     entity.AttendanceCorrection
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceCorrectionDom.EXPECT().Get(ctx, mockAttendanceCorrectionParam).Return(mockAttendanceCorrection, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockExecute()
+				mockAttendanceDom.EXPECT().Create(ctx, mockAttendanceInputParam).Return(entity.Attendance{ID: 12}, nil)
+				mockAttendanceCorrectionDom.EXPECT().Update(ctx, mockUpdateParam, entity.AttendanceCorrectionParam{ID: mockAttendanceCorrection.ID}).Return(nil)
+			},
+			want: mockApprovedCorrection,
+		},
+		{
+			name: "Attendance Correction Not Found",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceCorrectionDom.EXPECT().Get(ctx, mockAttendanceCorrectionParam).Return(entity.AttendanceCorrection{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name: "Attendance Correction Already Reviewed",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceCorrectionDom.EXPECT().Get(ctx, mockAttendanceCorrectionParam).Return(mockApprovedCorrection, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name: "Attendance Period No Longer Correctable",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceCorrectionDom.EXPECT().Get(ctx, mockAttendanceCorrectionParam).Return(mockAttendanceCorrection, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{
+					ID:           mockAttendancePeriod.ID,
+					PeriodStatus: entity.PeriodStatusProcessing,
+				}, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Attendance Already Recorded",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceCorrectionDom.EXPECT().Get(ctx, mockAttendanceCorrectionParam).Return(mockAttendanceCorrection, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockExecute()
+				mockAttendanceDom.EXPECT().Create(ctx, mockAttendanceInputParam).Return(entity.Attendance{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name: "Database Error When Update Correction",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceCorrectionDom.EXPECT().Get(ctx, mockAttendanceCorrectionParam).Return(mockAttendanceCorrection, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockExecute()
+				mockAttendanceDom.EXPECT().Create(ctx, mockAttendanceInputParam).Return(entity.Attendance{ID: 12}, nil)
+				mockAttendanceCorrectionDom.EXPECT().Update(ctx, mockUpdateParam, gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.ApproveCorrection(ctx, mockAttendanceCorrection.ID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendance.ApproveCorrection() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errCode != 0 {
+					assert.Equal(t, tt.errCode, errors.GetCode(err))
+				}
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package attendance
+
+import (
+	"context"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// GetMyAttendances returns a page of the days the logged in user was recorded as present.
+func (a *attendance) GetMyAttendances(ctx context.Context, inputParam dto.GetAttendancesParam) (dto.AttendanceHistory, *entity.Pagination, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return dto.AttendanceHistory{}, nil, err
+	}
+
+	return a.getAttendanceHistory(ctx, loginUser.ID, inputParam)
+}
+
+// GetUserAttendances returns a page of the days an employee was recorded as present.
+func (a *attendance) GetUserAttendances(ctx context.Context, userID int64, inputParam dto.GetAttendancesParam) (dto.AttendanceHistory, *entity.Pagination, error) {
+	_, err := a.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID:     userID,
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.AttendanceHistory{}, nil, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return dto.AttendanceHistory{}, nil, err
+		}
+	}
+
+	return a.getAttendanceHistory(ctx, userID, inputParam)
+}
+
+// getAttendanceHistory lists a page of the attendances of the user, the attendance counts per period cover every
+// attendance matching the filter rather than the page only.
+func (a *attendance) getAttendanceHistory(ctx context.Context, userID int64, inputParam dto.GetAttendancesParam) (dto.AttendanceHistory, *entity.Pagination, error) {
+	param, err := inputParam.ToAttendanceParam(userID)
+	if err != nil {
+		return dto.AttendanceHistory{}, nil, err
+	}
+
+	attendances, pg, err := a.attendanceDom.GetList(ctx, param)
+	if err != nil {
+		return dto.AttendanceHistory{}, nil, err
+	}
+
+	countParam := param
+	countParam.QueryOption.DisableLimit = true
+	countParam.PaginationParam = entity.PaginationParam{
+		SortBy: param.SortBy,
+	}
+
+	allAttendances, _, err := a.attendanceDom.GetList(ctx, countParam)
+	if err != nil {
+		return dto.AttendanceHistory{}, nil, err
+	}
+
+	currentTime := Now()
+	history := dto.AttendanceHistory{
+		Attendances:  make([]dto.AttendanceRecord, 0, len(attendances)),
+		PeriodCounts: []dto.AttendancePeriodCount{},
+	}
+
+	for _, attendance := range attendances {
+		history.Attendances = append(history.Attendances, a.toAttendanceRecord(attendance, currentTime))
+	}
+
+	// the attendances are listed latest first, so are the periods they are counted in
+	periodIndexes := map[int64]int{}
+	for _, attendance := range allAttendances {
+		index, ok := periodIndexes[attendance.AttendancePeriodID]
+		if !ok {
+			index = len(history.PeriodCounts)
+			periodIndexes[attendance.AttendancePeriodID] = index
+			history.PeriodCounts = append(history.PeriodCounts, dto.AttendancePeriodCount{AttendancePeriodID: attendance.AttendancePeriodID})
+		}
+
+		history.PeriodCounts[index].AttendanceCount++
+	}
+
+	return history, pg, nil
+}
@@ -0,0 +1,291 @@
+package attendance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	attendance_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_attendance_GetMyAttendances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendanceDom := attendance_dom.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Attendance: mockAttendanceDom,
+		Auth:       mockAuth,
+	})
+
+	mockTime := time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC)
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+	mockLoginUser := auth.User{
+		ID:    1,
+		Name:  "Test User",
+		Email: "test@example.com",
+	}
+
+	mockParam := dto.GetAttendancesParam{
+		StartDate: "2023-09-25",
+		EndDate:   "2023-10-05",
+		Page:      1,
+		Limit:     2,
+	}
+
+	mockAttendanceParam := entity.AttendanceParam{
+		UserID:            mockLoginUser.ID,
+		AttendanceDateGTE: null.DateFrom(time.Date(2023, 9, 25, 0, 0, 0, 0, time.UTC)),
+		AttendanceDateLTE: null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-attendance_date"},
+			Page:   1,
+			Limit:  2,
+		},
+	}
+
+	mockCountParam := mockAttendanceParam
+	mockCountParam.QueryOption.DisableLimit = true
+	mockCountParam.PaginationParam = entity.PaginationParam{
+		SortBy: []string{"-attendance_date"},
+	}
+
+	mockAttendances := []entity.Attendance{
+		{
+			ID:                 12,
+			AttendancePeriodID: 3,
+			UserID:             mockLoginUser.ID,
+			AttendanceDate:     null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+			CheckInAt:          null.TimeFrom(time.Date(2023, 10, 5, 9, 0, 0, 0, time.UTC)),
+			CheckOutAt:         null.TimeFrom(time.Date(2023, 10, 5, 17, 30, 0, 0, time.UTC)),
+			WorkedMinutes:      510,
+		},
+		{
+			ID:                 11,
+			AttendancePeriodID: 3,
+			UserID:             mockLoginUser.ID,
+			AttendanceDate:     null.DateFrom(time.Date(2023, 10, 4, 0, 0, 0, 0, time.UTC)),
+			CheckInAt:          null.TimeFrom(time.Date(2023, 10, 4, 9, 0, 0, 0, time.UTC)),
+		},
+	}
+	mockAllAttendances := append(append([]entity.Attendance{}, mockAttendances...),
+		entity.Attendance{ID: 8, AttendancePeriodID: 2, UserID: mockLoginUser.ID, AttendanceDate: null.DateFrom(time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC))},
+		entity.Attendance{ID: 7, AttendancePeriodID: 2, UserID: mockLoginUser.ID, AttendanceDate: null.DateFrom(time.Date(2023, 9, 28, 0, 0, 0, 0, time.UTC))},
+		entity.Attendance{ID: 6, AttendancePeriodID: 2, UserID: mockLoginUser.ID, AttendanceDate: null.DateFrom(time.Date(2023, 9, 27, 0, 0, 0, 0, time.UTC))},
+	)
+	mockPagination := &entity.Pagination{CurrentPage: 1, CurrentElements: 2, TotalPages: 3, TotalElements: 5}
+
+	tests := []struct {
+		name     string
+		param    dto.GetAttendancesParam
+		mockFunc func()
+		want     dto.AttendanceHistory
+		wantPg   *entity.Pagination
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(ctx, mockAttendanceParam).Return(mockAttendances, mockPagination, nil)
+				mockAttendanceDom.EXPECT().GetList(ctx, mockCountParam).Return(mockAllAttendances, nil, nil)
+			},
+			want: dto.AttendanceHistory{
+				Attendances: []dto.AttendanceRecord{
+					{
+						ID:                 12,
+						AttendancePeriodID: 3,
+						AttendanceDate:     mockAttendances[0].AttendanceDate,
+						CheckInAt:          mockAttendances[0].CheckInAt,
+						CheckOutAt:         mockAttendances[0].CheckOutAt,
+						WorkedHours:        8.5,
+					},
+					{
+						ID:                 11,
+						AttendancePeriodID: 3,
+						AttendanceDate:     mockAttendances[1].AttendanceDate,
+						CheckInAt:          mockAttendances[1].CheckInAt,
+						MissingCheckOut:    true,
+					},
+				},
+				PeriodCounts: []dto.AttendancePeriodCount{
+					{AttendancePeriodID: 3, AttendanceCount: 2},
+					{AttendancePeriodID: 2, AttendanceCount: 3},
+				},
+			},
+			wantPg: mockPagination,
+		},
+		{
+			name: "Invalid Start Date",
+			param: dto.GetAttendancesParam{
+				StartDate: "25-09-2023",
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "End Date Before Start Date",
+			param: dto.GetAttendancesParam{
+				StartDate: "2023-10-05",
+				EndDate:   "2023-09-25",
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Limit Too Large",
+			param: dto.GetAttendancesParam{
+				Limit: 500,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Database Error When Get Attendances",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(ctx, mockAttendanceParam).Return(nil, nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, gotPg, err := uc.GetMyAttendances(ctx, tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendance.GetMyAttendances() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errCode != 0 {
+					assert.Equal(t, tt.errCode, errors.GetCode(err))
+				}
+			} else {
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantPg, gotPg)
+			}
+		})
+	}
+}
+
+func Test_attendance_GetUserAttendances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendanceDom := attendance_dom.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Attendance: mockAttendanceDom,
+		User:       mockUserDom,
+	})
+
+	ctx := context.Background()
+	mockUserParam := entity.UserParam{
+		ID:     5,
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockAttendanceParam := entity.AttendanceParam{
+		UserID:             5,
+		AttendancePeriodID: 3,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-attendance_date"},
+		},
+	}
+
+	mockCountParam := mockAttendanceParam
+	mockCountParam.QueryOption.DisableLimit = true
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     dto.AttendanceHistory
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success Without Attendances",
+			mockFunc: func() {
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{ID: 5}, nil)
+				mockAttendanceDom.EXPECT().GetList(ctx, mockAttendanceParam).Return([]entity.Attendance{}, &entity.Pagination{}, nil)
+				mockAttendanceDom.EXPECT().GetList(ctx, mockCountParam).Return([]entity.Attendance{}, nil, nil)
+			},
+			want: dto.AttendanceHistory{
+				Attendances:  []dto.AttendanceRecord{},
+				PeriodCounts: []dto.AttendancePeriodCount{},
+			},
+		},
+		{
+			name: "User Not Found",
+			mockFunc: func() {
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, _, err := uc.GetUserAttendances(ctx, 5, dto.GetAttendancesParam{AttendancePeriodID: 3})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendance.GetUserAttendances() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errCode != 0 {
+					assert.Equal(t, tt.errCode, errors.GetCode(err))
+				}
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
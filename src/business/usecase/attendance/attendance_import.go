@@ -0,0 +1,441 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// attendanceImportBatchSize is the number of attendances inserted at once when importing a device log.
+const attendanceImportBatchSize = 500
+
+// systemUserID records the changes made by the service itself rather than a logged in user.
+const systemUserID = -1
+
+// pendingAttendanceImport is a device log line that passed every check and waits to be inserted.
+type pendingAttendanceImport struct {
+	line       dto.AttendanceImportRawLine
+	inputParam entity.AttendanceInputParam
+}
+
+// ImportAttendances imports the attendances of a biometric device log on behalf of the employees, created by the
+// logged in admin.
+func (a *attendance) ImportAttendances(ctx context.Context, inputParam dto.ImportAttendanceParam) (dto.AttendanceImportReport, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	return a.importAttendances(ctx, inputParam, loginUser.ID)
+}
+
+// ImportAttendancesCommand imports the attendances of a biometric device log from the command line, there is no
+// logged in user so they are created by the system.
+func (a *attendance) ImportAttendancesCommand(ctx context.Context, inputParam dto.ImportAttendanceParam) (dto.AttendanceImportReport, error) {
+	return a.importAttendances(ctx, inputParam, systemUserID)
+}
+
+// UpdateUserDevice maps the employee to the user ID the biometric devices know them by.
+func (a *attendance) UpdateUserDevice(ctx context.Context, userID int64, inputParam dto.UpdateUserDeviceParam) error {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	err = a.userDom.Update(
+		ctx,
+		inputParam.ToUserUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+		entity.UserParam{
+			ID: userID,
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLNoRowsAffected:
+			return errors.NewWithCode(codes.CodeNotFound, "user not found")
+		case codes.CodeSQLUniqueConstraint:
+			return errors.NewWithCode(codes.CodeConflict, "device user ID %s is already mapped to another employee", inputParam.DeviceUserID)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importAttendances checks every line of the device log on its own, so that a bad line is reported rather than
+// failing the whole import. A line is rejected on a day off of the work schedule of the employee or on a public
+// holiday they observe, as a check in is. A line is a duplicate when the employee already has an attendance on its
+// date, either recorded before or on an earlier line of the log. The biometric devices are at the offices, so every
+// imported attendance is an OFFICE one.
+//
+// The attendances are inserted in batches, each in its own statement. When a batch fails the report of the batches
+// inserted before it is returned with the error, importing the same log again marks those lines as duplicates.
+func (a *attendance) importAttendances(ctx context.Context, inputParam dto.ImportAttendanceParam, importedBy int64) (dto.AttendanceImportReport, error) {
+	if err := inputParam.Validate(); err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	currentTime := null.TimeFrom(Now())
+	report := dto.AttendanceImportReport{
+		AcceptedLines:  []int64{},
+		DuplicateLines: []int64{},
+		Rejections:     []dto.AttendanceImportRejection{},
+	}
+
+	// parse the lines of the device log
+	rawLines := map[int64]dto.AttendanceImportRawLine{}
+	importLines := []entity.AttendanceImportLine{}
+	for _, rawLine := range inputParam.Lines() {
+		importLine, err := entity.ParseAttendanceImportLine(inputParam.Format, rawLine.LineNumber, rawLine.Text, currentTime.Time.Location())
+		if err != nil {
+			report.Reject(rawLine, err.Error())
+			continue
+		}
+
+		if importLine.AttendanceDate.Time.Format(time.DateOnly) > currentTime.Time.Format(time.DateOnly) {
+			report.Reject(rawLine, "attendance date is in the future")
+			continue
+		}
+
+		rawLines[rawLine.LineNumber] = rawLine
+		importLines = append(importLines, importLine)
+	}
+
+	if len(importLines) == 0 {
+		report.Sort()
+		return report, nil
+	}
+
+	users, err := a.getUsersByDeviceUserID(ctx, importLines)
+	if err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	startDate, endDate := importLines[0].AttendanceDate, importLines[0].AttendanceDate
+	for _, importLine := range importLines {
+		if importLine.AttendanceDate.Time.Before(startDate.Time) {
+			startDate = importLine.AttendanceDate
+		}
+
+		if importLine.AttendanceDate.Time.After(endDate.Time) {
+			endDate = importLine.AttendanceDate
+		}
+	}
+
+	userIDs := make([]int64, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	attendancePeriods, _, err := a.attendancePeriodDom.GetList(
+		ctx,
+		entity.AttendancePeriodParam{
+			StartDateLTE: endDate,
+			EndDateGTE:   startDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	recordedAttendances, err := a.getRecordedAttendances(ctx, userIDs, startDate, endDate)
+	if err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	workSchedules, err := a.getWorkSchedules(ctx)
+	if err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	userIDToWorkSchedules, err := a.getUserIDToWorkSchedules(ctx, endDate)
+	if err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	holidays, err := a.getHolidays(ctx, startDate, endDate)
+	if err != nil {
+		return dto.AttendanceImportReport{}, err
+	}
+
+	// check every parsed line against the employees, their work schedules and holidays, the attendance periods and
+	// the recorded attendances
+	pendingImports := []pendingAttendanceImport{}
+	for _, importLine := range importLines {
+		rawLine := rawLines[importLine.LineNumber]
+		attendanceDate := importLine.AttendanceDate.Time.Format(time.DateOnly)
+
+		user, ok := users[importLine.DeviceUserID]
+		if !ok {
+			report.Reject(rawLine, fmt.Sprintf("device user ID %s is not mapped to an employee", importLine.DeviceUserID))
+			continue
+		}
+
+		if !user.IsEmployedOn(importLine.AttendanceDate.Time) {
+			report.Reject(rawLine, fmt.Sprintf("employee %s is not employed on %s", user.Name, attendanceDate))
+			continue
+		}
+
+		workSchedule := entity.NewWorkCalendar(userIDToWorkSchedules[user.ID], workSchedules).GetWorkSchedule(importLine.AttendanceDate.Time)
+		if !workSchedule.IsWorkDay(importLine.AttendanceDate.Time.Weekday()) {
+			report.Reject(rawLine, fmt.Sprintf("%s is a day off of work schedule %s", attendanceDate, workSchedule.Name))
+			continue
+		}
+
+		if holiday, ok := entity.NewHolidayCalendar(holidays, user.Region)[attendanceDate]; ok {
+			report.Reject(rawLine, fmt.Sprintf("%s is public holiday %s", attendanceDate, holiday.Name))
+			continue
+		}
+
+		attendancePeriod, ok := findAttendancePeriod(attendancePeriods, importLine.AttendanceDate.Time)
+		if !ok {
+			report.Reject(rawLine, fmt.Sprintf("no attendance period found for %s", attendanceDate))
+			continue
+		}
+
+		if !attendancePeriod.IsCorrectable() {
+			report.Reject(rawLine, fmt.Sprintf("attendance period of %s with status %s can no longer be changed", attendanceDate, attendancePeriod.PeriodStatus))
+			continue
+		}
+
+		key := fmt.Sprintf("%d|%s", user.ID, attendanceDate)
+		if recordedAttendances[key] {
+			report.MarkDuplicate(rawLine)
+			continue
+		}
+		recordedAttendances[key] = true
+
+		attendance := entity.Attendance{CheckInAt: importLine.CheckInAt}
+		var workedMinutes int64
+		if importLine.CheckOutAt.Valid {
+			workedMinutes = attendance.WorkedMinutesUntil(importLine.CheckOutAt.Time)
+		}
+
+		pendingImports = append(pendingImports, pendingAttendanceImport{
+			line: rawLine,
+			inputParam: entity.AttendanceInputParam{
+				AttendancePeriodID: attendancePeriod.ID,
+				UserID:             user.ID,
+				AttendanceDate:     importLine.AttendanceDate,
+				AttendanceType:     entity.AttendanceTypeCodeOffice,
+				CheckInAt:          importLine.CheckInAt,
+				CheckOutAt:         importLine.CheckOutAt,
+				WorkedMinutes:      workedMinutes,
+				CreatedAt:          currentTime,
+				CreatedBy:          null.Int64From(importedBy),
+			},
+		})
+	}
+
+	for start := 0; start < len(pendingImports); start += attendanceImportBatchSize {
+		batch := pendingImports[start:min(start+attendanceImportBatchSize, len(pendingImports))]
+		if err := a.createAttendanceBatch(ctx, batch, &report); err != nil {
+			report.Sort()
+			return report, err
+		}
+	}
+
+	report.Sort()
+	return report, nil
+}
+
+// createAttendanceBatch inserts the batch at once. When an attendance of the batch was recorded in the meantime the
+// unique_user_date constraint fails the whole batch, so its attendances are inserted one by one to find the duplicates.
+func (a *attendance) createAttendanceBatch(ctx context.Context, batch []pendingAttendanceImport, report *dto.AttendanceImportReport) error {
+	inputParams := make([]entity.AttendanceInputParam, 0, len(batch))
+	for _, pendingImport := range batch {
+		inputParams = append(inputParams, pendingImport.inputParam)
+	}
+
+	err := a.attendanceDom.CreateMany(ctx, inputParams)
+	if err == nil {
+		for _, pendingImport := range batch {
+			report.Accept(pendingImport.line)
+		}
+
+		return nil
+	} else if errors.GetCode(err) != codes.CodeSQLUniqueConstraint {
+		return err
+	}
+
+	for _, pendingImport := range batch {
+		_, err := a.attendanceDom.Create(ctx, pendingImport.inputParam)
+		switch {
+		case err == nil:
+			report.Accept(pendingImport.line)
+		case errors.GetCode(err) == codes.CodeSQLUniqueConstraint:
+			report.MarkDuplicate(pendingImport.line)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getUsersByDeviceUserID returns the employees mapped to the device user IDs of the lines, by device user ID.
+func (a *attendance) getUsersByDeviceUserID(ctx context.Context, importLines []entity.AttendanceImportLine) (map[string]entity.User, error) {
+	param := entity.UserParam{
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	seen := map[string]bool{}
+	for _, importLine := range importLines {
+		if !seen[importLine.DeviceUserID] {
+			seen[importLine.DeviceUserID] = true
+			param.DeviceUserIDs = append(param.DeviceUserIDs, importLine.DeviceUserID)
+		}
+	}
+
+	users, _, err := a.userDom.GetList(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByDeviceUserID := make(map[string]entity.User, len(users))
+	for _, user := range users {
+		usersByDeviceUserID[user.DeviceUserID.String] = user
+	}
+
+	return usersByDeviceUserID, nil
+}
+
+// getRecordedAttendances returns the days the employees already have an attendance on, keyed by user ID and date.
+// Deleted attendances are included as the unique_user_date constraint still holds for them.
+func (a *attendance) getRecordedAttendances(ctx context.Context, userIDs []int64, startDate, endDate null.Date) (map[string]bool, error) {
+	recordedAttendances := map[string]bool{}
+	if len(userIDs) == 0 {
+		return recordedAttendances, nil
+	}
+
+	attendances, _, err := a.attendanceDom.GetList(
+		ctx,
+		entity.AttendanceParam{
+			UserIDs:           userIDs,
+			AttendanceDateGTE: startDate,
+			AttendanceDateLTE: endDate,
+			BypassCache:       true,
+			QueryOption: query.Option{
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attendance := range attendances {
+		recordedAttendances[fmt.Sprintf("%d|%s", attendance.UserID, attendance.AttendanceDate.Time.Format(time.DateOnly))] = true
+	}
+
+	return recordedAttendances, nil
+}
+
+// getWorkSchedules returns the active work schedules by ID.
+func (a *attendance) getWorkSchedules(ctx context.Context) (map[int64]entity.WorkSchedule, error) {
+	workSchedules := make(map[int64]entity.WorkSchedule)
+
+	workScheduleList, _, err := a.workScheduleDom.GetList(
+		ctx,
+		entity.WorkScheduleParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return workSchedules, err
+	}
+
+	for _, workSchedule := range workScheduleList {
+		workSchedules[workSchedule.ID] = workSchedule
+	}
+
+	return workSchedules, nil
+}
+
+// getUserIDToWorkSchedules returns the work schedule assignments that took effect by the last date of the log,
+// the ones before its first date are needed to know the schedule it starts with.
+func (a *attendance) getUserIDToWorkSchedules(ctx context.Context, endDate null.Date) (map[int64][]entity.UserWorkSchedule, error) {
+	userIDToWorkSchedules := make(map[int64][]entity.UserWorkSchedule)
+
+	userWorkSchedules, _, err := a.userWorkScheduleDom.GetList(
+		ctx,
+		entity.UserWorkScheduleParam{
+			EffectiveDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return userIDToWorkSchedules, err
+	}
+
+	for _, userWorkSchedule := range userWorkSchedules {
+		userIDToWorkSchedules[userWorkSchedule.UserID] = append(userIDToWorkSchedules[userWorkSchedule.UserID], userWorkSchedule)
+	}
+
+	return userIDToWorkSchedules, nil
+}
+
+// getHolidays returns the public holidays of every region that fall within the dates of the log.
+func (a *attendance) getHolidays(ctx context.Context, startDate, endDate null.Date) ([]entity.Holiday, error) {
+	holidays, _, err := a.holidayDom.GetList(
+		ctx,
+		entity.HolidayParam{
+			HolidayDateGTE: startDate,
+			HolidayDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"holiday_date"},
+			},
+		},
+	)
+	if err != nil {
+		return holidays, err
+	}
+
+	return holidays, nil
+}
+
+// findAttendancePeriod returns the attendance period the date falls in.
+func findAttendancePeriod(attendancePeriods []entity.AttendancePeriod, date time.Time) (entity.AttendancePeriod, bool) {
+	for _, attendancePeriod := range attendancePeriods {
+		if attendancePeriod.Contains(date) {
+			return attendancePeriod, true
+		}
+	}
+
+	return entity.AttendancePeriod{}, false
+}
@@ -0,0 +1,312 @@
+package attendance
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	attendance_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance"
+	attendance_period_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_period"
+	mock_holiday "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/holiday"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_work_schedule"
+	mock_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_attendance_ImportAttendances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendanceDom := attendance_dom.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := attendance_period_dom.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+	mockHolidayDom := mock_holiday.NewMockInterface(ctrl)
+	mockWorkScheduleDom := mock_work_schedule.NewMockInterface(ctrl)
+	mockUserWorkScheduleDom := mock_user_work_schedule.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		AttendancePeriod: mockAttendancePeriodDom,
+		Attendance:       mockAttendanceDom,
+		Holiday:          mockHolidayDom,
+		User:             mockUserDom,
+		WorkSchedule:     mockWorkScheduleDom,
+		UserWorkSchedule: mockUserWorkScheduleDom,
+		Auth:             mockAuth,
+	})
+
+	mockTime := time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC)
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+	mockLoginUser := auth.User{
+		ID:    99,
+		Name:  "Admin",
+		Email: "admin@example.com",
+	}
+
+	mockParam := dto.ImportAttendanceParam{
+		Content: strings.Join([]string{
+			"device_user_id,date,check_in,check_out",
+			"1042,2023-10-04,08:55,17:40",
+			"9999,2023-10-04,08:55,17:40",
+			"1043,2023-10-04,08:50,17:00",
+			"1042,2023-10-04,09:00,17:00",
+			"1042,2023-10-04",
+			"1042,2023-09-20,08:55,17:40",
+			"1043,2023-10-09,08:55,17:40",
+			"1043,2023-10-05,08:30,",
+			"1042,2023-10-01,09:00,17:00",
+			"1043,2023-10-03,09:00,17:00",
+			"",
+		}, "\n"),
+	}
+
+	mockUserParam := entity.UserParam{
+		RoleID:        entity.RoleIDUser,
+		DeviceUserIDs: []string{"1042", "9999", "1043"},
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+	mockUsers := []entity.User{
+		{ID: 1, Name: "Alice", DeviceUserID: null.StringFrom("1042")},
+		{ID: 2, Name: "Bob", DeviceUserID: null.StringFrom("1043")},
+	}
+
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		StartDateLTE: null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+		EndDateGTE:   null.DateFrom(time.Date(2023, 9, 20, 0, 0, 0, 0, time.UTC)),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+	mockAttendancePeriods := []entity.AttendancePeriod{
+		{
+			ID:           2,
+			StartDate:    null.DateFrom(time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)),
+			EndDate:      null.DateFrom(time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)),
+			PeriodStatus: entity.PeriodStatusProcessed,
+		},
+		{
+			ID:           3,
+			StartDate:    null.DateFrom(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)),
+			EndDate:      null.DateFrom(time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)),
+			PeriodStatus: entity.PeriodStatusOpen,
+		},
+	}
+
+	mockAttendanceParam := entity.AttendanceParam{
+		UserIDs:           []int64{1, 2},
+		AttendanceDateGTE: null.DateFrom(time.Date(2023, 9, 20, 0, 0, 0, 0, time.UTC)),
+		AttendanceDateLTE: null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+		BypassCache:       true,
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	}
+	mockRecordedAttendances := []entity.Attendance{
+		{ID: 5, UserID: 2, AttendanceDate: null.DateFrom(time.Date(2023, 10, 4, 0, 0, 0, 0, time.UTC))},
+	}
+
+	mockWorkScheduleParam := entity.WorkScheduleParam{
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	mockUserWorkScheduleParam := entity.UserWorkScheduleParam{
+		EffectiveDateLTE: null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"effective_date"},
+		},
+	}
+
+	mockHolidayParam := entity.HolidayParam{
+		HolidayDateGTE: null.DateFrom(time.Date(2023, 9, 20, 0, 0, 0, 0, time.UTC)),
+		HolidayDateLTE: null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"holiday_date"},
+		},
+	}
+	mockHolidays := []entity.Holiday{
+		{ID: 1, HolidayDate: null.DateFrom(time.Date(2023, 10, 3, 0, 0, 0, 0, time.UTC)), Name: "Founding Day"},
+	}
+
+	mockInputParams := []entity.AttendanceInputParam{
+		{
+			AttendancePeriodID: 3,
+			UserID:             1,
+			AttendanceDate:     null.DateFrom(time.Date(2023, 10, 4, 0, 0, 0, 0, time.UTC)),
+			AttendanceType:     entity.AttendanceTypeCodeOffice,
+			CheckInAt:          null.TimeFrom(time.Date(2023, 10, 4, 8, 55, 0, 0, time.UTC)),
+			CheckOutAt:         null.TimeFrom(time.Date(2023, 10, 4, 17, 40, 0, 0, time.UTC)),
+			WorkedMinutes:      525,
+			CreatedAt:          null.TimeFrom(mockTime),
+			CreatedBy:          null.Int64From(mockLoginUser.ID),
+		},
+		{
+			AttendancePeriodID: 3,
+			UserID:             2,
+			AttendanceDate:     null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+			AttendanceType:     entity.AttendanceTypeCodeOffice,
+			CheckInAt:          null.TimeFrom(time.Date(2023, 10, 5, 8, 30, 0, 0, time.UTC)),
+			CreatedAt:          null.TimeFrom(mockTime),
+			CreatedBy:          null.Int64From(mockLoginUser.ID),
+		},
+	}
+
+	mockRejections := []dto.AttendanceImportRejection{
+		{LineNumber: 3, Line: "9999,2023-10-04,08:55,17:40", Reason: "device user ID 9999 is not mapped to an employee"},
+		{LineNumber: 6, Line: "1042,2023-10-04", Reason: "line must have 3 or 4 fields, got 2"},
+		{LineNumber: 7, Line: "1042,2023-09-20,08:55,17:40", Reason: "attendance period of 2023-09-20 with status PROCESSED can no longer be changed"},
+		{LineNumber: 8, Line: "1043,2023-10-09,08:55,17:40", Reason: "attendance date is in the future"},
+		{LineNumber: 10, Line: "1042,2023-10-01,09:00,17:00", Reason: "2023-10-01 is a day off of work schedule Monday to Friday"},
+		{LineNumber: 11, Line: "1043,2023-10-03,09:00,17:00", Reason: "2023-10-03 is public holiday Founding Day"},
+	}
+
+	mockPrepare := func() {
+		mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+		mockUserDom.EXPECT().GetList(ctx, mockUserParam).Return(mockUsers, nil, nil)
+		mockAttendancePeriodDom.EXPECT().GetList(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriods, nil, nil)
+		mockAttendanceDom.EXPECT().GetList(ctx, mockAttendanceParam).Return(mockRecordedAttendances, nil, nil)
+		mockWorkScheduleDom.EXPECT().GetList(ctx, mockWorkScheduleParam).Return(nil, nil, nil)
+		mockUserWorkScheduleDom.EXPECT().GetList(ctx, mockUserWorkScheduleParam).Return(nil, nil, nil)
+		mockHolidayDom.EXPECT().GetList(ctx, mockHolidayParam).Return(mockHolidays, nil, nil)
+	}
+
+	tests := []struct {
+		name     string
+		param    dto.ImportAttendanceParam
+		mockFunc func()
+		want     dto.AttendanceImportReport
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			param: mockParam,
+			mockFunc: func() {
+				mockPrepare()
+				mockAttendanceDom.EXPECT().CreateMany(ctx, mockInputParams).Return(nil)
+			},
+			want: dto.AttendanceImportReport{
+				Accepted:       2,
+				Duplicate:      2,
+				Rejected:       6,
+				AcceptedLines:  []int64{2, 9},
+				DuplicateLines: []int64{4, 5},
+				Rejections:     mockRejections,
+			},
+		},
+		{
+			name:  "Attendance Recorded During Import",
+			param: mockParam,
+			mockFunc: func() {
+				mockPrepare()
+				mockAttendanceDom.EXPECT().CreateMany(ctx, mockInputParams).Return(errors.NewWithCode(codes.CodeSQLUniqueConstraint, ""))
+				mockAttendanceDom.EXPECT().Create(ctx, mockInputParams[0]).Return(entity.Attendance{ID: 10}, nil)
+				mockAttendanceDom.EXPECT().Create(ctx, mockInputParams[1]).Return(entity.Attendance{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, ""))
+			},
+			want: dto.AttendanceImportReport{
+				Accepted:       1,
+				Duplicate:      3,
+				Rejected:       6,
+				AcceptedLines:  []int64{2},
+				DuplicateLines: []int64{4, 5, 9},
+				Rejections:     mockRejections,
+			},
+		},
+		{
+			name: "Unknown Format",
+			param: dto.ImportAttendanceParam{
+				Format:  "XML",
+				Content: mockParam.Content,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Database Error When Create Attendances",
+			param: mockParam,
+			mockFunc: func() {
+				mockPrepare()
+				mockAttendanceDom.EXPECT().CreateMany(ctx, mockInputParams).Return(assert.AnError)
+			},
+			want: dto.AttendanceImportReport{
+				Duplicate:      2,
+				Rejected:       6,
+				AcceptedLines:  []int64{},
+				DuplicateLines: []int64{4, 5},
+				Rejections:     mockRejections,
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Database Error After Part Of The Attendances Are Created",
+			param: mockParam,
+			mockFunc: func() {
+				mockPrepare()
+				mockAttendanceDom.EXPECT().CreateMany(ctx, mockInputParams).Return(errors.NewWithCode(codes.CodeSQLUniqueConstraint, ""))
+				mockAttendanceDom.EXPECT().Create(ctx, mockInputParams[0]).Return(entity.Attendance{ID: 10}, nil)
+				mockAttendanceDom.EXPECT().Create(ctx, mockInputParams[1]).Return(entity.Attendance{}, assert.AnError)
+			},
+			want: dto.AttendanceImportReport{
+				Accepted:       1,
+				Duplicate:      2,
+				Rejected:       6,
+				AcceptedLines:  []int64{2},
+				DuplicateLines: []int64{4, 5},
+				Rejections:     mockRejections,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.ImportAttendances(ctx, tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendance.ImportAttendances() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+
+			// the report of a failed import holds the lines checked and created before the failure
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
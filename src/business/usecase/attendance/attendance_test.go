@@ -13,23 +13,45 @@ import (
 	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
 	attendance_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance"
 	attendance_period_dom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_period"
+	mock_attendance_type "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_type"
+	mock_holiday "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/holiday"
+	mock_office_location "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/office_location"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_office_location "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_office_location"
+	mock_user_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_work_schedule"
+	mock_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
-func Test_attendance_Create(t *testing.T) {
+func Test_attendance_CheckIn(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockAuth := mock_auth.NewMockInterface(ctrl)
 	mockAttendanceDom := attendance_dom.NewMockInterface(ctrl)
 	mockAttendancePeriodDom := attendance_period_dom.NewMockInterface(ctrl)
+	mockHolidayDom := mock_holiday.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+	mockWorkScheduleDom := mock_work_schedule.NewMockInterface(ctrl)
+	mockUserWorkScheduleDom := mock_user_work_schedule.NewMockInterface(ctrl)
+	mockOfficeLocationDom := mock_office_location.NewMockInterface(ctrl)
+	mockUserOfficeLocationDom := mock_user_office_location.NewMockInterface(ctrl)
+	mockAttendanceTypeDom := mock_attendance_type.NewMockInterface(ctrl)
 
 	uc := Init(InitParam{
-		AttendancePeriod: mockAttendancePeriodDom,
-		Attendance:       mockAttendanceDom,
-		Auth:             mockAuth,
+		AttendancePeriod:   mockAttendancePeriodDom,
+		Attendance:         mockAttendanceDom,
+		Holiday:            mockHolidayDom,
+		User:               mockUserDom,
+		WorkSchedule:       mockWorkScheduleDom,
+		UserWorkSchedule:   mockUserWorkScheduleDom,
+		OfficeLocation:     mockOfficeLocationDom,
+		UserOfficeLocation: mockUserOfficeLocationDom,
+		AttendanceType:     mockAttendanceTypeDom,
+		Auth:               mockAuth,
 	})
 
 	mockTime := time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC) // A Friday
@@ -48,25 +70,91 @@ func Test_attendance_Create(t *testing.T) {
 		ID: 1,
 	}
 
+	mockHolidayParam := entity.HolidayParam{
+		HolidayDate: null.DateFrom(mockTime),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	mockUserWorkScheduleParam := entity.UserWorkScheduleParam{
+		UserID:           mockLoginUser.ID,
+		EffectiveDateLTE: null.DateFrom(mockTime),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-effective_date"},
+		},
+	}
+	mockNoWorkScheduleErr := errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "")
+
+	mockUserParam := entity.UserParam{
+		ID: mockLoginUser.ID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockUserOfficeLocationParam := entity.UserOfficeLocationParam{
+		UserID: mockLoginUser.ID,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	mockOfficeLocationParam := entity.OfficeLocationParam{
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	mockOfficeAttendanceTypeParam := entity.AttendanceTypeParam{
+		Code: entity.AttendanceTypeCodeOffice,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockOfficeLocation := entity.OfficeLocation{
+		ID:           4,
+		Name:         "Head Office",
+		Latitude:     -6.175392,
+		Longitude:    106.827153,
+		RadiusMeters: 100,
+	}
+
 	tests := []struct {
 		name     string
+		param    dto.CheckInParam
 		mockFunc func()
 		wantErr  bool
+		errCode  codes.Code
 	}{
 		{
 			name: "Success",
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
 				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), entity.AttendancePeriodParam{
 					PeriodStatus: entity.PeriodStatusOpen,
 					QueryOption: query.Option{
 						IsActive: true,
 					},
 				}).Return(mockAttendancePeriod, nil)
+				mockUserOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockUserOfficeLocationParam).Return([]entity.UserOfficeLocation{}, nil, nil)
+				mockOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockOfficeLocationParam).Return([]entity.OfficeLocation{}, nil, nil)
 				mockAttendanceDom.EXPECT().Create(gomock.Any(), entity.AttendanceInputParam{
 					AttendancePeriodID: mockAttendancePeriod.ID,
 					UserID:             mockLoginUser.ID,
 					AttendanceDate:     null.DateFrom(mockTime),
+					AttendanceType:     entity.AttendanceTypeCodeOffice,
+					CheckInAt:          null.TimeFrom(mockTime),
 					CreatedAt:          null.TimeFrom(mockTime),
 					CreatedBy:          null.Int64From(mockLoginUser.ID),
 				}).Return(entity.Attendance{}, nil)
@@ -77,16 +165,23 @@ func Test_attendance_Create(t *testing.T) {
 			name: "Attendance Already Submitted",
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
 				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), entity.AttendancePeriodParam{
 					PeriodStatus: entity.PeriodStatusOpen,
 					QueryOption: query.Option{
 						IsActive: true,
 					},
 				}).Return(mockAttendancePeriod, nil)
+				mockUserOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockUserOfficeLocationParam).Return([]entity.UserOfficeLocation{}, nil, nil)
+				mockOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockOfficeLocationParam).Return([]entity.OfficeLocation{}, nil, nil)
 				mockAttendanceDom.EXPECT().Create(gomock.Any(), entity.AttendanceInputParam{
 					AttendancePeriodID: mockAttendancePeriod.ID,
 					UserID:             mockLoginUser.ID,
 					AttendanceDate:     null.DateFrom(mockTime),
+					AttendanceType:     entity.AttendanceTypeCodeOffice,
+					CheckInAt:          null.TimeFrom(mockTime),
 					CreatedAt:          null.TimeFrom(mockTime),
 					CreatedBy:          null.Int64From(mockLoginUser.ID),
 				}).Return(entity.Attendance{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, ""))
@@ -97,16 +192,23 @@ func Test_attendance_Create(t *testing.T) {
 			name: "Database Error When Submit Attendance",
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
 				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), entity.AttendancePeriodParam{
 					PeriodStatus: entity.PeriodStatusOpen,
 					QueryOption: query.Option{
 						IsActive: true,
 					},
 				}).Return(mockAttendancePeriod, nil)
+				mockUserOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockUserOfficeLocationParam).Return([]entity.UserOfficeLocation{}, nil, nil)
+				mockOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockOfficeLocationParam).Return([]entity.OfficeLocation{}, nil, nil)
 				mockAttendanceDom.EXPECT().Create(gomock.Any(), entity.AttendanceInputParam{
 					AttendancePeriodID: mockAttendancePeriod.ID,
 					UserID:             mockLoginUser.ID,
 					AttendanceDate:     null.DateFrom(mockTime),
+					AttendanceType:     entity.AttendanceTypeCodeOffice,
+					CheckInAt:          null.TimeFrom(mockTime),
 					CreatedAt:          null.TimeFrom(mockTime),
 					CreatedBy:          null.Int64From(mockLoginUser.ID),
 				}).Return(entity.Attendance{}, assert.AnError)
@@ -117,6 +219,9 @@ func Test_attendance_Create(t *testing.T) {
 			name: "No Open Attendance Period",
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
 				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), entity.AttendancePeriodParam{
 					PeriodStatus: entity.PeriodStatusOpen,
 					QueryOption: query.Option{
@@ -130,6 +235,9 @@ func Test_attendance_Create(t *testing.T) {
 			name: "Database Error When Get Attendance Period",
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
 				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), entity.AttendancePeriodParam{
 					PeriodStatus: entity.PeriodStatusOpen,
 					QueryOption: query.Option{
@@ -139,6 +247,252 @@ func Test_attendance_Create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Success On Holiday Of Another Region",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{
+					{HolidayDate: null.DateFrom(mockTime), Name: "Nyepi", Region: "BALI"},
+				}, nil, nil)
+				mockUserDom.EXPECT().Get(gomock.Any(), mockUserParam).Return(entity.User{ID: mockLoginUser.ID, Region: "JAKARTA"}, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), entity.AttendancePeriodParam{
+					PeriodStatus: entity.PeriodStatusOpen,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(mockAttendancePeriod, nil)
+				mockUserOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockUserOfficeLocationParam).Return([]entity.UserOfficeLocation{}, nil, nil)
+				mockOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockOfficeLocationParam).Return([]entity.OfficeLocation{}, nil, nil)
+				mockAttendanceDom.EXPECT().Create(gomock.Any(), entity.AttendanceInputParam{
+					AttendancePeriodID: mockAttendancePeriod.ID,
+					UserID:             mockLoginUser.ID,
+					AttendanceDate:     null.DateFrom(mockTime),
+					AttendanceType:     entity.AttendanceTypeCodeOffice,
+					CheckInAt:          null.TimeFrom(mockTime),
+					CreatedAt:          null.TimeFrom(mockTime),
+					CreatedBy:          null.Int64From(mockLoginUser.ID),
+				}).Return(entity.Attendance{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success Within Office Location",
+			param: dto.CheckInParam{
+				Latitude:  null.Float64From(-6.175800),
+				Longitude: null.Float64From(106.827153),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), entity.AttendancePeriodParam{
+					PeriodStatus: entity.PeriodStatusOpen,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(mockAttendancePeriod, nil)
+				mockUserOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockUserOfficeLocationParam).Return([]entity.UserOfficeLocation{
+					{UserID: mockLoginUser.ID, OfficeLocationID: mockOfficeLocation.ID},
+				}, nil, nil)
+				mockOfficeLocationDom.EXPECT().GetList(gomock.Any(), entity.OfficeLocationParam{
+					IDs: []int64{mockOfficeLocation.ID},
+					QueryOption: query.Option{
+						IsActive:     true,
+						DisableLimit: true,
+					},
+				}).Return([]entity.OfficeLocation{mockOfficeLocation}, nil, nil)
+				mockAttendanceDom.EXPECT().Create(gomock.Any(), entity.AttendanceInputParam{
+					AttendancePeriodID: mockAttendancePeriod.ID,
+					UserID:             mockLoginUser.ID,
+					AttendanceDate:     null.DateFrom(mockTime),
+					AttendanceType:     entity.AttendanceTypeCodeOffice,
+					CheckInAt:          null.TimeFrom(mockTime),
+					OfficeLocationID:   null.Int64From(mockOfficeLocation.ID),
+					CheckInLatitude:    null.Float64From(-6.175800),
+					CheckInLongitude:   null.Float64From(106.827153),
+					CreatedAt:          null.TimeFrom(mockTime),
+					CreatedBy:          null.Int64From(mockLoginUser.ID),
+				}).Return(entity.Attendance{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Outside Office Location Error",
+			param: dto.CheckInParam{
+				Latitude:  null.Float64From(-6.200000),
+				Longitude: null.Float64From(106.816666),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), gomock.Any()).Return(mockAttendancePeriod, nil)
+				mockUserOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockUserOfficeLocationParam).Return([]entity.UserOfficeLocation{}, nil, nil)
+				mockOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockOfficeLocationParam).Return([]entity.OfficeLocation{mockOfficeLocation}, nil, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Missing Coordinates With Office Locations Error",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), gomock.Any()).Return(mockAttendancePeriod, nil)
+				mockUserOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockUserOfficeLocationParam).Return([]entity.UserOfficeLocation{}, nil, nil)
+				mockOfficeLocationDom.EXPECT().GetList(gomock.Any(), mockOfficeLocationParam).Return([]entity.OfficeLocation{mockOfficeLocation}, nil, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Success Work From Home Without Geofence",
+			param: dto.CheckInParam{
+				AttendanceType: "wfh",
+				Latitude:       null.Float64From(-6.200000),
+				Longitude:      null.Float64From(106.816666),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), entity.AttendanceTypeParam{
+					Code: entity.AttendanceTypeCodeWorkFromHome,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeWorkFromHome}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{}, nil, nil)
+				mockAttendancePeriodDom.EXPECT().Get(gomock.Any(), gomock.Any()).Return(mockAttendancePeriod, nil)
+				mockAttendanceDom.EXPECT().Create(gomock.Any(), entity.AttendanceInputParam{
+					AttendancePeriodID: mockAttendancePeriod.ID,
+					UserID:             mockLoginUser.ID,
+					AttendanceDate:     null.DateFrom(mockTime),
+					AttendanceType:     entity.AttendanceTypeCodeWorkFromHome,
+					CheckInAt:          null.TimeFrom(mockTime),
+					CheckInLatitude:    null.Float64From(-6.200000),
+					CheckInLongitude:   null.Float64From(106.816666),
+					CreatedAt:          null.TimeFrom(mockTime),
+					CreatedBy:          null.Int64From(mockLoginUser.ID),
+				}).Return(entity.Attendance{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unavailable Attendance Type Error",
+			param: dto.CheckInParam{
+				AttendanceType: "SABBATICAL",
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), entity.AttendanceTypeParam{
+					Code: "SABBATICAL",
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(entity.AttendanceType{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Invalid Coordinates Error",
+			param: dto.CheckInParam{
+				Latitude:  null.Float64From(-91),
+				Longitude: null.Float64From(106.827153),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Public Holiday Error",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return([]entity.Holiday{
+					{HolidayDate: null.DateFrom(mockTime), Name: "Independence Day"},
+				}, nil, nil)
+				mockUserDom.EXPECT().Get(gomock.Any(), mockUserParam).Return(entity.User{ID: mockLoginUser.ID, Region: "JAKARTA"}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Database Error When Get Holidays",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), mockHolidayParam).Return(nil, nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Day Off Of Assigned Work Schedule Error",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{WorkScheduleID: 2}, nil)
+				mockWorkScheduleDom.EXPECT().Get(gomock.Any(), entity.WorkScheduleParam{ID: 2, QueryOption: query.Option{IsActive: true}}).Return(entity.WorkSchedule{ID: 2, Name: "Four Day Week", WorkDays: "MON,TUE,WED,THU"}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			// the assigned work schedule was deactivated, the four day week assigned before it is still in effect
+			name: "Day Off Of Work Schedule Before Deactivated One Error",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{WorkScheduleID: 3}, nil)
+				mockWorkScheduleDom.EXPECT().Get(gomock.Any(), entity.WorkScheduleParam{ID: 3, QueryOption: query.Option{IsActive: true}}).Return(entity.WorkSchedule{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+				mockUserWorkScheduleDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.UserWorkScheduleParam{
+						UserID:           mockLoginUser.ID,
+						EffectiveDateLTE: null.DateFrom(mockTime),
+						QueryOption: query.Option{
+							IsActive:     true,
+							DisableLimit: true,
+						},
+						PaginationParam: entity.PaginationParam{
+							SortBy: []string{"effective_date"},
+						},
+					},
+				).Return([]entity.UserWorkSchedule{
+					{WorkScheduleID: 2, EffectiveDate: null.DateFrom(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))},
+					{WorkScheduleID: 3, EffectiveDate: null.DateFrom(time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC))},
+				}, nil, nil)
+				mockWorkScheduleDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.WorkScheduleParam{
+						IDs: []int64{2, 3},
+						QueryOption: query.Option{
+							IsActive:     true,
+							DisableLimit: true,
+						},
+					},
+				).Return([]entity.WorkSchedule{{ID: 2, Name: "Four Day Week", WorkDays: "MON,TUE,WED,THU"}}, nil, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Database Error When Get Work Schedule",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockUserWorkScheduleParam).Return(entity.UserWorkSchedule{}, assert.AnError)
+			},
+			wantErr: true,
+		},
 		{
 			name: "Weekend Error",
 			mockFunc: func() {
@@ -146,6 +500,8 @@ func Test_attendance_Create(t *testing.T) {
 					return time.Date(2023, 10, 7, 10, 0, 0, 0, time.UTC) // A Saturday
 				}
 				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(auth.User{}, nil)
+				mockAttendanceTypeDom.EXPECT().Get(gomock.Any(), mockOfficeAttendanceTypeParam).Return(entity.AttendanceType{Code: entity.AttendanceTypeCodeOffice}, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), gomock.Any()).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
 			},
 			wantErr: true,
 		},
@@ -162,9 +518,224 @@ func Test_attendance_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFunc()
 
-			err := uc.Create(context.Background())
+			_, err := uc.CheckIn(context.Background(), tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendance.CheckIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
+
+func Test_attendance_CheckOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendanceDom := attendance_dom.NewMockInterface(ctrl)
+	mockWorkScheduleDom := mock_work_schedule.NewMockInterface(ctrl)
+	mockUserWorkScheduleDom := mock_user_work_schedule.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Attendance:       mockAttendanceDom,
+		WorkSchedule:     mockWorkScheduleDom,
+		UserWorkSchedule: mockUserWorkScheduleDom,
+		Auth:             mockAuth,
+	})
+
+	mockTime := time.Date(2023, 10, 6, 17, 45, 30, 0, time.UTC)
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:    1,
+		Name:  "Test User",
+		Email: "test@example.com",
+	}
+
+	mockAttendanceParam := entity.AttendanceParam{
+		UserID:            mockLoginUser.ID,
+		AttendanceDateGTE: null.DateFrom(time.Date(2023, 10, 5, 17, 45, 30, 0, time.UTC)),
+		AttendanceDateLTE: null.DateFrom(mockTime),
+		BypassCache:       true,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-attendance_date"},
+		},
+	}
+
+	mockAttendance := entity.Attendance{
+		ID:             3,
+		UserID:         mockLoginUser.ID,
+		AttendanceDate: null.DateFrom(mockTime),
+		CheckInAt:      null.TimeFrom(time.Date(2023, 10, 6, 8, 30, 0, 0, time.UTC)),
+	}
+
+	// checked in for the night shift yesterday, checking out after midnight
+	mockAfterMidnight := time.Date(2023, 10, 6, 6, 10, 0, 0, time.UTC)
+	mockAfterMidnightParam := mockAttendanceParam
+	mockAfterMidnightParam.AttendanceDateGTE = null.DateFrom(mockAfterMidnight.AddDate(0, 0, -1))
+	mockAfterMidnightParam.AttendanceDateLTE = null.DateFrom(mockAfterMidnight)
+	mockNightAttendance := entity.Attendance{
+		ID:             4,
+		UserID:         mockLoginUser.ID,
+		AttendanceDate: null.DateFrom(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)),
+		CheckInAt:      null.TimeFrom(time.Date(2023, 10, 5, 22, 0, 0, 0, time.UTC)),
+	}
+	mockYesterdayWorkScheduleParam := entity.UserWorkScheduleParam{
+		UserID:           mockLoginUser.ID,
+		EffectiveDateLTE: mockNightAttendance.AttendanceDate,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-effective_date"},
+		},
+	}
+	mockNightWorkSchedule := entity.WorkSchedule{ID: 2, Name: "Night Warehouse", WorkDays: "MON,TUE,WED,THU,FRI", ShiftStart: "22:00", ShiftEnd: "06:00"}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		mockFunc func()
+		want     dto.AttendanceRecord
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			now:  mockTime,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), mockAttendanceParam).Return([]entity.Attendance{mockAttendance}, nil, nil)
+				mockAttendanceDom.EXPECT().Update(
+					gomock.Any(),
+					entity.AttendanceUpdateParam{
+						CheckOutAt:    null.TimeFrom(mockTime),
+						WorkedMinutes: null.Int64From(555),
+						UpdatedAt:     null.TimeFrom(mockTime),
+						UpdatedBy:     null.Int64From(mockLoginUser.ID),
+					},
+					entity.AttendanceParam{ID: mockAttendance.ID},
+				).Return(nil)
+			},
+			want: dto.AttendanceRecord{
+				ID:             mockAttendance.ID,
+				AttendanceDate: mockAttendance.AttendanceDate,
+				CheckInAt:      mockAttendance.CheckInAt,
+				CheckOutAt:     null.TimeFrom(mockTime),
+				WorkedHours:    9.25,
+			},
+		},
+		{
+			name: "Success After Midnight On Night Shift",
+			now:  mockAfterMidnight,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), mockAfterMidnightParam).Return([]entity.Attendance{mockNightAttendance}, nil, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockYesterdayWorkScheduleParam).Return(entity.UserWorkSchedule{WorkScheduleID: 2}, nil)
+				mockWorkScheduleDom.EXPECT().Get(gomock.Any(), entity.WorkScheduleParam{ID: 2, QueryOption: query.Option{IsActive: true}}).Return(mockNightWorkSchedule, nil)
+				mockAttendanceDom.EXPECT().Update(
+					gomock.Any(),
+					entity.AttendanceUpdateParam{
+						CheckOutAt:    null.TimeFrom(mockAfterMidnight),
+						WorkedMinutes: null.Int64From(490),
+						UpdatedAt:     null.TimeFrom(mockAfterMidnight),
+						UpdatedBy:     null.Int64From(mockLoginUser.ID),
+					},
+					entity.AttendanceParam{ID: mockNightAttendance.ID},
+				).Return(nil)
+			},
+			want: dto.AttendanceRecord{
+				ID:             mockNightAttendance.ID,
+				AttendanceDate: mockNightAttendance.AttendanceDate,
+				CheckInAt:      mockNightAttendance.CheckInAt,
+				CheckOutAt:     null.TimeFrom(mockAfterMidnight),
+				WorkedHours:    490.0 / 60,
+			},
+		},
+		{
+			name: "Open Attendance Yesterday On Day Shift",
+			now:  mockAfterMidnight,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), mockAfterMidnightParam).Return([]entity.Attendance{mockNightAttendance}, nil, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(gomock.Any(), mockYesterdayWorkScheduleParam).Return(entity.UserWorkSchedule{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name: "No Check In Today",
+			now:  mockTime,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), mockAttendanceParam).Return([]entity.Attendance{}, nil, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name: "Already Checked Out",
+			now:  mockTime,
+			mockFunc: func() {
+				checkedOut := mockAttendance
+				checkedOut.CheckOutAt = null.TimeFrom(time.Date(2023, 10, 6, 17, 0, 0, 0, time.UTC))
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), mockAttendanceParam).Return([]entity.Attendance{checkedOut, mockNightAttendance}, nil, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name: "Database Error When Get Attendances",
+			now:  mockTime,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), mockAttendanceParam).Return(nil, nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Database Error When Check Out",
+			now:  mockTime,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), mockAttendanceParam).Return([]entity.Attendance{mockAttendance}, nil, nil)
+				mockAttendanceDom.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Now = func() time.Time {
+				return tt.now
+			}
+			tt.mockFunc()
+
+			got, err := uc.CheckOut(context.Background())
 			if (err != nil) != tt.wantErr {
-				t.Errorf("attendance.Create() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("attendance.CheckOut() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errCode != 0 {
+					assert.Equal(t, tt.errCode, errors.GetCode(err))
+				}
+			} else {
+				assert.Equal(t, tt.want, got)
 			}
 		})
 	}
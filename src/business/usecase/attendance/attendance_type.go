@@ -0,0 +1,86 @@
+package attendance
+
+import (
+	"context"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// GetAttendanceTypes returns the attendance types an attendance can be submitted with.
+func (a *attendance) GetAttendanceTypes(ctx context.Context) ([]entity.AttendanceType, error) {
+	attendanceTypes, _, err := a.attendanceTypeDom.GetList(
+		ctx,
+		entity.AttendanceTypeParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"id"},
+			},
+		},
+	)
+	if err != nil {
+		return attendanceTypes, err
+	}
+
+	return attendanceTypes, nil
+}
+
+// UpdateAttendanceType changes the pay treatment of an attendance type, it applies to every payroll run afterwards.
+func (a *attendance) UpdateAttendanceType(ctx context.Context, attendanceTypeID int64, inputParam dto.UpdateAttendanceTypeParam) error {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	err = a.attendanceTypeDom.Update(
+		ctx,
+		inputParam.ToAttendanceTypeUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+		entity.AttendanceTypeParam{
+			ID: attendanceTypeID,
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLNoRowsAffected:
+			return errors.NewWithCode(codes.CodeNotFound, "attendance type not found")
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkAttendanceType returns an error unless the code is of an active attendance type.
+func (a *attendance) checkAttendanceType(ctx context.Context, code string) error {
+	_, err := a.attendanceTypeDom.Get(
+		ctx,
+		entity.AttendanceTypeParam{
+			Code: code,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeBadRequest, "attendance type %s is not available", code)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package attendance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_attendance_type "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_type"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_attendance_UpdateAttendanceType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendanceTypeDom := mock_attendance_type.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:           mockAuth,
+		AttendanceType: mockAttendanceTypeDom,
+	})
+
+	mockTime := time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC)
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+	mockLoginUser := auth.User{
+		ID:    1,
+		Name:  "Admin",
+		Email: "admin@example.com",
+	}
+
+	mockParam := dto.UpdateAttendanceTypeParam{
+		DailyAllowance:   entity.NullMoneyFrom(entity.NewMoney(0)),
+		MaxDaysPerPeriod: null.Int64From(8),
+	}
+
+	mockUpdateParam := entity.AttendanceTypeUpdateParam{
+		DailyAllowance:   entity.NullMoneyFrom(entity.NewMoney(0)),
+		MaxDaysPerPeriod: null.Int64From(8),
+		UpdatedAt:        null.TimeFrom(mockTime),
+		UpdatedBy:        null.Int64From(mockLoginUser.ID),
+	}
+
+	tests := []struct {
+		name     string
+		param    dto.UpdateAttendanceTypeParam
+		mockFunc func()
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Update(ctx, mockUpdateParam, entity.AttendanceTypeParam{ID: 2}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Failed Auth",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(auth.User{}, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Negative Max Days Error",
+			param: dto.UpdateAttendanceTypeParam{MaxDaysPerPeriod: null.Int64From(-1)},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Attendance Type Not Found",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendanceTypeDom.EXPECT().Update(ctx, mockUpdateParam, entity.AttendanceTypeParam{ID: 2}).Return(errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.UpdateAttendanceType(ctx, 2, tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendance.UpdateAttendanceType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
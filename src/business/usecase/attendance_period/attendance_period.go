@@ -2,6 +2,7 @@ package attendance_period
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/reyhanmichiels/go-pkg/v2/auth"
@@ -12,14 +13,32 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/parser"
 	"github.com/reyhanmichiels/go-pkg/v2/query"
 	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/allowance_type"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_period"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_type"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/exchange_rate"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/holiday"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/loan"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/loan_repayment"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/overtime"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/pay_component"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_adjustment"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_preview"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_run"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_run_employee"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payslip"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payslip_currency_total"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/payslip_detail"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/reimbursement"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/tax_bracket"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/transactor"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_allowance"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_salary"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain/year_to_date_total"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 	"github.com/reyhanmichies/employee-payroll-service/src/handler/pubsub/publisher"
@@ -27,63 +46,135 @@ import (
 
 var Now = time.Now
 
+// payrollPreviewSyncLimit is the largest headcount previewed within the request,
+// a bigger payroll is previewed in the background and stored as a snapshot.
+const payrollPreviewSyncLimit = 200
+
 type Interface interface {
 	Create(ctx context.Context, inputParam dto.CreateAttendancePeriodParam) (entity.AttendancePeriod, error)
 	GetCurrentAttendancePeriod(ctx context.Context) (entity.AttendancePeriod, error)
 	GeneratePayroll(ctx context.Context, attendancePeriodID int64) error
 	GeneratePayslip(ctx context.Context, attendancePeriodID int64) (dto.Payslip, error)
 	GeneratePayslipSummary(ctx context.Context, attendancePeriodID int64) (dto.PayslipSummary, error)
+	RerunPayroll(ctx context.Context, attendancePeriodID int64, param dto.RerunPayrollParam) error
+	GetPayrollRuns(ctx context.Context, attendancePeriodID int64) ([]entity.PayrollRun, error)
+	GeneratePayrollRunPayslipSummary(ctx context.Context, payrollRunID int64) (dto.PayslipSummary, error)
+	ResumePayrollRun(ctx context.Context, payrollRunID int64) error
+	GetPayrollRunEmployees(ctx context.Context, payrollRunID int64) ([]entity.PayrollRunEmployee, error)
+	GetPayrollStatus(ctx context.Context, attendancePeriodID int64) (dto.PayrollStatus, error)
+	PreviewPayroll(ctx context.Context, attendancePeriodID int64) (dto.PayrollPreview, error)
+	GetPayrollPreview(ctx context.Context, payrollPreviewID int64) (dto.PayrollPreview, error)
 
 	PubSubGeneratePayroll(ctx context.Context, message entity.PubSubMessage) error
+	PubSubPreviewPayroll(ctx context.Context, message entity.PubSubMessage) error
 
 	ValidateAttendancePeriodScheduler(ctx context.Context) error
 }
 
 type attendancePeriod struct {
-	auth                auth.Interface
-	attendancePeriodDom attendance_period.Interface
-	publisher           publisher.Interface
-	transactor          transactor.Interface
-	json                parser.JSONInterface
-	log                 log.Interface
-	payslipDom          payslip.Interface
-	payslipDetailDom    payslip_detail.Interface
-	userDom             user.Interface
-	overtimeDom         overtime.Interface
-	reimbursementDom    reimbursement.Interface
-	attendanceDom       attendance.Interface
+	auth                    auth.Interface
+	attendancePeriodDom     attendance_period.Interface
+	publisher               publisher.Interface
+	transactor              transactor.Interface
+	json                    parser.JSONInterface
+	log                     log.Interface
+	payslipDom              payslip.Interface
+	payslipDetailDom        payslip_detail.Interface
+	userDom                 user.Interface
+	overtimeDom             overtime.Interface
+	reimbursementDom        reimbursement.Interface
+	attendanceDom           attendance.Interface
+	payComponentDom         pay_component.Interface
+	taxBracketDom           tax_bracket.Interface
+	payrollPreviewDom       payroll_preview.Interface
+	payrollRunDom           payroll_run.Interface
+	payrollRunEmployeeDom   payroll_run_employee.Interface
+	exchangeRateDom         exchange_rate.Interface
+	payslipCurrencyTotalDom payslip_currency_total.Interface
+	allowanceTypeDom        allowance_type.Interface
+	userAllowanceDom        user_allowance.Interface
+	payrollAdjustmentDom    payroll_adjustment.Interface
+	loanDom                 loan.Interface
+	loanRepaymentDom        loan_repayment.Interface
+	holidayDom              holiday.Interface
+	workScheduleDom         work_schedule.Interface
+	userWorkScheduleDom     user_work_schedule.Interface
+	userSalaryDom           user_salary.Interface
+	yearToDateTotalDom      year_to_date_total.Interface
+	attendanceTypeDom       attendance_type.Interface
+	payrollRules            map[string]payrollRule
 }
 
 type InitParam struct {
-	Auth             auth.Interface
-	AttendancePeriod attendance_period.Interface
-	Publisher        publisher.Interface
-	Transactor       transactor.Interface
-	Json             parser.JSONInterface
-	Log              log.Interface
-	Payslip          payslip.Interface
-	PayslipDetail    payslip_detail.Interface
-	User             user.Interface
-	Overtime         overtime.Interface
-	Reimbursement    reimbursement.Interface
-	Attendance       attendance.Interface
+	Auth                 auth.Interface
+	AttendancePeriod     attendance_period.Interface
+	Publisher            publisher.Interface
+	Transactor           transactor.Interface
+	Json                 parser.JSONInterface
+	Log                  log.Interface
+	Payslip              payslip.Interface
+	PayslipDetail        payslip_detail.Interface
+	User                 user.Interface
+	Overtime             overtime.Interface
+	Reimbursement        reimbursement.Interface
+	Attendance           attendance.Interface
+	PayComponent         pay_component.Interface
+	TaxBracket           tax_bracket.Interface
+	PayrollPreview       payroll_preview.Interface
+	PayrollRun           payroll_run.Interface
+	PayrollRunEmployee   payroll_run_employee.Interface
+	ExchangeRate         exchange_rate.Interface
+	PayslipCurrencyTotal payslip_currency_total.Interface
+	AllowanceType        allowance_type.Interface
+	UserAllowance        user_allowance.Interface
+	PayrollAdjustment    payroll_adjustment.Interface
+	Loan                 loan.Interface
+	LoanRepayment        loan_repayment.Interface
+	Holiday              holiday.Interface
+	WorkSchedule         work_schedule.Interface
+	UserWorkSchedule     user_work_schedule.Interface
+	UserSalary           user_salary.Interface
+	YearToDateTotal      year_to_date_total.Interface
+	AttendanceType       attendance_type.Interface
 }
 
 func Init(param InitParam) Interface {
-	return &attendancePeriod{
-		auth:                param.Auth,
-		attendancePeriodDom: param.AttendancePeriod,
-		publisher:           param.Publisher,
-		transactor:          param.Transactor,
-		json:                param.Json,
-		log:                 param.Log,
-		payslipDom:          param.Payslip,
-		payslipDetailDom:    param.PayslipDetail,
-		userDom:             param.User,
-		overtimeDom:         param.Overtime,
-		reimbursementDom:    param.Reimbursement,
-		attendanceDom:       param.Attendance,
+	a := &attendancePeriod{
+		auth:                    param.Auth,
+		attendancePeriodDom:     param.AttendancePeriod,
+		publisher:               param.Publisher,
+		transactor:              param.Transactor,
+		json:                    param.Json,
+		log:                     param.Log,
+		payslipDom:              param.Payslip,
+		payslipDetailDom:        param.PayslipDetail,
+		userDom:                 param.User,
+		overtimeDom:             param.Overtime,
+		reimbursementDom:        param.Reimbursement,
+		attendanceDom:           param.Attendance,
+		payComponentDom:         param.PayComponent,
+		taxBracketDom:           param.TaxBracket,
+		payrollPreviewDom:       param.PayrollPreview,
+		payrollRunDom:           param.PayrollRun,
+		payrollRunEmployeeDom:   param.PayrollRunEmployee,
+		exchangeRateDom:         param.ExchangeRate,
+		payslipCurrencyTotalDom: param.PayslipCurrencyTotal,
+		allowanceTypeDom:        param.AllowanceType,
+		userAllowanceDom:        param.UserAllowance,
+		payrollAdjustmentDom:    param.PayrollAdjustment,
+		loanDom:                 param.Loan,
+		loanRepaymentDom:        param.LoanRepayment,
+		holidayDom:              param.Holiday,
+		workScheduleDom:         param.WorkSchedule,
+		userWorkScheduleDom:     param.UserWorkSchedule,
+		userSalaryDom:           param.UserSalary,
+		yearToDateTotalDom:      param.YearToDateTotal,
+		attendanceTypeDom:       param.AttendanceType,
 	}
+
+	a.payrollRules = a.registerPayrollRules()
+
+	return a
 }
 
 func (a *attendancePeriod) Create(
@@ -183,13 +274,17 @@ func (a *attendancePeriod) GeneratePayroll(
 		return errors.NewWithCode(codes.CodeBadRequest, "attendance period is still open and cannot be processed")
 	case entity.PeriodStatusUpcoming:
 		return errors.NewWithCode(codes.CodeBadRequest, "attendance period is upcoming and cannot be processed")
+	case entity.PeriodStatusProcessError:
+		// the payslips, loan repayments and year to date totals of the failed run are already written, a new
+		// version would pay and count those employees twice
+		return errors.NewWithCode(codes.CodeConflict, "payroll of the attendance period failed, resume its failed payroll run instead")
 	}
 
 	return a.transactor.Execute(ctx, "txGeneratePayroll", sql.TxOptions{}, func(ctx context.Context) error {
 		err = a.attendancePeriodDom.Update(
 			ctx,
 			entity.AttendancePeriodUpdateParam{
-				PeriodStatus: entity.PeriodStatusProcessed,
+				PeriodStatus: entity.PeriodStatusProcessing,
 				UpdatedAt:    null.TimeFrom(Now()),
 				UpdatedBy:    null.Int64From(loginUser.ID),
 			},
@@ -199,12 +294,18 @@ func (a *attendancePeriod) GeneratePayroll(
 			return err
 		}
 
+		payrollRun, err := a.createNextPayrollRun(ctx, attendancePeriod.ID, loginUser.ID)
+		if err != nil {
+			return err
+		}
+
 		err = a.publisher.Publish(
 			ctx,
 			entity.ExchangePayrollEvent,
 			entity.RoutingKeyPayrollCalculate,
 			dto.PubSubGeneratePayrollMessage{
 				AttendancePeriod: attendancePeriod,
+				PayrollRun:       payrollRun,
 				LoginUser:        loginUser,
 			},
 		)
@@ -216,32 +317,24 @@ func (a *attendancePeriod) GeneratePayroll(
 	})
 }
 
-func (a *attendancePeriod) GeneratePayslip(ctx context.Context, attendancePeriodID int64) (dto.Payslip, error) {
+func (a *attendancePeriod) RerunPayroll(
+	ctx context.Context,
+	attendancePeriodID int64,
+	param dto.RerunPayrollParam,
+) error {
 	loginUser, err := a.auth.GetUserAuthInfo(ctx)
 	if err != nil {
-		return dto.Payslip{}, err
+		return err
 	}
 
-	attendancePeriod, err := a.attendancePeriodDom.Get(ctx, entity.AttendancePeriodParam{
-		ID: attendancePeriodID,
-		QueryOption: query.Option{
-			IsActive: true,
-		},
-	})
-	if err != nil {
-		switch errors.GetCode(err) {
-		case codes.CodeSQLRecordDoesNotExist:
-			return dto.Payslip{}, errors.NewWithCode(codes.CodeNotFound, "attendance period not found")
-		default:
-			return dto.Payslip{}, err
-		}
+	if err := param.Validate(); err != nil {
+		return err
 	}
 
-	payslip, err := a.payslipDom.Get(
+	attendancePeriod, err := a.attendancePeriodDom.Get(
 		ctx,
-		entity.PayslipParam{
-			AttendancePeriodID: attendancePeriodID,
-			UserID:             loginUser.ID,
+		entity.AttendancePeriodParam{
+			ID: attendancePeriodID,
 			QueryOption: query.Option{
 				IsActive: true,
 			},
@@ -250,16 +343,97 @@ func (a *attendancePeriod) GeneratePayslip(ctx context.Context, attendancePeriod
 	if err != nil {
 		switch errors.GetCode(err) {
 		case codes.CodeSQLRecordDoesNotExist:
-			return dto.Payslip{}, errors.NewWithCode(codes.CodeNotFound, "payslip not found")
+			return errors.NewWithCode(codes.CodeNotFound, "attendance period not found")
 		default:
-			return dto.Payslip{}, err
+			return err
 		}
 	}
 
-	details, _, err := a.payslipDetailDom.GetList(
+	if attendancePeriod.PeriodStatus == entity.PeriodStatusProcessing {
+		return errors.NewWithCode(codes.CodeConflict, "attendance period is currently being processed")
+	} else if attendancePeriod.PeriodStatus != entity.PeriodStatusProcessed {
+		return errors.NewWithCode(codes.CodeBadRequest, "only a processed payroll can be re-run")
+	}
+
+	currentPayrollRun, err := a.getCurrentPayrollRun(ctx, attendancePeriodID)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeConflict, "payroll of the attendance period is not finished yet")
+		default:
+			return err
+		}
+	}
+
+	return a.transactor.Execute(ctx, "txRerunPayroll", sql.TxOptions{}, func(ctx context.Context) error {
+		// the period is no longer settled until the next version has written every payslip
+		err := a.attendancePeriodDom.Update(
+			ctx,
+			entity.AttendancePeriodUpdateParam{
+				PeriodStatus: entity.PeriodStatusProcessing,
+				UpdatedAt:    null.TimeFrom(Now()),
+				UpdatedBy:    null.Int64From(loginUser.ID),
+			},
+			entity.AttendancePeriodParam{
+				ID: attendancePeriod.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		err = a.payrollRunDom.Update(
+			ctx,
+			param.ToPayrollRunUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+			entity.PayrollRunParam{
+				ID: currentPayrollRun.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		err = a.reverseLoanRepayments(ctx, currentPayrollRun.ID, loginUser.ID)
+		if err != nil {
+			return err
+		}
+
+		err = a.reverseYearToDateTotals(ctx, currentPayrollRun.ID, attendancePeriod.PayrollYear(), loginUser.ID)
+		if err != nil {
+			return err
+		}
+
+		payrollRun, err := a.createNextPayrollRun(ctx, attendancePeriod.ID, loginUser.ID)
+		if err != nil {
+			return err
+		}
+
+		err = a.publisher.Publish(
+			ctx,
+			entity.ExchangePayrollEvent,
+			entity.RoutingKeyPayrollCalculate,
+			dto.PubSubGeneratePayrollMessage{
+				AttendancePeriod: attendancePeriod,
+				PayrollRun:       payrollRun,
+				LoginUser:        loginUser,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// reverseYearToDateTotals takes the payslips of a voided payroll run off the year to date totals of their
+// employees, the run that replaces it counts its own payslips again.
+func (a *attendancePeriod) reverseYearToDateTotals(ctx context.Context, payrollRunID, year, userID int64) error {
+	payslips, _, err := a.payslipDom.GetList(
 		ctx,
-		entity.PayslipDetailParam{
-			PayslipID: payslip.ID,
+		entity.PayslipParam{
+			PayrollRunID: payrollRunID,
+			BypassCache:  true,
 			QueryOption: query.Option{
 				IsActive:     true,
 				DisableLimit: true,
@@ -267,92 +441,224 @@ func (a *attendancePeriod) GeneratePayslip(ctx context.Context, attendancePeriod
 		},
 	)
 	if err != nil {
-		return dto.Payslip{}, err
+		return err
 	}
 
-	res := dto.Payslip{
-		StartDate:          attendancePeriod.StartDate,
-		EndDate:            attendancePeriod.EndDate,
-		BasePayComponent:   payslip.BasePayComponent,
-		OvertimeComponent:  payslip.OvertimeComponent,
-		ReimburseComponent: payslip.ReimbursementComponent,
-		TotalTakeHomePay:   payslip.TotalTakeHomePay,
+	for _, payslip := range payslips {
+		err := a.updateYearToDateTotal(ctx, payslip.UserID, year, null.TimeFrom(Now()), null.Int64From(userID), func(total *entity.YearToDateTotal) {
+			total.Subtract(payslip)
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	resDetails := make([]dto.PayslipDetail, 0, len(details))
-	for _, detail := range details {
-		resDetails = append(resDetails, dto.PayslipDetail{
-			Type:        detail.ItemType,
-			Description: detail.Description,
-			Amount:      detail.Amount,
-		})
+	return nil
+}
+
+// reverseLoanRepayments adds the loan instalments withheld by a voided payroll run back to the balance
+// of their loans, so the run that replaces it withholds them again.
+func (a *attendancePeriod) reverseLoanRepayments(ctx context.Context, payrollRunID, userID int64) error {
+	repaymentParam := entity.LoanRepaymentParam{
+		PayrollRunID:    payrollRunID,
+		RepaymentStatus: entity.LoanRepaymentStatusPaid,
+		BypassCache:     true,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
 	}
 
-	res.Details = resDetails
+	repayments, _, err := a.loanRepaymentDom.GetList(ctx, repaymentParam)
+	if err != nil {
+		return err
+	}
 
-	return res, nil
+	if len(repayments) == 0 {
+		return nil
+	}
+
+	for _, repayment := range repayments {
+		loan, err := a.loanDom.Get(
+			ctx,
+			entity.LoanParam{
+				ID:          repayment.LoanID,
+				BypassCache: true,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		err = a.loanDom.Update(
+			ctx,
+			entity.LoanUpdateParam{
+				RemainingBalance: entity.NullMoneyFrom(loan.RemainingBalance + repayment.Amount),
+				LoanStatus:       entity.LoanStatusActive,
+				UpdatedAt:        null.TimeFrom(Now()),
+				UpdatedBy:        null.Int64From(userID),
+			},
+			entity.LoanParam{
+				ID: loan.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return a.loanRepaymentDom.Update(
+		ctx,
+		entity.LoanRepaymentUpdateParam{
+			RepaymentStatus: entity.LoanRepaymentStatusReversed,
+			UpdatedAt:       null.TimeFrom(Now()),
+			UpdatedBy:       null.Int64From(userID),
+		},
+		entity.LoanRepaymentParam{
+			PayrollRunID:    payrollRunID,
+			RepaymentStatus: entity.LoanRepaymentStatusPaid,
+		},
+	)
 }
 
-func (a *attendancePeriod) GeneratePayslipSummary(ctx context.Context, attendancePeriodID int64) (dto.PayslipSummary, error) {
-	users, _, err := a.userDom.GetList(
+func (a *attendancePeriod) GetPayrollRuns(ctx context.Context, attendancePeriodID int64) ([]entity.PayrollRun, error) {
+	payrollRuns, _, err := a.payrollRunDom.GetList(
 		ctx,
-		entity.UserParam{
-			RoleID: entity.RoleIDUser,
+		entity.PayrollRunParam{
+			AttendancePeriodID: attendancePeriodID,
 			QueryOption: query.Option{
 				IsActive:     true,
 				DisableLimit: true,
 			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-version"},
+			},
 		},
 	)
 	if err != nil {
-		return dto.PayslipSummary{}, err
+		return payrollRuns, err
 	}
 
-	userIDToPayslip, err := a.getUserIDToPayslip(ctx, attendancePeriodID)
-	if err != nil {
-		return dto.PayslipSummary{}, err
+	if len(payrollRuns) == 0 {
+		return payrollRuns, errors.NewWithCode(codes.CodeNotFound, "no payroll run found for the given attendance period")
 	}
 
-	if len(userIDToPayslip) == 0 {
-		return dto.PayslipSummary{}, errors.NewWithCode(codes.CodeNotFound, "no payslips found for the given attendance period")
+	return payrollRuns, nil
+}
+
+func (a *attendancePeriod) ResumePayrollRun(ctx context.Context, payrollRunID int64) error {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
 	}
 
-	totalTakeHomePay := 0.0
-	employeePayouts := []dto.EmployeePayout{}
-	for _, user := range users {
-		employeePayouts = append(
-			employeePayouts,
-			dto.EmployeePayout{
-				ID:          user.ID,
-				Name:        user.Name,
-				TakeHomePay: userIDToPayslip[user.ID].TotalTakeHomePay,
+	payrollRun, err := a.payrollRunDom.Get(
+		ctx,
+		entity.PayrollRunParam{
+			ID:          payrollRunID,
+			BypassCache: true,
+			QueryOption: query.Option{
+				IsActive: true,
 			},
-		)
-		totalTakeHomePay += userIDToPayslip[user.ID].TotalTakeHomePay
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeNotFound, "payroll run not found")
+		default:
+			return err
+		}
 	}
 
-	res := dto.PayslipSummary{
-		TotalEmployeeTakeHomePay: totalTakeHomePay,
-		TotalEmployee:            int64(len(users)),
-		EmployeePayouts:          employeePayouts,
+	switch payrollRun.RunStatus {
+	case entity.PayrollRunStatusProcessing:
+		return errors.NewWithCode(codes.CodeConflict, "payroll run is currently being processed")
+	case entity.PayrollRunStatusProcessed:
+		return errors.NewWithCode(codes.CodeBadRequest, "payroll run has already been processed")
+	case entity.PayrollRunStatusVoided:
+		return errors.NewWithCode(codes.CodeBadRequest, "payroll run has been voided and cannot be resumed")
 	}
 
-	return res, nil
-}
+	latestPayrollRun, err := a.getLatestPayrollRun(ctx, payrollRun.AttendancePeriodID)
+	if err != nil {
+		return err
+	}
 
-func (a *attendancePeriod) getUserIDToPayslip(
-	ctx context.Context,
-	attendancePeriodID int64,
-) (
-	map[int64]entity.Payslip,
-	error,
-) {
-	userIDToPayslips := make(map[int64]entity.Payslip)
+	if latestPayrollRun.ID != payrollRun.ID {
+		return errors.NewWithCode(codes.CodeBadRequest, "payroll run has been superseded by version %d and cannot be resumed", latestPayrollRun.Version)
+	}
 
-	payslips, _, err := a.payslipDom.GetList(
+	attendancePeriodParam := entity.AttendancePeriodParam{
+		ID: payrollRun.AttendancePeriodID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	attendancePeriod, err := a.attendancePeriodDom.Get(ctx, attendancePeriodParam)
+	if err != nil {
+		return err
+	}
+
+	return a.transactor.Execute(ctx, "txResumePayrollRun", sql.TxOptions{}, func(ctx context.Context) error {
+		currentTime := null.TimeFrom(Now())
+
+		err := a.attendancePeriodDom.Update(
+			ctx,
+			entity.AttendancePeriodUpdateParam{
+				PeriodStatus: entity.PeriodStatusProcessing,
+				UpdatedAt:    currentTime,
+				UpdatedBy:    null.Int64From(loginUser.ID),
+			},
+			attendancePeriodParam,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = a.payrollRunDom.Update(
+			ctx,
+			entity.PayrollRunUpdateParam{
+				RunStatus:    entity.PayrollRunStatusProcessing,
+				ProcessError: null.String{SqlNull: true},
+				UpdatedAt:    currentTime,
+				UpdatedBy:    null.Int64From(loginUser.ID),
+			},
+			entity.PayrollRunParam{
+				ID: payrollRun.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		payrollRun.RunStatus = entity.PayrollRunStatusProcessing
+		payrollRun.ProcessError = null.String{}
+
+		err = a.publisher.Publish(
+			ctx,
+			entity.ExchangePayrollEvent,
+			entity.RoutingKeyPayrollCalculate,
+			dto.PubSubGeneratePayrollMessage{
+				AttendancePeriod: attendancePeriod,
+				PayrollRun:       payrollRun,
+				LoginUser:        loginUser,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (a *attendancePeriod) GetPayrollRunEmployees(ctx context.Context, payrollRunID int64) ([]entity.PayrollRunEmployee, error) {
+	payrollRunEmployees, _, err := a.payrollRunEmployeeDom.GetList(
 		ctx,
-		entity.PayslipParam{
-			AttendancePeriodID: attendancePeriodID,
+		entity.PayrollRunEmployeeParam{
+			PayrollRunID: payrollRunID,
 			QueryOption: query.Option{
 				IsActive:     true,
 				DisableLimit: true,
@@ -360,16 +666,758 @@ func (a *attendancePeriod) getUserIDToPayslip(
 		},
 	)
 	if err != nil {
-		return userIDToPayslips, err
+		return payrollRunEmployees, err
 	}
 
-	for _, payslip := range payslips {
-		userIDToPayslips[payslip.UserID] = payslip
+	if len(payrollRunEmployees) == 0 {
+		return payrollRunEmployees, errors.NewWithCode(codes.CodeNotFound, "no employee found for the given payroll run")
+	}
+
+	return payrollRunEmployees, nil
+}
+
+func (a *attendancePeriod) GetPayrollStatus(ctx context.Context, attendancePeriodID int64) (dto.PayrollStatus, error) {
+	var payrollStatus dto.PayrollStatus
+
+	attendancePeriod, err := a.attendancePeriodDom.Get(
+		ctx,
+		entity.AttendancePeriodParam{
+			ID:          attendancePeriodID,
+			BypassCache: true,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return payrollStatus, errors.NewWithCode(codes.CodeNotFound, "attendance period not found")
+		default:
+			return payrollStatus, err
+		}
+	}
+
+	payrollRun, err := a.getLatestPayrollRun(ctx, attendancePeriod.ID)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return payrollStatus, errors.NewWithCode(codes.CodeNotFound, "payroll of the attendance period has not been run")
+		default:
+			return payrollStatus, err
+		}
+	}
+
+	failures, _, err := a.payrollRunEmployeeDom.GetList(
+		ctx,
+		entity.PayrollRunEmployeeParam{
+			PayrollRunID:   payrollRun.ID,
+			EmployeeStatus: entity.PayrollRunEmployeeStatusFailed,
+			BypassCache:    true,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return payrollStatus, err
+	}
+
+	return a.toPayrollStatus(attendancePeriod, payrollRun, failures), nil
+}
+
+// getLatestPayrollRun returns the highest version of the payroll of the attendance period, whatever its status.
+func (a *attendancePeriod) getLatestPayrollRun(ctx context.Context, attendancePeriodID int64) (entity.PayrollRun, error) {
+	return a.payrollRunDom.Get(
+		ctx,
+		entity.PayrollRunParam{
+			AttendancePeriodID: attendancePeriodID,
+			BypassCache:        true,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-version"},
+			},
+		},
+	)
+}
+
+// createNextPayrollRun starts the next version of the payroll of the attendance period.
+func (a *attendancePeriod) createNextPayrollRun(ctx context.Context, attendancePeriodID, userID int64) (entity.PayrollRun, error) {
+	var version int64
+
+	latestPayrollRun, err := a.getLatestPayrollRun(ctx, attendancePeriodID)
+	switch {
+	case err == nil:
+		version = latestPayrollRun.Version
+	case errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist:
+		return entity.PayrollRun{}, err
+	}
+
+	return a.payrollRunDom.Create(
+		ctx,
+		entity.PayrollRunInputParam{
+			AttendancePeriodID: attendancePeriodID,
+			Version:            version + 1,
+			RunStatus:          entity.PayrollRunStatusProcessing,
+			CreatedAt:          null.TimeFrom(Now()),
+			CreatedBy:          null.Int64From(userID),
+		},
+	)
+}
+
+// getCurrentPayrollRun returns the latest processed version of the payroll of the attendance period,
+// the one employees are paid by.
+func (a *attendancePeriod) getCurrentPayrollRun(ctx context.Context, attendancePeriodID int64) (entity.PayrollRun, error) {
+	return a.payrollRunDom.Get(
+		ctx,
+		entity.PayrollRunParam{
+			AttendancePeriodID: attendancePeriodID,
+			RunStatus:          entity.PayrollRunStatusProcessed,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-version"},
+			},
+		},
+	)
+}
+
+func (a *attendancePeriod) GeneratePayslip(ctx context.Context, attendancePeriodID int64) (dto.Payslip, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return dto.Payslip{}, err
+	}
+
+	attendancePeriod, err := a.attendancePeriodDom.Get(ctx, entity.AttendancePeriodParam{
+		ID: attendancePeriodID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.Payslip{}, errors.NewWithCode(codes.CodeNotFound, "attendance period not found")
+		default:
+			return dto.Payslip{}, err
+		}
+	}
+
+	payrollRun, err := a.getCurrentPayrollRun(ctx, attendancePeriodID)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.Payslip{}, errors.NewWithCode(codes.CodeNotFound, "payslip not found")
+		default:
+			return dto.Payslip{}, err
+		}
+	}
+
+	payslip, err := a.payslipDom.Get(
+		ctx,
+		entity.PayslipParam{
+			PayrollRunID: payrollRun.ID,
+			UserID:       loginUser.ID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.Payslip{}, errors.NewWithCode(codes.CodeNotFound, "payslip not found")
+		default:
+			return dto.Payslip{}, err
+		}
+	}
+
+	details, _, err := a.payslipDetailDom.GetList(
+		ctx,
+		entity.PayslipDetailParam{
+			PayslipID: payslip.ID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return dto.Payslip{}, err
+	}
+
+	currencyTotals, _, err := a.payslipCurrencyTotalDom.GetList(
+		ctx,
+		entity.PayslipCurrencyTotalParam{
+			PayslipID: payslip.ID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"currency"},
+			},
+		},
+	)
+	if err != nil {
+		return dto.Payslip{}, err
+	}
+
+	yearToDate, err := a.getYearToDate(ctx, loginUser.ID, attendancePeriod.PayrollYear())
+	if err != nil {
+		return dto.Payslip{}, err
+	}
+
+	res := dto.Payslip{
+		StartDate:            attendancePeriod.StartDate,
+		EndDate:              attendancePeriod.EndDate,
+		Version:              payrollRun.Version,
+		ReportingCurrency:    entity.ReportingCurrency,
+		BasePayComponent:     payslip.BasePayComponent,
+		OvertimeComponent:    payslip.OvertimeComponent,
+		AllowanceComponent:   payslip.AllowanceComponent,
+		AdjustmentComponent:  payslip.AdjustmentComponent,
+		ReimburseComponent:   payslip.ReimbursementComponent,
+		DeductionComponent:   payslip.DeductionComponent,
+		EmployerContribution: payslip.EmployerContribution,
+		TotalTakeHomePay:     payslip.TotalTakeHomePay,
+		YearToDate:           yearToDate,
+		CurrencyTotals:       make([]dto.PayslipCurrencyTotal, 0, len(currencyTotals)),
+	}
+
+	for _, currencyTotal := range currencyTotals {
+		res.CurrencyTotals = append(res.CurrencyTotals, a.toPayslipCurrencyTotal(currencyTotal))
+	}
+
+	resDetails := make([]dto.PayslipDetail, 0, len(details))
+	for _, detail := range details {
+		resDetails = append(resDetails, dto.PayslipDetail{
+			Type:        detail.ItemType,
+			Description: detail.Description,
+			Amount:      detail.Amount,
+			Currency:    detail.Currency,
+			Rate:        detail.Rate.Float64,
+		})
+	}
+
+	res.Details = resDetails
+
+	return res, nil
+}
+
+// getYearToDate returns the year to date totals of an employee, which are zero until a payslip of the year is
+// generated.
+func (a *attendancePeriod) getYearToDate(ctx context.Context, userID int64, year int64) (dto.YearToDate, error) {
+	total, err := a.yearToDateTotalDom.Get(
+		ctx,
+		entity.YearToDateTotalParam{
+			UserID: userID,
+			Year:   year,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return dto.YearToDate{}, err
+	}
+
+	return dto.YearToDate{
+		Year:                 year,
+		Currency:             entity.ReportingCurrency,
+		GrossEarnings:        total.GrossEarnings,
+		TaxDeduction:         total.TaxDeduction,
+		EmployeeContribution: total.EmployeeContribution,
+		EmployerContribution: total.EmployerContribution,
+		TotalTakeHomePay:     total.TotalTakeHomePay,
+	}, nil
+}
+
+func (a *attendancePeriod) GeneratePayslipSummary(ctx context.Context, attendancePeriodID int64) (dto.PayslipSummary, error) {
+	payrollRun, err := a.getCurrentPayrollRun(ctx, attendancePeriodID)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.PayslipSummary{}, errors.NewWithCode(codes.CodeNotFound, "no payslips found for the given attendance period")
+		default:
+			return dto.PayslipSummary{}, err
+		}
+	}
+
+	return a.generatePayslipSummary(ctx, payrollRun)
+}
+
+func (a *attendancePeriod) GeneratePayrollRunPayslipSummary(ctx context.Context, payrollRunID int64) (dto.PayslipSummary, error) {
+	payrollRun, err := a.payrollRunDom.Get(
+		ctx,
+		entity.PayrollRunParam{
+			ID: payrollRunID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.PayslipSummary{}, errors.NewWithCode(codes.CodeNotFound, "payroll run not found")
+		default:
+			return dto.PayslipSummary{}, err
+		}
+	}
+
+	return a.generatePayslipSummary(ctx, payrollRun)
+}
+
+// generatePayslipSummary sums up the payslips of the payroll run, only the employees paid by the run are listed.
+// Employees deactivated since the run are still named.
+func (a *attendancePeriod) generatePayslipSummary(ctx context.Context, payrollRun entity.PayrollRun) (dto.PayslipSummary, error) {
+	users, _, err := a.userDom.GetList(
+		ctx,
+		entity.UserParam{
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return dto.PayslipSummary{}, err
+	}
+
+	userIDToName := make(map[int64]string, len(users))
+	for _, user := range users {
+		userIDToName[user.ID] = user.Name
+	}
+
+	userIDToPayslip, err := a.getUserIDToPayslip(ctx, payrollRun.ID)
+	if err != nil {
+		return dto.PayslipSummary{}, err
+	}
+
+	if len(userIDToPayslip) == 0 {
+		return dto.PayslipSummary{}, errors.NewWithCode(codes.CodeNotFound, "no payslips found for the given attendance period")
+	}
+
+	payslipIDToCurrencyTotals, err := a.getPayslipIDToCurrencyTotals(ctx, payrollRun.ID)
+	if err != nil {
+		return dto.PayslipSummary{}, err
+	}
+
+	var (
+		totalTakeHomePay, totalEmployerCost entity.Money
+		allPayouts                          []dto.CurrencyPayout
+	)
+	userIDs := make([]int64, 0, len(userIDToPayslip))
+	for userID := range userIDToPayslip {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	employeePayouts := []dto.EmployeePayout{}
+	for _, userID := range userIDs {
+		payslip := userIDToPayslip[userID]
+
+		payouts := []dto.CurrencyPayout{}
+		for _, currencyTotal := range payslipIDToCurrencyTotals[payslip.ID] {
+			payouts = append(payouts, dto.CurrencyPayout{
+				Currency:     currencyTotal.Currency,
+				TakeHomePay:  currencyTotal.TotalTakeHomePay,
+				EmployerCost: currencyTotal.EmployerCost(),
+			})
+		}
+
+		employeePayouts = append(
+			employeePayouts,
+			dto.EmployeePayout{
+				ID:           userID,
+				Name:         userIDToName[userID],
+				TakeHomePay:  payslip.TotalTakeHomePay,
+				EmployerCost: payslip.EmployerCost(),
+				Payouts:      payouts,
+			},
+		)
+		totalTakeHomePay += payslip.TotalTakeHomePay
+		totalEmployerCost += payslip.EmployerCost()
+		allPayouts = append(allPayouts, payouts...)
+	}
+
+	res := dto.PayslipSummary{
+		PayrollRunID:             payrollRun.ID,
+		Version:                  payrollRun.Version,
+		RunStatus:                payrollRun.RunStatus,
+		ReportingCurrency:        entity.ReportingCurrency,
+		TotalEmployeeTakeHomePay: totalTakeHomePay,
+		TotalEmployerCost:        totalEmployerCost,
+		TotalEmployee:            int64(len(employeePayouts)),
+		CurrencyTotals:           a.sumCurrencyPayouts(allPayouts),
+		EmployeePayouts:          employeePayouts,
+	}
+
+	return res, nil
+}
+
+func (a *attendancePeriod) PreviewPayroll(ctx context.Context, attendancePeriodID int64) (dto.PayrollPreview, error) {
+	loginUser, err := a.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return dto.PayrollPreview{}, err
+	}
+
+	attendancePeriod, err := a.attendancePeriodDom.Get(
+		ctx,
+		entity.AttendancePeriodParam{
+			ID: attendancePeriodID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.PayrollPreview{}, errors.NewWithCode(codes.CodeNotFound, "attendance period not found")
+		default:
+			return dto.PayrollPreview{}, err
+		}
+	}
+
+	switch attendancePeriod.PeriodStatus {
+	case entity.PeriodStatusProcessed:
+		return dto.PayrollPreview{}, errors.NewWithCode(codes.CodeBadRequest, "attendance period has already been processed")
+	case entity.PeriodStatusProcessing:
+		return dto.PayrollPreview{}, errors.NewWithCode(codes.CodeConflict, "attendance period is currently being processed")
+	case entity.PeriodStatusOpen:
+		return dto.PayrollPreview{}, errors.NewWithCode(codes.CodeBadRequest, "attendance period is still open and cannot be previewed")
+	case entity.PeriodStatusUpcoming:
+		return dto.PayrollPreview{}, errors.NewWithCode(codes.CodeBadRequest, "attendance period is upcoming and cannot be previewed")
+	}
+
+	users, err := a.getPayrollEmployees(ctx, attendancePeriod)
+	if err != nil {
+		return dto.PayrollPreview{}, err
+	}
+
+	if len(users) <= payrollPreviewSyncLimit {
+		payrolls, err := a.calculatePayroll(ctx, attendancePeriod, users)
+		if err != nil {
+			return dto.PayrollPreview{}, err
+		}
+
+		return a.toPayrollPreview(attendancePeriod, payrolls), nil
+	}
+
+	var payrollPreview entity.PayrollPreview
+	err = a.transactor.Execute(ctx, "txPreviewPayroll", sql.TxOptions{}, func(ctx context.Context) error {
+		payrollPreview, err = a.payrollPreviewDom.Create(
+			ctx,
+			entity.PayrollPreviewInputParam{
+				AttendancePeriodID: attendancePeriod.ID,
+				PreviewStatus:      entity.PayrollPreviewStatusPending,
+				TotalEmployee:      int64(len(users)),
+				CreatedAt:          null.TimeFrom(Now()),
+				CreatedBy:          null.Int64From(loginUser.ID),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		err = a.publisher.Publish(
+			ctx,
+			entity.ExchangePayrollEvent,
+			entity.RoutingKeyPayrollPreview,
+			dto.PubSubPreviewPayrollMessage{
+				PayrollPreviewID: payrollPreview.ID,
+				AttendancePeriod: attendancePeriod,
+				LoginUser:        loginUser,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dto.PayrollPreview{}, err
+	}
+
+	return dto.PayrollPreview{
+		PreviewID:          payrollPreview.ID,
+		PreviewStatus:      payrollPreview.PreviewStatus,
+		AttendancePeriodID: attendancePeriod.ID,
+		StartDate:          attendancePeriod.StartDate,
+		EndDate:            attendancePeriod.EndDate,
+		TotalEmployee:      payrollPreview.TotalEmployee,
+	}, nil
+}
+
+func (a *attendancePeriod) GetPayrollPreview(ctx context.Context, payrollPreviewID int64) (dto.PayrollPreview, error) {
+	payrollPreview, err := a.payrollPreviewDom.Get(
+		ctx,
+		entity.PayrollPreviewParam{
+			ID: payrollPreviewID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.PayrollPreview{}, errors.NewWithCode(codes.CodeNotFound, "payroll preview not found")
+		default:
+			return dto.PayrollPreview{}, err
+		}
+	}
+
+	res := dto.PayrollPreview{
+		AttendancePeriodID: payrollPreview.AttendancePeriodID,
+		TotalEmployee:      payrollPreview.TotalEmployee,
+	}
+
+	if payrollPreview.PreviewStatus == entity.PayrollPreviewStatusCompleted {
+		if err := a.json.Unmarshal([]byte(payrollPreview.Result.String), &res); err != nil {
+			return dto.PayrollPreview{}, errors.NewWithCode(codes.CodeJSONUnmarshalError, "failed to unmarshal payroll preview result: %s", err.Error())
+		}
+	}
+
+	res.PreviewID = payrollPreview.ID
+	res.PreviewStatus = payrollPreview.PreviewStatus
+	res.PreviewError = payrollPreview.PreviewError.String
+
+	return res, nil
+}
+
+// toPayrollPreview totals the calculated payrolls the same way the payslip summary totals stored payslips.
+func (a *attendancePeriod) toPayrollPreview(
+	attendancePeriod entity.AttendancePeriod,
+	payrolls []employeePayroll,
+) dto.PayrollPreview {
+	res := dto.PayrollPreview{
+		PreviewStatus:      entity.PayrollPreviewStatusCompleted,
+		AttendancePeriodID: attendancePeriod.ID,
+		StartDate:          attendancePeriod.StartDate,
+		EndDate:            attendancePeriod.EndDate,
+		TotalEmployee:      int64(len(payrolls)),
+		ReportingCurrency:  entity.ReportingCurrency,
+		Employees:          make([]dto.EmployeePayrollPreview, 0, len(payrolls)),
+	}
+
+	var allPayouts []dto.CurrencyPayout
+	for _, payroll := range payrolls {
+		payslip := entity.Payslip{
+			BasePayComponent:       payroll.Payslip.BasePayComponent,
+			OvertimeComponent:      payroll.Payslip.OvertimeComponent,
+			AllowanceComponent:     payroll.Payslip.AllowanceComponent,
+			AdjustmentComponent:    payroll.Payslip.AdjustmentComponent,
+			ReimbursementComponent: payroll.Payslip.ReimbursementComponent,
+			DeductionComponent:     payroll.Payslip.DeductionComponent,
+			EmployerContribution:   payroll.Payslip.EmployerContribution,
+			TotalTakeHomePay:       payroll.Payslip.TotalTakeHomePay,
+		}
+
+		currencyTotals := make([]dto.PayslipCurrencyTotal, 0, len(payroll.CurrencyTotals))
+		for _, inputParam := range payroll.CurrencyTotals {
+			currencyTotal := entity.PayslipCurrencyTotal{
+				Currency:               inputParam.Currency,
+				ExchangeRate:           inputParam.ExchangeRate,
+				BasePayComponent:       inputParam.BasePayComponent,
+				OvertimeComponent:      inputParam.OvertimeComponent,
+				AllowanceComponent:     inputParam.AllowanceComponent,
+				AdjustmentComponent:    inputParam.AdjustmentComponent,
+				ReimbursementComponent: inputParam.ReimbursementComponent,
+				DeductionComponent:     inputParam.DeductionComponent,
+				EmployerContribution:   inputParam.EmployerContribution,
+				TotalTakeHomePay:       inputParam.TotalTakeHomePay,
+			}
+
+			currencyTotals = append(currencyTotals, a.toPayslipCurrencyTotal(currencyTotal))
+			allPayouts = append(allPayouts, dto.CurrencyPayout{
+				Currency:     currencyTotal.Currency,
+				TakeHomePay:  currencyTotal.TotalTakeHomePay,
+				EmployerCost: currencyTotal.EmployerCost(),
+			})
+		}
+
+		details := make([]dto.PayslipDetail, 0, len(payroll.Details))
+		for _, detail := range payroll.Details {
+			details = append(details, dto.PayslipDetail{
+				Type:        detail.ItemType,
+				Description: detail.Description,
+				Amount:      detail.Amount,
+				Currency:    detail.Currency,
+				Rate:        detail.Rate.Float64,
+			})
+		}
+
+		res.Employees = append(res.Employees, dto.EmployeePayrollPreview{
+			ID:                   payroll.User.ID,
+			Name:                 payroll.User.Name,
+			BasePayComponent:     payslip.BasePayComponent,
+			OvertimeComponent:    payslip.OvertimeComponent,
+			AllowanceComponent:   payslip.AllowanceComponent,
+			AdjustmentComponent:  payslip.AdjustmentComponent,
+			ReimburseComponent:   payslip.ReimbursementComponent,
+			DeductionComponent:   payslip.DeductionComponent,
+			EmployerContribution: payslip.EmployerContribution,
+			TotalTakeHomePay:     payslip.TotalTakeHomePay,
+			EmployerCost:         payslip.EmployerCost(),
+			CurrencyTotals:       currencyTotals,
+			Details:              details,
+		})
+
+		res.TotalEmployeeTakeHomePay += payslip.TotalTakeHomePay
+		res.TotalEmployerCost += payslip.EmployerCost()
+	}
+
+	res.CurrencyTotals = a.sumCurrencyPayouts(allPayouts)
+
+	return res
+}
+
+func (a *attendancePeriod) toPayslipCurrencyTotal(currencyTotal entity.PayslipCurrencyTotal) dto.PayslipCurrencyTotal {
+	return dto.PayslipCurrencyTotal{
+		Currency:             currencyTotal.Currency,
+		ExchangeRate:         currencyTotal.ExchangeRate,
+		BasePayComponent:     currencyTotal.BasePayComponent,
+		OvertimeComponent:    currencyTotal.OvertimeComponent,
+		AllowanceComponent:   currencyTotal.AllowanceComponent,
+		AdjustmentComponent:  currencyTotal.AdjustmentComponent,
+		ReimburseComponent:   currencyTotal.ReimbursementComponent,
+		DeductionComponent:   currencyTotal.DeductionComponent,
+		EmployerContribution: currencyTotal.EmployerContribution,
+		TotalTakeHomePay:     currencyTotal.TotalTakeHomePay,
+	}
+}
+
+// sumCurrencyPayouts totals the payouts of every employee by currency, ordered by currency code.
+// Amounts of different currencies are never added together.
+func (a *attendancePeriod) sumCurrencyPayouts(payouts []dto.CurrencyPayout) []dto.CurrencyPayout {
+	currencyToPayout := make(map[string]dto.CurrencyPayout)
+	for _, payout := range payouts {
+		total := currencyToPayout[payout.Currency]
+		total.Currency = payout.Currency
+		total.TakeHomePay += payout.TakeHomePay
+		total.EmployerCost += payout.EmployerCost
+		currencyToPayout[payout.Currency] = total
+	}
+
+	res := make([]dto.CurrencyPayout, 0, len(currencyToPayout))
+	for _, payout := range currencyToPayout {
+		res = append(res, payout)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Currency < res[j].Currency
+	})
+
+	return res
+}
+
+// toPayrollStatus reports the latest payroll run of the attendance period. Progress is the percentage of
+// employees the run has finished, successfully or not.
+func (a *attendancePeriod) toPayrollStatus(
+	attendancePeriod entity.AttendancePeriod,
+	payrollRun entity.PayrollRun,
+	failedPayrollRunEmployees []entity.PayrollRunEmployee,
+) dto.PayrollStatus {
+	payrollStatus := dto.PayrollStatus{
+		AttendancePeriodID: attendancePeriod.ID,
+		PeriodStatus:       attendancePeriod.PeriodStatus,
+		PayrollRunID:       payrollRun.ID,
+		Version:            payrollRun.Version,
+		State:              payrollRun.RunStatus,
+		TotalEmployee:      payrollRun.TotalEmployee,
+		ProcessedEmployee:  payrollRun.ProcessedEmployee,
+		FailedEmployee:     payrollRun.FailedEmployee,
+		StartedAt:          payrollRun.StartedAt,
+		FinishedAt:         payrollRun.FinishedAt,
+		ProcessError:       payrollRun.ProcessError.String,
+		Failures:           make([]dto.PayrollStatusFailure, 0, len(failedPayrollRunEmployees)),
+	}
+
+	if payrollRun.TotalEmployee > 0 {
+		payrollStatus.Progress = float64(payrollRun.ProcessedEmployee*100) / float64(payrollRun.TotalEmployee)
+	}
+
+	for _, payrollRunEmployee := range failedPayrollRunEmployees {
+		payrollStatus.Failures = append(payrollStatus.Failures, dto.PayrollStatusFailure{
+			UserID:       payrollRunEmployee.UserID,
+			ProcessError: payrollRunEmployee.ProcessError.String,
+		})
+	}
+
+	return payrollStatus
+}
+
+func (a *attendancePeriod) getUserIDToPayslip(
+	ctx context.Context,
+	payrollRunID int64,
+) (
+	map[int64]entity.Payslip,
+	error,
+) {
+	userIDToPayslips := make(map[int64]entity.Payslip)
+
+	payslips, _, err := a.payslipDom.GetList(
+		ctx,
+		entity.PayslipParam{
+			PayrollRunID: payrollRunID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return userIDToPayslips, err
+	}
+
+	for _, payslip := range payslips {
+		userIDToPayslips[payslip.UserID] = payslip
 	}
 
 	return userIDToPayslips, nil
 }
 
+func (a *attendancePeriod) getPayslipIDToCurrencyTotals(
+	ctx context.Context,
+	payrollRunID int64,
+) (
+	map[int64][]entity.PayslipCurrencyTotal,
+	error,
+) {
+	payslipIDToCurrencyTotals := make(map[int64][]entity.PayslipCurrencyTotal)
+
+	currencyTotals, _, err := a.payslipCurrencyTotalDom.GetList(
+		ctx,
+		entity.PayslipCurrencyTotalParam{
+			PayrollRunID: payrollRunID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"currency"},
+			},
+		},
+	)
+	if err != nil {
+		return payslipIDToCurrencyTotals, err
+	}
+
+	for _, currencyTotal := range currencyTotals {
+		payslipIDToCurrencyTotals[currencyTotal.PayslipID] = append(payslipIDToCurrencyTotals[currencyTotal.PayslipID], currencyTotal)
+	}
+
+	return payslipIDToCurrencyTotals, nil
+}
+
 func (a *attendancePeriod) ValidateAttendancePeriodScheduler(ctx context.Context) error {
 	currentTime := null.TimeFrom(Now())
 	err := a.attendancePeriodDom.Update(
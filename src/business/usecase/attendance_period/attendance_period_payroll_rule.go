@@ -0,0 +1,878 @@
+package attendance_period
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+// payrollInput holds everything a payroll rule needs to evaluate a single employee.
+// EmployedWorkingDays are the working days of the period within the employment of the employee,
+// they are fewer than TotalWorkingDays for an employee who joined or left during the period.
+// AttendanceTypes are the pay treatments of the attendances by attendance type code.
+type payrollInput struct {
+	User                entity.User
+	AttendancePeriod    entity.AttendancePeriod
+	WorkCalendar        entity.WorkCalendar
+	Holidays            entity.HolidayCalendar
+	TotalWorkingDays    int64
+	EmployedWorkingDays int64
+	TotalAttendance     int64
+	Attendances         []entity.Attendance
+	AttendanceTypes     map[string]entity.AttendanceType
+	SalarySegments      []entity.SalarySegment
+	Overtimes           []entity.Overtime
+	Reimbursements      []entity.Reimbursement
+	Allowances          []entity.UserAllowance
+	AllowanceTypes      map[int64]entity.AllowanceType
+	Adjustments         []entity.PayrollAdjustment
+	Loans               []entity.Loan
+	TaxBrackets         []entity.TaxBracket
+	ExchangeRates       map[string]float64
+}
+
+// payableAttendance is the attendance paid for, an employee is never paid for more days than they were employed
+// nor for the days of an attendance type beyond its limit.
+func (p payrollInput) payableAttendance() int64 {
+	return min(max(p.TotalAttendance-p.unpaidAttendance(), 0), p.EmployedWorkingDays)
+}
+
+// segmentBasePay is the base pay of a salary segment, the payable attendance is spread over the segments by
+// their working days.
+func (p payrollInput) segmentBasePay(segment entity.SalarySegment) entity.Money {
+	return segment.BaseSalary.MulDiv(
+		float64(p.payableAttendance()*segment.WorkingDays),
+		float64(p.EmployedWorkingDays*p.TotalWorkingDays),
+	)
+}
+
+// basePay is the base salary prorated by the payable attendance against the working days of the period, the amount
+// paid by the base pay rule.
+func (p payrollInput) basePay() entity.Money {
+	if len(p.SalarySegments) <= 1 || p.EmployedWorkingDays == 0 {
+		return p.User.BaseSalary.MulDiv(float64(p.payableAttendance()), float64(p.TotalWorkingDays))
+	}
+
+	var totalPay entity.Money
+	for _, segment := range p.SalarySegments {
+		totalPay += p.segmentBasePay(segment)
+	}
+
+	return totalPay
+}
+
+// attendanceTypeDays counts the attendances of the employee by attendance type, the codes are returned sorted.
+func (p payrollInput) attendanceTypeDays() ([]string, map[string]int64) {
+	days := make(map[string]int64)
+	for _, attendance := range p.Attendances {
+		days[attendance.AttendanceType]++
+	}
+
+	attendanceTypeCodes := make([]string, 0, len(days))
+	for code := range days {
+		attendanceTypeCodes = append(attendanceTypeCodes, code)
+	}
+	sort.Strings(attendanceTypeCodes)
+
+	return attendanceTypeCodes, days
+}
+
+// unpaidAttendance is the attendance of the capped attendance types beyond their limit in the period.
+func (p payrollInput) unpaidAttendance() int64 {
+	var unpaid int64
+
+	_, days := p.attendanceTypeDays()
+	for code, typeDays := range days {
+		if attendanceType, ok := p.AttendanceTypes[code]; ok {
+			unpaid += typeDays - attendanceType.PaidDays(typeDays)
+		}
+	}
+
+	return unpaid
+}
+
+// unpaidAttendanceDescription names the days of every capped attendance type left unpaid, it is empty when every
+// day is paid.
+func (p payrollInput) unpaidAttendanceDescription() string {
+	var unpaid []string
+
+	attendanceTypeCodes, days := p.attendanceTypeDays()
+	for _, code := range attendanceTypeCodes {
+		attendanceType, ok := p.AttendanceTypes[code]
+		if !ok {
+			continue
+		}
+
+		if unpaidDays := days[code] - attendanceType.PaidDays(days[code]); unpaidDays > 0 {
+			unpaid = append(unpaid, fmt.Sprintf("%v %v Days over the Limit of %v", unpaidDays, attendanceType.Name, attendanceType.MaxDaysPerPeriod))
+		}
+	}
+
+	if len(unpaid) == 0 {
+		return ""
+	}
+
+	return ", Excluding " + strings.Join(unpaid, ", ")
+}
+
+// workdaysDescription describes the working days attendance is paid against, naming the eligible
+// days of an employee who was not employed for the whole period.
+func (p payrollInput) workdaysDescription() string {
+	if p.EmployedWorkingDays < p.TotalWorkingDays {
+		return fmt.Sprintf("%v Eligible of %v Workdays", p.EmployedWorkingDays, p.TotalWorkingDays)
+	}
+
+	return fmt.Sprintf("%v Workdays", p.TotalWorkingDays)
+}
+
+// payrollRuleResult is the outcome of evaluating one pay component for one employee.
+// Amount is in the salary currency of the employee, every detail line is in its own currency.
+// LoanRepayments are the loan instalments withheld by the result, they are stored with the payslip.
+type payrollRuleResult struct {
+	Component      entity.PayComponent
+	Amount         entity.Money
+	Details        []entity.PayslipDetailInputParam
+	LoanRepayments []loanRepayment
+}
+
+// loanRepayment is an instalment withheld from a loan, Amount is in the currency of the loan.
+type loanRepayment struct {
+	Loan   entity.Loan
+	Amount entity.Money
+}
+
+// payrollRule evaluates a pay component. The results of the rules evaluated earlier
+// in the chain are passed along, so a rule can depend on the components before it.
+type payrollRule func(component entity.PayComponent, input payrollInput, previous []payrollRuleResult) (payrollRuleResult, error)
+
+// overtimeRateBand pays the overtime hours worked between FromHour (inclusive) and ToHour (exclusive)
+// at Multiplier times the hourly rate. A zero ToHour leaves the band open ended.
+type overtimeRateBand struct {
+	FromHour   float64 `json:"fromHour"`
+	ToHour     float64 `json:"toHour"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+type overtimeRuleConfig struct {
+	HoursPerDay float64                       `json:"hoursPerDay"`
+	Rates       map[string][]overtimeRateBand `json:"rates"`
+}
+
+// taxRuleConfig holds the annual reliefs subtracted from the annualised taxable earnings
+// before the progressive tax brackets are applied.
+type taxRuleConfig struct {
+	PeriodsPerYear  int64        `json:"periodsPerYear"`
+	PersonalRelief  entity.Money `json:"personalRelief"`
+	MarriedRelief   entity.Money `json:"marriedRelief"`
+	DependantRelief entity.Money `json:"dependantRelief"`
+	MaxDependants   int64        `json:"maxDependants"`
+}
+
+// contributionRuleConfig splits a social security contribution between the employee and the employer.
+// Both shares are taken from the prorated base pay clamped between SalaryFloor and SalaryCap, a zero SalaryCap
+// leaves the salary uncapped.
+type contributionRuleConfig struct {
+	EmployeeRate float64      `json:"employeeRate"`
+	EmployerRate float64      `json:"employerRate"`
+	SalaryFloor  entity.Money `json:"salaryFloor"`
+	SalaryCap    entity.Money `json:"salaryCap"`
+}
+
+// payslipComponents totals payslip detail lines into the components of a payslip.
+type payslipComponents struct {
+	BasePay              entity.Money
+	Overtime             entity.Money
+	Allowance            entity.Money
+	Adjustment           entity.Money
+	Reimbursement        entity.Money
+	Deduction            entity.Money
+	TaxDeduction         entity.Money
+	EmployeeContribution entity.Money
+	EmployerContribution entity.Money
+	TotalTakeHomePay     entity.Money
+}
+
+func (a *attendancePeriod) registerPayrollRules() map[string]payrollRule {
+	return map[string]payrollRule{
+		entity.PayComponentCodeBasePay:             a.calculateBasePay,
+		entity.PayComponentCodeOvertime:            a.calculateOvertimePay,
+		entity.PayComponentCodeAllowance:           a.calculateAllowancePay,
+		entity.PayComponentCodeEarningAdjustment:   a.calculateAdjustment,
+		entity.PayComponentCodeReimbursement:       a.calculateReimbursementPay,
+		entity.PayComponentCodeTax:                 a.calculateTaxDeduction,
+		entity.PayComponentCodePension:             a.calculateContribution,
+		entity.PayComponentCodeHealthInsurance:     a.calculateContribution,
+		entity.PayComponentCodeAttendancePenalty:   a.calculateAttendancePenalty,
+		entity.PayComponentCodeDeductionAdjustment: a.calculateAdjustment,
+		entity.PayComponentCodeLoanRepayment:       a.calculateLoanRepayment,
+	}
+}
+
+// runPayrollRules evaluates the pay components in the given order and returns one result per component.
+func (a *attendancePeriod) runPayrollRules(components []entity.PayComponent, input payrollInput) ([]payrollRuleResult, error) {
+	results := make([]payrollRuleResult, 0, len(components))
+
+	for _, component := range components {
+		rule, ok := a.payrollRules[component.Code]
+		if !ok {
+			return results, errors.NewWithCode(codes.CodeNotImplemented, "no payroll rule registered for pay component %s", component.Code)
+		}
+
+		result, err := rule(component, input, results)
+		if err != nil {
+			return results, err
+		}
+
+		result.Component = component
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// toPayslipInputParam totals the detail lines of the results into the payslip components, once per currency
+// in that currency and once for the whole payslip with every line converted to the reporting currency.
+// Deductions are subtracted from the take home pay, employer costs are only recorded and leave the take home pay untouched.
+func (a *attendancePeriod) toPayslipInputParam(
+	results []payrollRuleResult,
+	input payrollInput,
+) (
+	entity.PayslipInputParam,
+	[]entity.PayslipCurrencyTotalInputParam,
+	[]entity.PayslipDetailInputParam,
+	error,
+) {
+	var (
+		reportingComponents payslipComponents
+		currencyComponents  = make(map[string]*payslipComponents)
+		currencyRates       = make(map[string]float64)
+		details             []entity.PayslipDetailInputParam
+	)
+
+	for _, result := range results {
+		for _, detail := range result.Details {
+			// a line without a currency is in the reporting currency
+			if detail.Currency == "" {
+				detail.Currency = entity.ReportingCurrency
+			}
+
+			rate, err := a.getExchangeRate(input, detail.Currency)
+			if err != nil {
+				return entity.PayslipInputParam{}, nil, nil, err
+			}
+
+			components, ok := currencyComponents[detail.Currency]
+			if !ok {
+				components = &payslipComponents{}
+				currencyComponents[detail.Currency] = components
+				currencyRates[detail.Currency] = rate
+			}
+
+			components.add(detail.ItemType, detail.Amount)
+			reportingComponents.add(detail.ItemType, detail.Amount.MulRate(rate))
+
+			details = append(details, detail)
+		}
+	}
+
+	currencies := make([]string, 0, len(currencyComponents))
+	for currency := range currencyComponents {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	currencyTotals := make([]entity.PayslipCurrencyTotalInputParam, 0, len(currencies))
+	for _, currency := range currencies {
+		components := currencyComponents[currency]
+		currencyTotals = append(currencyTotals, entity.PayslipCurrencyTotalInputParam{
+			Currency:               currency,
+			ExchangeRate:           currencyRates[currency],
+			BasePayComponent:       components.BasePay,
+			OvertimeComponent:      components.Overtime,
+			AllowanceComponent:     components.Allowance,
+			AdjustmentComponent:    components.Adjustment,
+			ReimbursementComponent: components.Reimbursement,
+			DeductionComponent:     components.Deduction,
+			EmployerContribution:   components.EmployerContribution,
+			TotalTakeHomePay:       components.TotalTakeHomePay,
+		})
+	}
+
+	return entity.PayslipInputParam{
+		BasePayComponent:       reportingComponents.BasePay,
+		OvertimeComponent:      reportingComponents.Overtime,
+		AllowanceComponent:     reportingComponents.Allowance,
+		AdjustmentComponent:    reportingComponents.Adjustment,
+		ReimbursementComponent: reportingComponents.Reimbursement,
+		DeductionComponent:     reportingComponents.Deduction,
+		TaxDeduction:           reportingComponents.TaxDeduction,
+		EmployeeContribution:   reportingComponents.EmployeeContribution,
+		EmployerContribution:   reportingComponents.EmployerContribution,
+		TotalTakeHomePay:       reportingComponents.TotalTakeHomePay,
+	}, currencyTotals, details, nil
+}
+
+func (p *payslipComponents) add(itemType string, amount entity.Money) {
+	switch itemType {
+	case entity.PayslipItemTypeEarningBasePay:
+		p.BasePay += amount
+	case entity.PayslipItemTypeEarningOvertime:
+		p.Overtime += amount
+	case entity.PayslipItemTypeEarningAllowance:
+		p.Allowance += amount
+	case entity.PayslipItemTypeEarningAdjustment:
+		p.Adjustment += amount
+	case entity.PayslipItemTypeReimbursement:
+		p.Reimbursement += amount
+	case entity.PayslipItemTypeDeductionTax:
+		p.TaxDeduction += amount
+	case entity.PayslipItemTypeDeductionContribution:
+		p.EmployeeContribution += amount
+	case entity.PayslipItemTypeEmployerContribution:
+		p.EmployerContribution += amount
+	}
+
+	if entity.IsPayslipItemTypeDeduction(itemType) {
+		p.Deduction += amount
+		p.TotalTakeHomePay -= amount
+	} else if !entity.IsPayslipItemTypeEmployerCost(itemType) {
+		p.TotalTakeHomePay += amount
+	}
+}
+
+// getExchangeRate returns the amount of the reporting currency one unit of the currency is worth in the period.
+func (a *attendancePeriod) getExchangeRate(input payrollInput, currency string) (float64, error) {
+	if currency == "" || currency == entity.ReportingCurrency {
+		return 1, nil
+	}
+
+	rate, ok := input.ExchangeRates[currency]
+	if !ok {
+		return 0, errors.NewWithCode(codes.CodeNotFound, "no exchange rate of %s fixed on or before %s", currency, input.AttendancePeriod.GetExchangeRateFixingDate().Time.Format(time.DateOnly))
+	}
+
+	return rate, nil
+}
+
+func (a *attendancePeriod) decodePayComponentConfig(component entity.PayComponent, config interface{}) error {
+	if !component.Config.Valid || component.Config.String == "" {
+		return nil
+	}
+
+	if err := a.json.Unmarshal([]byte(component.Config.String), config); err != nil {
+		return errors.NewWithCode(codes.CodeJSONUnmarshalError, "failed to unmarshal config of pay component %s: %s", component.Code, err.Error())
+	}
+
+	return nil
+}
+
+// calculateBasePay pays the attendance of the employee against the working days of the period. When the salary
+// changes during the period the attendance is spread over the salary segments by their working days, and every
+// segment is paid at its own salary on its own payslip line.
+func (a *attendancePeriod) calculateBasePay(component entity.PayComponent, input payrollInput, _ []payrollRuleResult) (payrollRuleResult, error) {
+	if len(input.SalarySegments) <= 1 || input.EmployedWorkingDays == 0 {
+		totalPay := input.basePay()
+		return payrollRuleResult{
+			Amount: totalPay,
+			Details: []entity.PayslipDetailInputParam{
+				{
+					ItemType:    component.ItemType,
+					Description: fmt.Sprintf("Base Pay for %v Attendance on %v%v", input.payableAttendance(), input.workdaysDescription(), input.unpaidAttendanceDescription()),
+					Amount:      totalPay,
+					Currency:    input.User.Currency,
+				},
+			},
+		}, nil
+	}
+
+	result := payrollRuleResult{Details: []entity.PayslipDetailInputParam{}}
+	for _, segment := range input.SalarySegments {
+		if segment.WorkingDays == 0 {
+			continue
+		}
+
+		amount := input.segmentBasePay(segment)
+		result.Amount += amount
+
+		result.Details = append(result.Details, entity.PayslipDetailInputParam{
+			ItemType: component.ItemType,
+			Description: fmt.Sprintf(
+				"Base Pay for %v Attendance on %v%v, %v Workdays at %v from %v",
+				input.payableAttendance(),
+				input.workdaysDescription(),
+				input.unpaidAttendanceDescription(),
+				segment.WorkingDays,
+				segment.BaseSalary,
+				segment.StartDate.Time.Format(time.DateOnly),
+			),
+			Amount:   amount,
+			Currency: input.User.Currency,
+		})
+	}
+
+	return result, nil
+}
+
+func (a *attendancePeriod) calculateOvertimePay(component entity.PayComponent, input payrollInput, _ []payrollRuleResult) (payrollRuleResult, error) {
+	config := overtimeRuleConfig{HoursPerDay: 8}
+	if err := a.decodePayComponentConfig(component, &config); err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	if config.HoursPerDay <= 0 {
+		return payrollRuleResult{}, errors.NewWithCode(codes.CodeInvalidValue, "hoursPerDay of pay component %s must be a positive number", component.Code)
+	}
+
+	// an hour of overtime is worth the base salary spread over every working hour of the period
+	workingHours := float64(input.TotalWorkingDays) * config.HoursPerDay
+
+	result := payrollRuleResult{Details: []entity.PayslipDetailInputParam{}}
+	for _, overtime := range input.Overtimes {
+		dayType := a.getOvertimeDayType(input.WorkCalendar, input.Holidays, overtime.OvertimeDate.Time)
+
+		bands, ok := config.Rates[dayType]
+		if !ok || len(bands) == 0 {
+			return payrollRuleResult{}, errors.NewWithCode(codes.CodeInvalidValue, "no overtime rate configured for day type %s in pay component %s", dayType, component.Code)
+		}
+
+		for _, band := range bands {
+			hours := band.hoursWithin(overtime.OvertimeHour)
+			if hours <= 0 {
+				continue
+			}
+
+			overtimePay := input.User.BaseSalary.MulDiv(band.Multiplier*hours, workingHours)
+			result.Amount += overtimePay
+
+			result.Details = append(result.Details, entity.PayslipDetailInputParam{
+				ItemType:    component.ItemType,
+				Description: fmt.Sprintf("Overtime %v Hours at %vx on %v (%v)", hours, band.Multiplier, overtime.OvertimeDate.Time.Format(time.DateOnly), dayType),
+				Amount:      overtimePay,
+				Currency:    input.User.Currency,
+				Rate:        null.Float64From(band.Multiplier),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// getOvertimeDayType classifies the date an overtime was worked on, a public holiday takes precedence over
+// a rest day of the work schedule of the employee.
+func (a *attendancePeriod) getOvertimeDayType(workCalendar entity.WorkCalendar, holidays entity.HolidayCalendar, date time.Time) string {
+	if holidays.IsHoliday(date) {
+		return entity.OvertimeDayTypePublicHoliday
+	}
+
+	if !workCalendar.IsWorkDay(date) {
+		return entity.OvertimeDayTypeRestDay
+	}
+
+	return entity.OvertimeDayTypeWorkday
+}
+
+// hoursWithin returns how many of the given overtime hours fall inside the band.
+func (o overtimeRateBand) hoursWithin(overtimeHour float64) float64 {
+	upper := overtimeHour
+	if o.ToHour > 0 && o.ToHour < upper {
+		upper = o.ToHour
+	}
+
+	return max(upper-o.FromHour, 0)
+}
+
+// calculateAllowancePay pays every allowance assigned to the employee for the period in the salary currency.
+// A prorated allowance is paid for the days attended only, the same way as the base pay. The daily allowance of an
+// attendance type is paid for every paid day the employee attended with it.
+func (a *attendancePeriod) calculateAllowancePay(component entity.PayComponent, input payrollInput, _ []payrollRuleResult) (payrollRuleResult, error) {
+	result := payrollRuleResult{Details: []entity.PayslipDetailInputParam{}}
+	for _, allowance := range input.Allowances {
+		allowanceType, ok := input.AllowanceTypes[allowance.AllowanceTypeID]
+		if !ok {
+			// the allowance type was deactivated, its allowances are no longer paid
+			continue
+		}
+
+		amount := allowance.Amount
+		description := allowanceType.Name
+		if allowance.IsProrated {
+			amount = allowance.Amount.MulDiv(float64(input.payableAttendance()), float64(input.TotalWorkingDays))
+			description = fmt.Sprintf("%v for %v Attendance on %v", allowanceType.Name, input.payableAttendance(), input.workdaysDescription())
+		}
+
+		result.Amount += amount
+
+		result.Details = append(result.Details, entity.PayslipDetailInputParam{
+			ItemType:    component.ItemType,
+			Description: description,
+			Amount:      amount,
+			Currency:    input.User.Currency,
+		})
+	}
+
+	attendanceTypeCodes, days := input.attendanceTypeDays()
+	for _, code := range attendanceTypeCodes {
+		attendanceType, ok := input.AttendanceTypes[code]
+		if !ok || attendanceType.DailyAllowance <= 0 {
+			continue
+		}
+
+		paidDays := attendanceType.PaidDays(days[code])
+		if paidDays == 0 {
+			continue
+		}
+
+		salaryRate, err := a.getExchangeRate(input, input.User.Currency)
+		if err != nil {
+			return payrollRuleResult{}, err
+		}
+
+		// the daily allowance is in the reporting currency
+		amount := attendanceType.DailyAllowance.MulDiv(float64(paidDays), salaryRate)
+		result.Amount += amount
+
+		result.Details = append(result.Details, entity.PayslipDetailInputParam{
+			ItemType:    component.ItemType,
+			Description: fmt.Sprintf("%v Allowance for %v Days", attendanceType.Name, paidDays),
+			Amount:      amount,
+			Currency:    input.User.Currency,
+		})
+	}
+
+	return result, nil
+}
+
+// calculateAdjustment pays or withholds the one-off adjustments of the employee whose direction matches
+// the item type of the pay component, so earnings are taxed and deductions are taken after the tax.
+func (a *attendancePeriod) calculateAdjustment(component entity.PayComponent, input payrollInput, _ []payrollRuleResult) (payrollRuleResult, error) {
+	salaryRate, err := a.getExchangeRate(input, input.User.Currency)
+	if err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	result := payrollRuleResult{Details: []entity.PayslipDetailInputParam{}}
+	for _, adjustment := range input.Adjustments {
+		if adjustment.ItemType() != component.ItemType {
+			continue
+		}
+
+		rate, err := a.getExchangeRate(input, adjustment.Currency)
+		if err != nil {
+			return payrollRuleResult{}, err
+		}
+
+		result.Amount += adjustment.Amount.MulDiv(rate, salaryRate)
+
+		result.Details = append(result.Details, entity.PayslipDetailInputParam{
+			ItemType:    component.ItemType,
+			Description: adjustment.Reason,
+			Amount:      adjustment.Amount,
+			Currency:    adjustment.Currency,
+		})
+	}
+
+	return result, nil
+}
+
+// calculateReimbursementPay pays back every reimbursement in the currency it was claimed in.
+func (a *attendancePeriod) calculateReimbursementPay(component entity.PayComponent, input payrollInput, _ []payrollRuleResult) (payrollRuleResult, error) {
+	salaryRate, err := a.getExchangeRate(input, input.User.Currency)
+	if err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	result := payrollRuleResult{Details: []entity.PayslipDetailInputParam{}}
+	for _, reimbursement := range input.Reimbursements {
+		rate, err := a.getExchangeRate(input, reimbursement.Currency)
+		if err != nil {
+			return payrollRuleResult{}, err
+		}
+
+		result.Amount += reimbursement.Amount.MulDiv(rate, salaryRate)
+
+		result.Details = append(result.Details, entity.PayslipDetailInputParam{
+			ItemType:    component.ItemType,
+			Description: reimbursement.Description,
+			Amount:      reimbursement.Amount,
+			Currency:    reimbursement.Currency,
+		})
+	}
+
+	return result, nil
+}
+
+// calculateTaxDeduction withholds the income tax of the period. The taxable earnings of the previous
+// components are converted to the reporting currency and annualised, reduced by the reliefs of the
+// employee tax status, taxed with the progressive brackets in effect for the period, then spread back
+// over the periods of the year and withheld in the salary currency.
+func (a *attendancePeriod) calculateTaxDeduction(component entity.PayComponent, input payrollInput, previous []payrollRuleResult) (payrollRuleResult, error) {
+	config := taxRuleConfig{PeriodsPerYear: 12}
+	if err := a.decodePayComponentConfig(component, &config); err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	if config.PeriodsPerYear <= 0 {
+		return payrollRuleResult{}, errors.NewWithCode(codes.CodeInvalidValue, "periodsPerYear of pay component %s must be a positive number", component.Code)
+	}
+
+	if len(input.TaxBrackets) == 0 {
+		return payrollRuleResult{}, errors.NewWithCode(codes.CodeNotFound, "no tax bracket effective on %s", input.AttendancePeriod.EndDate.Time.Format(time.DateOnly))
+	}
+
+	rate, err := a.getExchangeRate(input, input.User.Currency)
+	if err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	var taxableEarnings entity.Money
+	for _, result := range previous {
+		if entity.IsPayslipItemTypeEarning(result.Component.ItemType) {
+			taxableEarnings += result.Amount
+		}
+	}
+
+	relief := config.PersonalRelief
+	if input.User.TaxMaritalStatus == entity.UserTaxMaritalStatusMarried {
+		relief += config.MarriedRelief
+	}
+	relief += config.DependantRelief * entity.Money(min(input.User.TaxDependants, config.MaxDependants))
+
+	annualTaxableIncome := max(taxableEarnings.MulRate(rate)*entity.Money(config.PeriodsPerYear)-relief, 0)
+	tax := entity.CalculateProgressiveTax(input.TaxBrackets, annualTaxableIncome).MulDiv(1, float64(config.PeriodsPerYear)*rate)
+
+	return payrollRuleResult{
+		Amount: tax,
+		Details: []entity.PayslipDetailInputParam{
+			{
+				ItemType:    component.ItemType,
+				Description: fmt.Sprintf("Income Tax on %v Annual Taxable Income (%v, %v Dependants)", annualTaxableIncome, input.User.TaxMaritalStatus, input.User.TaxDependants),
+				Amount:      tax,
+				Currency:    input.User.Currency,
+			},
+		},
+	}, nil
+}
+
+// calculateContribution withholds the employee share of a contribution and records the employer share
+// as an informational line, the employer share is a cost of the employer and never reaches the employee.
+// The salary floor and cap are in the reporting currency and are converted to the salary currency.
+func (a *attendancePeriod) calculateContribution(component entity.PayComponent, input payrollInput, _ []payrollRuleResult) (payrollRuleResult, error) {
+	config := contributionRuleConfig{}
+	if err := a.decodePayComponentConfig(component, &config); err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	if config.EmployeeRate < 0 || config.EmployerRate < 0 {
+		return payrollRuleResult{}, errors.NewWithCode(codes.CodeInvalidValue, "contribution rates of pay component %s cannot be negative", component.Code)
+	}
+
+	if config.SalaryCap > 0 && config.SalaryCap < config.SalaryFloor {
+		return payrollRuleResult{}, errors.NewWithCode(codes.CodeInvalidValue, "salaryCap of pay component %s cannot be below its salaryFloor", component.Code)
+	}
+
+	rate, err := a.getExchangeRate(input, input.User.Currency)
+	if err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	contributionBase := max(input.basePay(), config.SalaryFloor.MulDiv(1, rate))
+	if config.SalaryCap > 0 {
+		contributionBase = min(contributionBase, config.SalaryCap.MulDiv(1, rate))
+	}
+
+	employeeShare := contributionBase.MulRate(config.EmployeeRate)
+	employerShare := contributionBase.MulRate(config.EmployerRate)
+
+	return payrollRuleResult{
+		Amount: employeeShare,
+		Details: []entity.PayslipDetailInputParam{
+			{
+				ItemType:    component.ItemType,
+				Description: fmt.Sprintf("%v Employee Contribution on %v", component.Name, contributionBase),
+				Amount:      employeeShare,
+				Currency:    input.User.Currency,
+				Rate:        null.Float64From(config.EmployeeRate),
+			},
+			{
+				ItemType:    entity.PayslipItemTypeEmployerContribution,
+				Description: fmt.Sprintf("%v Employer Contribution on %v", component.Name, contributionBase),
+				Amount:      employerShare,
+				Currency:    input.User.Currency,
+				Rate:        null.Float64From(config.EmployerRate),
+			},
+		},
+	}, nil
+}
+
+// calculateAttendancePenalty withholds the penalties of the late arrivals and early departures of the employee, each
+// day is judged by the work schedule in effect on it. A per minute penalty is withheld for every late arrival and early
+// departure, a half day penalty for every HalfDayAfterLates days late or early under the same schedule in the period.
+// Every line names the attendance dates it was withheld for.
+func (a *attendancePeriod) calculateAttendancePenalty(component entity.PayComponent, input payrollInput, _ []payrollRuleResult) (payrollRuleResult, error) {
+	salaryRate, err := a.getExchangeRate(input, input.User.Currency)
+	if err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	attendances := make([]entity.Attendance, len(input.Attendances))
+	copy(attendances, input.Attendances)
+	sort.SliceStable(attendances, func(i, j int) bool {
+		return attendances[i].AttendanceDate.Time.Before(attendances[j].AttendanceDate.Time)
+	})
+
+	var (
+		result          = payrollRuleResult{Details: []entity.PayslipDetailInputParam{}}
+		halfDaySchedule = make(map[int64]entity.WorkSchedule)
+		halfDayLates    = make(map[int64][]string)
+		halfDayOrder    []int64
+	)
+
+	for _, attendance := range attendances {
+		date := attendance.AttendanceDate.Time
+
+		// nobody is expected at work on a day off or a public holiday, so nobody is late on them
+		if !input.WorkCalendar.IsWorkDay(date) || input.Holidays.IsHoliday(date) {
+			continue
+		}
+
+		workSchedule := input.WorkCalendar.GetWorkSchedule(date)
+		if !workSchedule.IsPenalised() {
+			continue
+		}
+
+		lateMinutes := attendance.LateMinutes(workSchedule)
+		earlyMinutes := attendance.EarlyMinutes(workSchedule)
+		if lateMinutes == 0 && earlyMinutes == 0 {
+			continue
+		}
+
+		switch workSchedule.PenaltyType {
+		case entity.WorkSchedulePenaltyTypePerMinute:
+			for _, offence := range []struct {
+				name    string
+				minutes int64
+			}{
+				{name: "Late Arrival", minutes: lateMinutes},
+				{name: "Early Departure", minutes: earlyMinutes},
+			} {
+				if offence.minutes == 0 {
+					continue
+				}
+
+				// the penalty per minute is in the reporting currency
+				amount := workSchedule.PenaltyPerMinute.MulDiv(float64(offence.minutes), salaryRate)
+				result.Amount += amount
+
+				result.Details = append(result.Details, entity.PayslipDetailInputParam{
+					ItemType:    component.ItemType,
+					Description: fmt.Sprintf("%v of %v Minutes on %v", offence.name, offence.minutes, date.Format(time.DateOnly)),
+					Amount:      amount,
+					Currency:    input.User.Currency,
+				})
+			}
+		case entity.WorkSchedulePenaltyTypeHalfDay:
+			if _, ok := halfDaySchedule[workSchedule.ID]; !ok {
+				halfDaySchedule[workSchedule.ID] = workSchedule
+				halfDayOrder = append(halfDayOrder, workSchedule.ID)
+			}
+
+			halfDayLates[workSchedule.ID] = append(halfDayLates[workSchedule.ID], date.Format(time.DateOnly))
+		}
+	}
+
+	if len(halfDayOrder) == 0 || input.TotalWorkingDays == 0 {
+		return result, nil
+	}
+
+	// half a day is worth half of the base salary spread over the working days of the period
+	halfDayPay := input.User.BaseSalary.MulDiv(1, float64(2*input.TotalWorkingDays))
+
+	for _, workScheduleID := range halfDayOrder {
+		workSchedule := halfDaySchedule[workScheduleID]
+		if workSchedule.HalfDayAfterLates <= 0 {
+			return payrollRuleResult{}, errors.NewWithCode(codes.CodeInvalidValue, "halfDayAfterLates of work schedule %s must be a positive number", workSchedule.Name)
+		}
+
+		lates := halfDayLates[workScheduleID]
+		for end := workSchedule.HalfDayAfterLates; end <= int64(len(lates)); end += workSchedule.HalfDayAfterLates {
+			result.Amount += halfDayPay
+
+			result.Details = append(result.Details, entity.PayslipDetailInputParam{
+				ItemType:    component.ItemType,
+				Description: fmt.Sprintf("Half Day for %v Lates on %v", workSchedule.HalfDayAfterLates, strings.Join(lates[end-workSchedule.HalfDayAfterLates:end], ", ")),
+				Amount:      halfDayPay,
+				Currency:    input.User.Currency,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// calculateLoanRepayment withholds the due instalment of every active loan of the employee in the currency of the loan.
+// The instalments never take more than the net pay left by the previous components, the part of an instalment that
+// cannot be withheld stays in the balance of the loan and is repaid by the later payrolls.
+func (a *attendancePeriod) calculateLoanRepayment(component entity.PayComponent, input payrollInput, previous []payrollRuleResult) (payrollRuleResult, error) {
+	salaryRate, err := a.getExchangeRate(input, input.User.Currency)
+	if err != nil {
+		return payrollRuleResult{}, err
+	}
+
+	var netPay entity.Money
+	for _, result := range previous {
+		switch {
+		case entity.IsPayslipItemTypeDeduction(result.Component.ItemType):
+			netPay -= result.Amount
+		case !entity.IsPayslipItemTypeEmployerCost(result.Component.ItemType):
+			netPay += result.Amount
+		}
+	}
+
+	result := payrollRuleResult{Details: []entity.PayslipDetailInputParam{}}
+	for _, loan := range input.Loans {
+		instalment := loan.DueInstalment()
+		if instalment <= 0 {
+			continue
+		}
+
+		rate, err := a.getExchangeRate(input, loan.Currency)
+		if err != nil {
+			return payrollRuleResult{}, err
+		}
+
+		if availablePay := max(netPay-result.Amount, 0); instalment.MulDiv(rate, salaryRate) > availablePay {
+			instalment = availablePay.MulDiv(salaryRate, rate)
+
+			// converting back and forth may round a cent above the pay left
+			if instalment.MulDiv(rate, salaryRate) > availablePay {
+				instalment--
+			}
+		}
+
+		if instalment <= 0 {
+			continue
+		}
+
+		result.Amount += instalment.MulDiv(rate, salaryRate)
+
+		result.Details = append(result.Details, entity.PayslipDetailInputParam{
+			ItemType:    component.ItemType,
+			Description: fmt.Sprintf("%v Instalment, %v of %v Remaining", loan.Description, loan.RemainingBalance-instalment, loan.Principal),
+			Amount:      instalment,
+			Currency:    loan.Currency,
+		})
+
+		result.LoanRepayments = append(result.LoanRepayments, loanRepayment{
+			Loan:   loan,
+			Amount: instalment,
+		})
+	}
+
+	return result, nil
+}
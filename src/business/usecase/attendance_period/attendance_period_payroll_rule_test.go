@@ -0,0 +1,870 @@
+package attendance_period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	mock_parser "github.com/reyhanmichiels/go-pkg/v2/tests/mock/parser"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_attendancePeriod_runPayrollRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJson := mock_parser.NewMockJSONInterface(ctrl)
+
+	uc := Init(InitParam{
+		Json: mockJson,
+	}).(*attendancePeriod)
+
+	basePayComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeBasePay,
+		ItemType:        entity.PayslipItemTypeEarningBasePay,
+		EvaluationOrder: 1,
+	}
+	overtimeComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeOvertime,
+		ItemType:        entity.PayslipItemTypeEarningOvertime,
+		EvaluationOrder: 2,
+		Config:          null.StringFrom(`{"hoursPerDay": 4, "rates": {"WORKDAY": [{"fromHour": 0, "toHour": 1, "multiplier": 1.5}, {"fromHour": 1, "multiplier": 2}]}}`),
+	}
+
+	mockOvertimeRuleConfig := overtimeRuleConfig{
+		HoursPerDay: 4,
+		Rates: map[string][]overtimeRateBand{
+			entity.OvertimeDayTypeWorkday: {
+				{FromHour: 0, ToHour: 1, Multiplier: 1.5},
+				{FromHour: 1, Multiplier: 2},
+			},
+		},
+	}
+	allowanceComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeAllowance,
+		ItemType:        entity.PayslipItemTypeEarningAllowance,
+		EvaluationOrder: 3,
+	}
+	earningAdjustmentComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeEarningAdjustment,
+		ItemType:        entity.PayslipItemTypeEarningAdjustment,
+		EvaluationOrder: 4,
+	}
+	deductionAdjustmentComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeDeductionAdjustment,
+		ItemType:        entity.PayslipItemTypeDeductionAdjustment,
+		EvaluationOrder: 9,
+	}
+	loanRepaymentComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeLoanRepayment,
+		ItemType:        entity.PayslipItemTypeDeductionLoan,
+		EvaluationOrder: 10,
+	}
+	attendancePenaltyComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeAttendancePenalty,
+		ItemType:        entity.PayslipItemTypeDeductionAttendancePenalty,
+		EvaluationOrder: 9,
+	}
+	reimbursementComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeReimbursement,
+		ItemType:        entity.PayslipItemTypeReimbursement,
+		EvaluationOrder: 3,
+	}
+
+	taxComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeTax,
+		ItemType:        entity.PayslipItemTypeDeductionTax,
+		EvaluationOrder: 4,
+		Config:          null.StringFrom(`{"periodsPerYear": 12, "personalRelief": 8050000, "marriedRelief": 1000000, "dependantRelief": 1000000, "maxDependants": 1}`),
+	}
+
+	mockTaxRuleConfig := taxRuleConfig{
+		PeriodsPerYear:  12,
+		PersonalRelief:  entity.NewMoney(8050000),
+		MarriedRelief:   entity.NewMoney(1000000),
+		DependantRelief: entity.NewMoney(1000000),
+		MaxDependants:   1,
+	}
+
+	pensionComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodePension,
+		Name:            "Pension",
+		ItemType:        entity.PayslipItemTypeDeductionContribution,
+		EvaluationOrder: 5,
+		Config:          null.StringFrom(`{"employeeRate": 0.02, "employerRate": 0.04}`),
+	}
+	healthInsuranceComponent := entity.PayComponent{
+		Code:            entity.PayComponentCodeHealthInsurance,
+		Name:            "Health Insurance",
+		ItemType:        entity.PayslipItemTypeDeductionContribution,
+		EvaluationOrder: 6,
+		Config:          null.StringFrom(`{"employeeRate": 0.01, "employerRate": 0.04, "salaryCap": 1000000}`),
+	}
+
+	mockInput := payrollInput{
+		User: entity.User{
+			BaseSalary:       entity.NewMoney(2000000),
+			TaxMaritalStatus: entity.UserTaxMaritalStatusMarried,
+			TaxDependants:    2,
+		},
+		TotalWorkingDays:    20,
+		EmployedWorkingDays: 20,
+		TotalAttendance:     18,
+		Overtimes: []entity.Overtime{
+			{OvertimeDate: null.DateFrom(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)), OvertimeHour: 2},
+		},
+		Reimbursements: []entity.Reimbursement{
+			{Description: "Taxi", Amount: entity.NewMoney(75000)},
+		},
+		TaxBrackets: []entity.TaxBracket{
+			{LowerBound: 0, UpperBound: entity.NullMoneyFrom(entity.NewMoney(6000000)), Rate: 0.05},
+			{LowerBound: entity.NewMoney(6000000), Rate: 0.1},
+		},
+	}
+
+	mockLoansAboveNetPay := []entity.Loan{
+		{ID: 1, Description: "Laptop Loan", Principal: entity.NewMoney(1000000), InstalmentAmount: entity.NewMoney(333334), RemainingBalance: entity.NewMoney(666668)},
+		{ID: 2, Description: "Salary Advance", Principal: entity.NewMoney(900000), InstalmentAmount: entity.NewMoney(300000), RemainingBalance: entity.NewMoney(600000)},
+		{ID: 4, Description: "Relocation Loan", Principal: entity.NewMoney(1000), InstalmentAmount: entity.NewMoney(100), RemainingBalance: entity.NewMoney(1000), Currency: "USD"},
+	}
+
+	tests := []struct {
+		name           string
+		components     []entity.PayComponent
+		input          payrollInput
+		mockFunc       func()
+		want           entity.PayslipInputParam
+		wantTotals     []entity.PayslipCurrencyTotalInputParam
+		wantRepayments []loanRepayment
+		wantDetail     int
+		wantErr        bool
+	}{
+		{
+			name:       "Success",
+			components: []entity.PayComponent{basePayComponent, overtimeComponent, reimbursementComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(overtimeComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*overtimeRuleConfig) = mockOvertimeRuleConfig
+						return nil
+					},
+				)
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(87500),
+				ReimbursementComponent: entity.NewMoney(75000),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1962500),
+			},
+			wantDetail: 4,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Overtime On Public Holiday",
+			components: []entity.PayComponent{basePayComponent, overtimeComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(overtimeComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*overtimeRuleConfig) = overtimeRuleConfig{
+							HoursPerDay: 4,
+							Rates: map[string][]overtimeRateBand{
+								entity.OvertimeDayTypeWorkday:       mockOvertimeRuleConfig.Rates[entity.OvertimeDayTypeWorkday],
+								entity.OvertimeDayTypePublicHoliday: {{FromHour: 0, Multiplier: 3}},
+							},
+						}
+						return nil
+					},
+				)
+			},
+			input: payrollInput{
+				User:                mockInput.User,
+				Holidays:            entity.NewHolidayCalendar([]entity.Holiday{{HolidayDate: null.DateFrom(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)), Name: "Eid al-Adha"}}, ""),
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Overtimes:           mockInput.Overtimes,
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(150000),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1950000),
+			},
+			wantDetail: 2,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Overtime On Rest Day Of Work Schedule",
+			components: []entity.PayComponent{basePayComponent, overtimeComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(overtimeComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*overtimeRuleConfig) = overtimeRuleConfig{
+							HoursPerDay: 4,
+							Rates: map[string][]overtimeRateBand{
+								entity.OvertimeDayTypeWorkday: mockOvertimeRuleConfig.Rates[entity.OvertimeDayTypeWorkday],
+								entity.OvertimeDayTypeRestDay: {{FromHour: 0, Multiplier: 2}},
+							},
+						}
+						return nil
+					},
+				)
+			},
+			input: payrollInput{
+				User: mockInput.User,
+				WorkCalendar: entity.NewWorkCalendar(
+					[]entity.UserWorkSchedule{{WorkScheduleID: 1, EffectiveDate: null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))}},
+					map[int64]entity.WorkSchedule{1: {ID: 1, Name: "Warehouse", WorkDays: "TUE,WED,THU,FRI,SAT"}},
+				),
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Overtimes: []entity.Overtime{
+					{OvertimeDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)), OvertimeHour: 2},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(100000),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1900000),
+			},
+			wantDetail: 2,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Mid Period Joiner",
+			components: []entity.PayComponent{basePayComponent},
+			mockFunc:   func() {},
+			// attendance recorded before the hire date is not paid
+			input: payrollInput{
+				User:                mockInput.User,
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 10,
+				TotalAttendance:     12,
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1000000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1000000),
+			},
+			wantDetail: 1,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Salary Change Mid Period",
+			components: []entity.PayComponent{basePayComponent},
+			mockFunc:   func() {},
+			input: payrollInput{
+				User:                entity.User{BaseSalary: entity.NewMoney(3000000)},
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				SalarySegments: []entity.SalarySegment{
+					{BaseSalary: entity.NewMoney(2000000), StartDate: null.DateFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)), WorkingDays: 10},
+					{BaseSalary: entity.NewMoney(3000000), StartDate: null.DateFrom(time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC)), WorkingDays: 10},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(2250000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(2250000),
+			},
+			wantDetail: 2,
+			wantErr:    false,
+		},
+		{
+			name:       "Success Without Overtime Component",
+			components: []entity.PayComponent{basePayComponent, reimbursementComponent},
+			mockFunc:   func() {},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(75000),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1875000),
+			},
+			wantDetail: 2,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Allowances",
+			components: []entity.PayComponent{basePayComponent, allowanceComponent},
+			mockFunc:   func() {},
+			input: payrollInput{
+				User:                mockInput.User,
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Allowances: []entity.UserAllowance{
+					{AllowanceTypeID: 1, Amount: entity.NewMoney(500000), IsProrated: true},
+					{AllowanceTypeID: 2, Amount: entity.NewMoney(1000000)},
+					{AllowanceTypeID: 3, Amount: entity.NewMoney(300000)},
+				},
+				AllowanceTypes: map[int64]entity.AllowanceType{
+					1: {ID: 1, Code: "TRANSPORT", Name: "Transport Allowance", IsProrated: true},
+					2: {ID: 2, Code: "POSITION", Name: "Position Allowance"},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				AllowanceComponent:     entity.NewMoney(1450000),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(3250000),
+			},
+			wantDetail: 3,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Attendance Types",
+			components: []entity.PayComponent{basePayComponent, allowanceComponent},
+			mockFunc:   func() {},
+			input: payrollInput{
+				User:                mockInput.User,
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     14,
+				Attendances: append(
+					mockAttendancesOfType(entity.AttendanceTypeCodeWorkFromHome, 10),
+					append(mockAttendancesOfType(entity.AttendanceTypeCodeFieldVisit, 2), mockAttendancesOfType(entity.AttendanceTypeCodeOffice, 2)...)...,
+				),
+				AttendanceTypes: map[string]entity.AttendanceType{
+					entity.AttendanceTypeCodeOffice:       {Code: entity.AttendanceTypeCodeOffice, Name: "Office"},
+					entity.AttendanceTypeCodeWorkFromHome: {Code: entity.AttendanceTypeCodeWorkFromHome, Name: "Work From Home", MaxDaysPerPeriod: 8},
+					entity.AttendanceTypeCodeFieldVisit:   {Code: entity.AttendanceTypeCodeFieldVisit, Name: "Field Visit", DailyAllowance: entity.NewMoney(150000)},
+				},
+				Allowances: []entity.UserAllowance{
+					{AllowanceTypeID: 1, Amount: entity.NewMoney(500000), IsProrated: true},
+				},
+				AllowanceTypes: map[int64]entity.AllowanceType{
+					1: {ID: 1, Code: "TRANSPORT", Name: "Transport Allowance", IsProrated: true},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1200000),
+				OvertimeComponent:      entity.NewMoney(0),
+				AllowanceComponent:     entity.NewMoney(600000),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(0),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1800000),
+			},
+			wantDetail: 3,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Adjustments",
+			components: []entity.PayComponent{basePayComponent, earningAdjustmentComponent, deductionAdjustmentComponent},
+			mockFunc:   func() {},
+			input: payrollInput{
+				User:                mockInput.User,
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Adjustments: []entity.PayrollAdjustment{
+					{AdjustmentType: entity.PayrollAdjustmentTypeEarning, Amount: entity.NewMoney(1000000), Reason: "Q2 Performance Bonus"},
+					{AdjustmentType: entity.PayrollAdjustmentTypeDeduction, Amount: entity.NewMoney(150000), Reason: "Overpaid Overtime Clawback"},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				AdjustmentComponent:    entity.NewMoney(1000000),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(150000),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(2650000),
+			},
+			wantDetail: 3,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Per Minute Attendance Penalty",
+			components: []entity.PayComponent{basePayComponent, attendancePenaltyComponent},
+			mockFunc:   func() {},
+			// a check-in within the grace minutes and a missing check-out are not penalised
+			input: payrollInput{
+				User: mockInput.User,
+				WorkCalendar: entity.NewWorkCalendar(
+					[]entity.UserWorkSchedule{{WorkScheduleID: 1, EffectiveDate: null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))}},
+					map[int64]entity.WorkSchedule{1: {
+						ID:               1,
+						Name:             "Office",
+						WorkDays:         "MON,TUE,WED,THU,FRI",
+						ShiftStart:       "09:00",
+						ShiftEnd:         "17:00",
+						GraceMinutes:     10,
+						PenaltyType:      entity.WorkSchedulePenaltyTypePerMinute,
+						PenaltyPerMinute: entity.NewMoney(1000),
+					}},
+				),
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Attendances: []entity.Attendance{
+					{
+						AttendanceDate: null.DateFrom(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)),
+						CheckInAt:      null.TimeFrom(time.Date(2025, 6, 10, 9, 25, 0, 0, time.UTC)),
+						CheckOutAt:     null.TimeFrom(time.Date(2025, 6, 10, 16, 40, 0, 0, time.UTC)),
+					},
+					{
+						AttendanceDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+						CheckInAt:      null.TimeFrom(time.Date(2025, 6, 9, 9, 5, 0, 0, time.UTC)),
+						CheckOutAt:     null.TimeFrom(time.Date(2025, 6, 9, 17, 0, 0, 0, time.UTC)),
+					},
+					{
+						AttendanceDate: null.DateFrom(time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC)),
+						CheckInAt:      null.TimeFrom(time.Date(2025, 6, 11, 9, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(45000),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1755000),
+			},
+			wantDetail: 3,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Half Day Attendance Penalty",
+			components: []entity.PayComponent{basePayComponent, attendancePenaltyComponent},
+			mockFunc:   func() {},
+			// three lates convert to a single half day, the late check-in on the Saturday day off is not counted
+			input: payrollInput{
+				User: mockInput.User,
+				WorkCalendar: entity.NewWorkCalendar(
+					[]entity.UserWorkSchedule{{WorkScheduleID: 1, EffectiveDate: null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))}},
+					map[int64]entity.WorkSchedule{1: {
+						ID:                1,
+						Name:              "Office",
+						WorkDays:          "MON,TUE,WED,THU,FRI",
+						ShiftStart:        "09:00",
+						ShiftEnd:          "17:00",
+						PenaltyType:       entity.WorkSchedulePenaltyTypeHalfDay,
+						HalfDayAfterLates: 2,
+					}},
+				),
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Attendances: []entity.Attendance{
+					{
+						AttendanceDate: null.DateFrom(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+						CheckInAt:      null.TimeFrom(time.Date(2025, 6, 9, 9, 30, 0, 0, time.UTC)),
+					},
+					{
+						AttendanceDate: null.DateFrom(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)),
+						CheckInAt:      null.TimeFrom(time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)),
+						CheckOutAt:     null.TimeFrom(time.Date(2025, 6, 10, 15, 0, 0, 0, time.UTC)),
+					},
+					{
+						AttendanceDate: null.DateFrom(time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC)),
+						CheckInAt:      null.TimeFrom(time.Date(2025, 6, 11, 9, 1, 0, 0, time.UTC)),
+					},
+					{
+						AttendanceDate: null.DateFrom(time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)),
+						CheckInAt:      null.TimeFrom(time.Date(2025, 6, 14, 11, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(50000),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1750000),
+			},
+			wantDetail: 2,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Loan Repayment",
+			components: []entity.PayComponent{basePayComponent, loanRepaymentComponent},
+			mockFunc:   func() {},
+			input: payrollInput{
+				User:                mockInput.User,
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Loans: []entity.Loan{
+					{ID: 1, Description: "Laptop Loan", Principal: entity.NewMoney(1000000), InstalmentAmount: entity.NewMoney(333334), RemainingBalance: entity.NewMoney(666668)},
+					{ID: 2, Description: "Salary Advance", Principal: entity.NewMoney(900000), InstalmentAmount: entity.NewMoney(300000), RemainingBalance: entity.NewMoney(100000)},
+					{ID: 3, Description: "Paid Off Loan", Principal: entity.NewMoney(500000), InstalmentAmount: entity.NewMoney(500000), RemainingBalance: entity.NewMoney(0)},
+				},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(433334),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1366666),
+			},
+			wantDetail: 3,
+			wantErr:    false,
+		},
+		{
+			// the net pay left before the loans only covers part of the instalments, the rest stays in the balance
+			name:       "Success With Loan Repayment Above Net Pay",
+			components: []entity.PayComponent{basePayComponent, deductionAdjustmentComponent, loanRepaymentComponent},
+			mockFunc:   func() {},
+			input: payrollInput{
+				User:                entity.User{BaseSalary: entity.NewMoney(500000)},
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Adjustments: []entity.PayrollAdjustment{
+					{AdjustmentType: entity.PayrollAdjustmentTypeDeduction, Amount: entity.NewMoney(50000), Reason: "Uniform"},
+				},
+				Loans:         mockLoansAboveNetPay,
+				ExchangeRates: map[string]float64{"USD": 16000},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(450000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(450000),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(0),
+			},
+			wantRepayments: []loanRepayment{
+				{Loan: mockLoansAboveNetPay[0], Amount: entity.NewMoney(333334)},
+				{Loan: mockLoansAboveNetPay[1], Amount: entity.NewMoney(66666)},
+			},
+			wantDetail: 4,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Tax Deduction",
+			components: []entity.PayComponent{basePayComponent, overtimeComponent, reimbursementComponent, taxComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(overtimeComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*overtimeRuleConfig) = mockOvertimeRuleConfig
+						return nil
+					},
+				)
+				mockJson.EXPECT().Unmarshal([]byte(taxComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*taxRuleConfig) = mockTaxRuleConfig
+						return nil
+					},
+				)
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(87500),
+				ReimbursementComponent: entity.NewMoney(75000),
+				DeductionComponent:     entity.NewMoney(80000),
+				TaxDeduction:           entity.NewMoney(80000),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(1882500),
+			},
+			wantDetail: 5,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Contributions",
+			components: []entity.PayComponent{basePayComponent, reimbursementComponent, pensionComponent, healthInsuranceComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(pensionComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*contributionRuleConfig) = contributionRuleConfig{EmployeeRate: 0.02, EmployerRate: 0.04}
+						return nil
+					},
+				)
+				mockJson.EXPECT().Unmarshal([]byte(healthInsuranceComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*contributionRuleConfig) = contributionRuleConfig{EmployeeRate: 0.01, EmployerRate: 0.04, SalaryCap: entity.NewMoney(1000000)}
+						return nil
+					},
+				)
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(1800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(75000),
+				DeductionComponent:     entity.NewMoney(46000),
+				EmployeeContribution:   entity.NewMoney(46000),
+				EmployerContribution:   entity.NewMoney(112000),
+				TotalTakeHomePay:       entity.NewMoney(1829000),
+			},
+			wantDetail: 6,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Contributions For Mid Period Joiner",
+			components: []entity.PayComponent{basePayComponent, pensionComponent, healthInsuranceComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(pensionComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*contributionRuleConfig) = contributionRuleConfig{EmployeeRate: 0.02, EmployerRate: 0.04}
+						return nil
+					},
+				)
+				mockJson.EXPECT().Unmarshal([]byte(healthInsuranceComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*contributionRuleConfig) = contributionRuleConfig{EmployeeRate: 0.01, EmployerRate: 0.04, SalaryFloor: entity.NewMoney(500000)}
+						return nil
+					},
+				)
+			},
+			// contributions are taken from the base pay of the 2 employed days, the floor still applies
+			input: payrollInput{
+				User:                mockInput.User,
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 2,
+				TotalAttendance:     2,
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(200000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(0),
+				DeductionComponent:     entity.NewMoney(9000),
+				EmployeeContribution:   entity.NewMoney(9000),
+				EmployerContribution:   entity.NewMoney(28000),
+				TotalTakeHomePay:       entity.NewMoney(191000),
+			},
+			wantDetail: 5,
+			wantErr:    false,
+		},
+		{
+			name:       "Success With Foreign Salary Currency",
+			components: []entity.PayComponent{basePayComponent, reimbursementComponent, taxComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(taxComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*taxRuleConfig) = mockTaxRuleConfig
+						return nil
+					},
+				)
+			},
+			input: payrollInput{
+				User: entity.User{
+					BaseSalary:       entity.NewMoney(2000),
+					Currency:         "USD",
+					TaxMaritalStatus: entity.UserTaxMaritalStatusMarried,
+					TaxDependants:    2,
+				},
+				TotalWorkingDays:    20,
+				EmployedWorkingDays: 20,
+				TotalAttendance:     18,
+				Reimbursements: []entity.Reimbursement{
+					{Description: "Taxi", Amount: entity.NewMoney(75000), Currency: entity.ReportingCurrency},
+				},
+				TaxBrackets:   mockInput.TaxBrackets,
+				ExchangeRates: map[string]float64{entity.ReportingCurrency: 1, "USD": 16000},
+			},
+			want: entity.PayslipInputParam{
+				BasePayComponent:       entity.NewMoney(28800000),
+				OvertimeComponent:      entity.NewMoney(0),
+				ReimbursementComponent: entity.NewMoney(75000),
+				DeductionComponent:     entity.NewMoney(2771200),
+				TaxDeduction:           entity.NewMoney(2771200),
+				EmployerContribution:   entity.NewMoney(0),
+				TotalTakeHomePay:       entity.NewMoney(26103800),
+			},
+			wantTotals: []entity.PayslipCurrencyTotalInputParam{
+				{
+					Currency:               entity.ReportingCurrency,
+					ExchangeRate:           1,
+					ReimbursementComponent: entity.NewMoney(75000),
+					TotalTakeHomePay:       entity.NewMoney(75000),
+				},
+				{
+					Currency:           "USD",
+					ExchangeRate:       16000,
+					BasePayComponent:   entity.NewMoney(1800),
+					DeductionComponent: entity.Money(17320),
+					TotalTakeHomePay:   entity.Money(162680),
+				},
+			},
+			wantDetail: 3,
+			wantErr:    false,
+		},
+		{
+			name:       "Failed Missing Exchange Rate",
+			components: []entity.PayComponent{basePayComponent, taxComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(taxComponent.Config.String), gomock.Any()).Return(nil)
+			},
+			input:   payrollInput{User: entity.User{BaseSalary: entity.NewMoney(2000), Currency: "USD"}, TotalWorkingDays: 20, EmployedWorkingDays: 20, TotalAttendance: 18},
+			wantErr: true,
+		},
+		{
+			name:       "Failed Contribution Cap Below Floor",
+			components: []entity.PayComponent{healthInsuranceComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(healthInsuranceComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						*v.(*contributionRuleConfig) = contributionRuleConfig{EmployeeRate: 0.01, SalaryFloor: entity.NewMoney(2000000), SalaryCap: entity.NewMoney(1000000)}
+						return nil
+					},
+				)
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Failed Unknown Pay Component",
+			components: []entity.PayComponent{basePayComponent, {Code: "UNKNOWN"}},
+			mockFunc:   func() {},
+			wantErr:    true,
+		},
+		{
+			name:       "Failed Missing Overtime Rate For Day Type",
+			components: []entity.PayComponent{overtimeComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(overtimeComponent.Config.String), gomock.Any()).DoAndReturn(
+					func(_ []byte, v interface{}) error {
+						v.(*overtimeRuleConfig).HoursPerDay = 4
+						return nil
+					},
+				)
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Failed No Tax Bracket Effective",
+			components: []entity.PayComponent{basePayComponent, taxComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(taxComponent.Config.String), gomock.Any()).Return(nil)
+			},
+			input:   payrollInput{User: entity.User{BaseSalary: entity.NewMoney(2000000)}, TotalWorkingDays: 20, EmployedWorkingDays: 20, TotalAttendance: 18},
+			wantErr: true,
+		},
+		{
+			name:       "Failed Unmarshal Config",
+			components: []entity.PayComponent{overtimeComponent},
+			mockFunc: func() {
+				mockJson.EXPECT().Unmarshal([]byte(overtimeComponent.Config.String), gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			input := mockInput
+			if tt.input.TotalWorkingDays > 0 {
+				input = tt.input
+			}
+
+			results, err := uc.runPayrollRules(tt.components, input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendancePeriod.runPayrollRules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			got, currencyTotals, details, err := uc.toPayslipInputParam(results, input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, details, tt.wantDetail)
+			if tt.wantTotals != nil {
+				assert.Equal(t, tt.wantTotals, currencyTotals)
+			}
+			if tt.wantRepayments != nil {
+				var repayments []loanRepayment
+				for _, result := range results {
+					repayments = append(repayments, result.LoanRepayments...)
+				}
+				assert.Equal(t, tt.wantRepayments, repayments)
+			}
+
+			// the detail lines converted to the reporting currency must reconcile with the stored totals to the cent
+			var takeHomePay entity.Money
+			for _, detail := range details {
+				amount := detail.Amount
+				if rate, ok := input.ExchangeRates[detail.Currency]; ok {
+					amount = amount.MulRate(rate)
+				}
+
+				switch {
+				case entity.IsPayslipItemTypeDeduction(detail.ItemType):
+					takeHomePay -= amount
+				case !entity.IsPayslipItemTypeEmployerCost(detail.ItemType):
+					takeHomePay += amount
+				}
+			}
+			assert.Equal(t, got.TotalTakeHomePay, takeHomePay)
+		})
+	}
+}
+
+// mockAttendancesOfType returns the given number of attendances of the attendance type.
+func mockAttendancesOfType(attendanceType string, days int) []entity.Attendance {
+	attendances := make([]entity.Attendance, 0, days)
+	for range days {
+		attendances = append(attendances, entity.Attendance{AttendanceType: attendanceType})
+	}
+
+	return attendances
+}
+
+func Test_overtimeRateBand_hoursWithin(t *testing.T) {
+	tests := []struct {
+		name         string
+		band         overtimeRateBand
+		overtimeHour float64
+		want         float64
+	}{
+		{
+			name:         "Overtime Covers Bounded Band",
+			band:         overtimeRateBand{FromHour: 0, ToHour: 1, Multiplier: 1.5},
+			overtimeHour: 3,
+			want:         1,
+		},
+		{
+			name:         "Overtime Ends Inside Bounded Band",
+			band:         overtimeRateBand{FromHour: 0, ToHour: 1, Multiplier: 1.5},
+			overtimeHour: 0.5,
+			want:         0.5,
+		},
+		{
+			name:         "Overtime Reaches Open Ended Band",
+			band:         overtimeRateBand{FromHour: 1, Multiplier: 2},
+			overtimeHour: 2.5,
+			want:         1.5,
+		},
+		{
+			name:         "Overtime Ends Before Band",
+			band:         overtimeRateBand{FromHour: 1, Multiplier: 2},
+			overtimeHour: 1,
+			want:         0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.band.hoursWithin(tt.overtimeHour))
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	"github.com/reyhanmichiels/go-pkg/v2/codes"
 	"github.com/reyhanmichiels/go-pkg/v2/errors"
@@ -26,20 +25,20 @@ func (a *attendancePeriod) PubSubGeneratePayroll(
 	)
 
 	defer func() {
-		a.handleGeneratePayrollFailure(ctx, body.LoginUser.ID, body.AttendancePeriod.ID, err)
+		a.handleGeneratePayrollFailure(ctx, body.LoginUser.ID, body.AttendancePeriod.ID, body.PayrollRun.ID, err)
 	}()
 
 	if err = a.json.Unmarshal([]byte(message.Payload), &body); err != nil {
 		return errors.NewWithCode(codes.CodeJSONUnmarshalError, "failed to unmarshal message body: %s", err.Error())
 	}
 
-	users, _, err := a.userDom.GetList(
+	payrollRun, err := a.payrollRunDom.Get(
 		ctx,
-		entity.UserParam{
-			RoleID: entity.RoleIDUser,
+		entity.PayrollRunParam{
+			ID:          body.PayrollRun.ID,
+			BypassCache: true,
 			QueryOption: query.Option{
-				IsActive:     true,
-				DisableLimit: true,
+				IsActive: true,
 			},
 		},
 	)
@@ -47,128 +46,149 @@ func (a *attendancePeriod) PubSubGeneratePayroll(
 		return err
 	}
 
-	// Use goroutines to fetch data concurrently
-	var (
-		userAttendanceCount    map[int64]int64
-		userIDToReimbursements map[int64][]entity.Reimbursement
-		userIDToOvertimes      map[int64][]entity.Overtime
-	)
+	// a redelivered message of a run that has already finished or been voided has nothing left to do
+	if payrollRun.RunStatus != entity.PayrollRunStatusProcessing {
+		a.log.Info(ctx, fmt.Sprintf("skip payroll run %d with status %s", payrollRun.ID, payrollRun.RunStatus))
+		return nil
+	}
 
-	g, gctx := errgroup.WithContext(ctx)
+	users, err := a.getPayrollEmployees(ctx, body.AttendancePeriod)
+	if err != nil {
+		return err
+	}
 
-	g.Go(func() error {
-		var err error
-		userAttendanceCount, err = a.attendanceDom.CountUserAttendance(gctx, body.AttendancePeriod.ID)
+	data, err := a.getPayrollData(ctx, body.AttendancePeriod)
+	if err != nil {
 		return err
-	})
+	}
 
-	g.Go(func() error {
-		var err error
-		userIDToReimbursements, err = a.getUserIDToReimbursements(gctx, body.AttendancePeriod.StartDate, body.AttendancePeriod.EndDate)
+	userIDToPayslip, err := a.getUserIDToPayslip(ctx, payrollRun.ID)
+	if err != nil {
 		return err
-	})
+	}
 
-	g.Go(func() error {
-		var err error
-		userIDToOvertimes, err = a.getUserIDToOvertimes(gctx, body.AttendancePeriod.StartDate, body.AttendancePeriod.EndDate)
+	userIDToPayrollRunEmployee, err := a.getUserIDToPayrollRunEmployee(ctx, payrollRun.ID)
+	if err != nil {
 		return err
-	})
+	}
+
+	// employees that already have a payslip in this run were generated by an earlier delivery
+	pendingUsers := make([]entity.User, 0, len(users))
+	for _, user := range users {
+		if _, ok := userIDToPayslip[user.ID]; !ok {
+			pendingUsers = append(pendingUsers, user)
+		}
+	}
 
-	if err = g.Wait(); err != nil {
+	var (
+		progressMu     sync.Mutex
+		processedCount = int64(len(users) - len(pendingUsers))
+		failedCount    int64
+	)
+
+	err = a.payrollRunDom.Update(
+		ctx,
+		entity.PayrollRunUpdateParam{
+			TotalEmployee:     null.Int64From(int64(len(users))),
+			ProcessedEmployee: null.Int64From(processedCount),
+			FailedEmployee:    null.Int64From(failedCount),
+			StartedAt:         null.TimeFrom(Now()),
+			FinishedAt:        null.Time{SqlNull: true},
+			UpdatedAt:         null.TimeFrom(Now()),
+			UpdatedBy:         null.Int64From(body.LoginUser.ID),
+		},
+		entity.PayrollRunParam{
+			ID: payrollRun.ID,
+		},
+	)
+	if err != nil {
 		return err
 	}
 
-	totalWorkingDays := body.AttendancePeriod.TotalWorkingDays()
-	currentTime := null.TimeFrom(Now())
-	userID := null.Int64From(body.LoginUser.ID)
+	// reportProgress counts a finished employee and stores the counts on the run, it is serialized
+	// so a slower update never overwrites the counts of a later one.
+	reportProgress := func(failed bool) {
+		progressMu.Lock()
+		defer progressMu.Unlock()
 
-	// Now implement goroutines for processing users
-	err = a.transactor.Execute(ctx, "txPubSubGeneratePayroll", sql.TxOptions{}, func(ctx context.Context) error {
-		const workerPoolSize = 5
-		sem := make(chan struct{}, workerPoolSize)
-		errChan := make(chan error, len(users))
-		var wgUsers sync.WaitGroup
-
-		for _, user := range users {
-			wgUsers.Add(1)
-
-			// Capture user variable for goroutine
-			currentUser := user
-
-			// Acquire semaphore slot
-			sem <- struct{}{}
-
-			go func() {
-				defer wgUsers.Done()
-				defer func() { <-sem }() // Release semaphore slot
-
-				var payslipDetailInputParams []entity.PayslipDetailInputParam
-
-				proratedSalary := currentUser.BaseSalary / float64(totalWorkingDays)
-
-				basePayComponent, basePayDetail := a.calculateBasePayComponentAndDetail(
-					userAttendanceCount[currentUser.ID],
-					totalWorkingDays,
-					proratedSalary,
-				)
-
-				overtimePayComponent, overtimeDetails := a.calculateOvertimePayComponentAndDetail(
-					proratedSalary,
-					userIDToOvertimes[currentUser.ID],
-				)
-
-				reimbursementPayComponent, reimbursementDetails := a.calculateReimbursementPayComponentAndDetail(
-					proratedSalary,
-					userIDToReimbursements[currentUser.ID],
-				)
-
-				payslip, err := a.payslipDom.Create(
-					ctx,
-					entity.PayslipInputParam{
-						UserID:                 currentUser.ID,
-						AttendancePeriodID:     body.AttendancePeriod.ID,
-						BasePayComponent:       null.Float64From(basePayComponent),
-						OvertimeComponent:      null.Float64From(overtimePayComponent),
-						ReimbursementComponent: null.Float64From(reimbursementPayComponent),
-						TotalTakeHomePay:       null.Float64From(basePayComponent + overtimePayComponent + reimbursementPayComponent),
-						CreatedAt:              currentTime,
-						CreatedBy:              userID,
-					},
-				)
-				if err != nil {
-					errChan <- err
-					return
-				}
-
-				payslipDetailInputParams = append([]entity.PayslipDetailInputParam{basePayDetail}, overtimeDetails...)
-				payslipDetailInputParams = append(payslipDetailInputParams, reimbursementDetails...)
-
-				a.setPayslipIDToDetails(
-					payslipDetailInputParams,
-					payslip.ID,
-					currentTime,
-					userID,
-				)
-
-				err = a.payslipDetailDom.CreateMany(ctx, payslipDetailInputParams)
-				if err != nil {
-					errChan <- err
-					return
-				}
-			}()
+		processedCount++
+		if failed {
+			failedCount++
+		}
+
+		err := a.payrollRunDom.Update(
+			ctx,
+			entity.PayrollRunUpdateParam{
+				ProcessedEmployee: null.Int64From(processedCount),
+				FailedEmployee:    null.Int64From(failedCount),
+				UpdatedAt:         null.TimeFrom(Now()),
+				UpdatedBy:         null.Int64From(body.LoginUser.ID),
+			},
+			entity.PayrollRunParam{
+				ID: payrollRun.ID,
+			},
+		)
+		if err != nil {
+			a.log.Error(ctx, fmt.Sprintf("failed to report progress of payroll run %d: %s", payrollRun.ID, err.Error()))
 		}
+	}
+
+	const workerPoolSize = 5
+	sem := make(chan struct{}, workerPoolSize)
+	var wgUsers sync.WaitGroup
+
+	for _, user := range pendingUsers {
+		wgUsers.Add(1)
+
+		// Capture user variable for goroutine
+		currentUser := user
+
+		// Acquire semaphore slot
+		sem <- struct{}{}
+
+		go func() {
+			defer wgUsers.Done()
+			defer func() { <-sem }() // Release semaphore slot
 
-		// Wait for all user processing to finish
-		wgUsers.Wait()
-		close(errChan)
+			payrollRunEmployee := userIDToPayrollRunEmployee[currentUser.ID]
 
-		// Check if any errors occurred
-		for err := range errChan {
+			err := a.generateEmployeePayslip(ctx, body, data, currentUser, payrollRunEmployee)
+			if err == nil {
+				reportProgress(false)
+				return
+			}
+
+			a.log.Error(ctx, fmt.Sprintf("failed to generate payslip of user %d in payroll run %d: %s", currentUser.ID, payrollRun.ID, err.Error()))
+
+			err = a.savePayrollRunEmployee(
+				ctx,
+				payrollRunEmployee,
+				entity.PayrollRunEmployeeInputParam{
+					PayrollRunID:   payrollRun.ID,
+					UserID:         currentUser.ID,
+					EmployeeStatus: entity.PayrollRunEmployeeStatusFailed,
+					ProcessError:   null.StringFrom(err.Error()),
+					CreatedAt:      null.TimeFrom(Now()),
+					CreatedBy:      null.Int64From(body.LoginUser.ID),
+				},
+			)
 			if err != nil {
-				return err
+				a.log.Error(ctx, fmt.Sprintf("failed to record payslip failure of user %d in payroll run %d: %s", currentUser.ID, payrollRun.ID, err.Error()))
 			}
-		}
 
+			reportProgress(true)
+		}()
+	}
+
+	// Wait for all user processing to finish
+	wgUsers.Wait()
+
+	if failedCount > 0 {
+		err = errors.NewWithCode(codes.CodeInternalServerError, "failed to generate payslip of %d employees, resume the payroll run to retry them", failedCount)
+		return err
+	}
+
+	err = a.transactor.Execute(ctx, "txPubSubGeneratePayroll", sql.TxOptions{}, func(ctx context.Context) error {
 		err := a.attendancePeriodDom.Update(
 			ctx,
 			entity.AttendancePeriodUpdateParam{
@@ -184,6 +204,22 @@ func (a *attendancePeriod) PubSubGeneratePayroll(
 			return err
 		}
 
+		err = a.payrollRunDom.Update(
+			ctx,
+			entity.PayrollRunUpdateParam{
+				RunStatus:  entity.PayrollRunStatusProcessed,
+				FinishedAt: null.TimeFrom(Now()),
+				UpdatedAt:  null.TimeFrom(Now()),
+				UpdatedBy:  null.Int64From(body.LoginUser.ID),
+			},
+			entity.PayrollRunParam{
+				ID: body.PayrollRun.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -193,84 +229,254 @@ func (a *attendancePeriod) PubSubGeneratePayroll(
 	return nil
 }
 
-func (a *attendancePeriod) handleGeneratePayrollFailure(ctx context.Context, userID, attendancePeriodID int64, err error) {
+// generateEmployeePayslip calculates and stores the payslip of one employee in its own transaction,
+// so a failing employee never rolls back the payslips of the others.
+func (a *attendancePeriod) generateEmployeePayslip(
+	ctx context.Context,
+	body dto.PubSubGeneratePayrollMessage,
+	data payrollData,
+	user entity.User,
+	payrollRunEmployee entity.PayrollRunEmployee,
+) error {
+	payroll, err := a.calculateEmployeePayroll(body.AttendancePeriod, data, user)
 	if err != nil {
-		err := a.attendancePeriodDom.Update(
+		return err
+	}
+
+	currentTime := null.TimeFrom(Now())
+	userID := null.Int64From(body.LoginUser.ID)
+
+	return a.transactor.Execute(ctx, "txPubSubGenerateEmployeePayslip", sql.TxOptions{}, func(ctx context.Context) error {
+		payslipInputParam := payroll.Payslip
+		payslipInputParam.PayrollRunID = body.PayrollRun.ID
+		payslipInputParam.CreatedAt = currentTime
+		payslipInputParam.CreatedBy = userID
+
+		payslip, err := a.payslipDom.Create(ctx, payslipInputParam)
+		if err != nil {
+			return err
+		}
+
+		payslipDetailInputParams := payroll.Details
+		if len(payslipDetailInputParams) > 0 {
+			a.setPayslipIDToDetails(
+				payslipDetailInputParams,
+				payslip.ID,
+				currentTime,
+				userID,
+			)
+
+			err = a.payslipDetailDom.CreateMany(ctx, payslipDetailInputParams)
+			if err != nil {
+				return err
+			}
+		}
+
+		currencyTotalInputParams := payroll.CurrencyTotals
+		if len(currencyTotalInputParams) > 0 {
+			for i := range currencyTotalInputParams {
+				currencyTotalInputParams[i].PayslipID = payslip.ID
+				currencyTotalInputParams[i].PayrollRunID = body.PayrollRun.ID
+				currencyTotalInputParams[i].CreatedAt = currentTime
+				currencyTotalInputParams[i].CreatedBy = userID
+			}
+
+			err = a.payslipCurrencyTotalDom.CreateMany(ctx, currencyTotalInputParams)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = a.repayLoans(ctx, payroll.LoanRepayments, payslip, currentTime, userID)
+		if err != nil {
+			return err
+		}
+
+		err = a.updateYearToDateTotal(ctx, user.ID, body.AttendancePeriod.PayrollYear(), currentTime, userID, func(total *entity.YearToDateTotal) {
+			total.Add(payslip)
+		})
+		if err != nil {
+			return err
+		}
+
+		return a.savePayrollRunEmployee(
 			ctx,
-			entity.AttendancePeriodUpdateParam{
-				PeriodStatus:        entity.PeriodStatusProcessError,
-				PayrollProcessError: err.Error(),
-				UpdatedAt:           null.TimeFrom(Now()),
-				UpdatedBy:           null.Int64From(userID),
+			payrollRunEmployee,
+			entity.PayrollRunEmployeeInputParam{
+				PayrollRunID:   body.PayrollRun.ID,
+				UserID:         user.ID,
+				PayslipID:      null.Int64From(payslip.ID),
+				EmployeeStatus: entity.PayrollRunEmployeeStatusSuccess,
+				CreatedAt:      currentTime,
+				CreatedBy:      userID,
 			},
-			entity.AttendancePeriodParam{
-				ID: attendancePeriodID,
+		)
+	})
+}
+
+// repayLoans records the loan instalments withheld by a payslip and lowers the balance of their loans,
+// a loan is paid off once its balance reaches zero.
+func (a *attendancePeriod) repayLoans(
+	ctx context.Context,
+	repayments []loanRepayment,
+	payslip entity.Payslip,
+	currentTime null.Time,
+	userID null.Int64,
+) error {
+	if len(repayments) == 0 {
+		return nil
+	}
+
+	inputParams := make([]entity.LoanRepaymentInputParam, 0, len(repayments))
+	for _, repayment := range repayments {
+		remainingBalance := repayment.Loan.RemainingBalance - repayment.Amount
+
+		loanStatus := entity.LoanStatusActive
+		if remainingBalance <= 0 {
+			loanStatus = entity.LoanStatusPaidOff
+		}
+
+		err := a.loanDom.Update(
+			ctx,
+			entity.LoanUpdateParam{
+				RemainingBalance: entity.NullMoneyFrom(remainingBalance),
+				LoanStatus:       loanStatus,
+				UpdatedAt:        currentTime,
+				UpdatedBy:        userID,
+			},
+			entity.LoanParam{
+				ID: repayment.Loan.ID,
 			},
 		)
 		if err != nil {
-			a.log.Error(ctx, fmt.Sprintf("failed to handle generate payroll failure: %s", err.Error()))
+			return err
 		}
-	}
-}
 
-func (a *attendancePeriod) calculateBasePayComponentAndDetail(totalAttendance, totalWorkday int64, proratedSalary float64) (float64, entity.PayslipDetailInputParam) {
-	totalPay := proratedSalary * float64(totalAttendance)
-	return totalPay, entity.PayslipDetailInputParam{
-		ItemType:    entity.PayslipItemTypeEarningBasePay,
-		Description: fmt.Sprintf("Base Pay for %v Attendance on %v Workdays", totalAttendance, totalWorkday),
-		Amount:      null.Float64From(totalPay),
+		inputParams = append(inputParams, entity.LoanRepaymentInputParam{
+			LoanID:          repayment.Loan.ID,
+			UserID:          payslip.UserID,
+			PayrollRunID:    payslip.PayrollRunID,
+			PayslipID:       payslip.ID,
+			Amount:          repayment.Amount,
+			Currency:        repayment.Loan.Currency,
+			RepaymentStatus: entity.LoanRepaymentStatusPaid,
+			CreatedAt:       currentTime,
+			CreatedBy:       userID,
+		})
 	}
+
+	return a.loanRepaymentDom.CreateMany(ctx, inputParams)
 }
 
-func (a *attendancePeriod) calculateOvertimePayComponentAndDetail(proratedSalary float64, overtimes []entity.Overtime) (float64, []entity.PayslipDetailInputParam) {
-	inputParams := []entity.PayslipDetailInputParam{}
-	totalHours := 0.0
-	totalPay := 0.0
+// updateYearToDateTotal applies a change to the year to date totals of an employee, the totals of a year
+// start from zero with the first payslip of the employee in it.
+func (a *attendancePeriod) updateYearToDateTotal(
+	ctx context.Context,
+	employeeID int64,
+	year int64,
+	currentTime null.Time,
+	userID null.Int64,
+	apply func(total *entity.YearToDateTotal),
+) error {
+	total, err := a.yearToDateTotalDom.Get(
+		ctx,
+		entity.YearToDateTotalParam{
+			UserID:      employeeID,
+			Year:        year,
+			BypassCache: true,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return err
+	} else if err != nil {
+		total = entity.YearToDateTotal{}
+		apply(&total)
 
-	for _, overtime := range overtimes {
-		totalHours += overtime.OvertimeHour
-		overtimePay := proratedSalary / 8 * overtime.OvertimeHour
-		totalPay += overtimePay
-
-		inputParams = append(inputParams, entity.PayslipDetailInputParam{
-			ItemType:    entity.PayslipItemTypeEarningOvertime,
-			Description: fmt.Sprintf("Overtime %v Hours on %v", overtime.OvertimeHour, overtime.OvertimeDate.Time.Format(time.DateOnly)),
-			Amount:      null.Float64From(overtimePay),
-		})
+		_, err = a.yearToDateTotalDom.Create(
+			ctx,
+			entity.YearToDateTotalInputParam{
+				UserID:               employeeID,
+				Year:                 year,
+				GrossEarnings:        total.GrossEarnings,
+				TaxDeduction:         total.TaxDeduction,
+				EmployeeContribution: total.EmployeeContribution,
+				EmployerContribution: total.EmployerContribution,
+				TotalTakeHomePay:     total.TotalTakeHomePay,
+				CreatedAt:            currentTime,
+				CreatedBy:            userID,
+			},
+		)
+		return err
 	}
 
-	return totalPay, inputParams
-}
+	apply(&total)
 
-func (a *attendancePeriod) calculateReimbursementPayComponentAndDetail(proratedSalary float64, reimbursements []entity.Reimbursement) (float64, []entity.PayslipDetailInputParam) {
-	inputParams := []entity.PayslipDetailInputParam{}
-	totalPay := 0.0
+	return a.yearToDateTotalDom.Update(
+		ctx,
+		entity.YearToDateTotalUpdateParam{
+			GrossEarnings:        entity.NullMoneyFrom(total.GrossEarnings),
+			TaxDeduction:         entity.NullMoneyFrom(total.TaxDeduction),
+			EmployeeContribution: entity.NullMoneyFrom(total.EmployeeContribution),
+			EmployerContribution: entity.NullMoneyFrom(total.EmployerContribution),
+			TotalTakeHomePay:     entity.NullMoneyFrom(total.TotalTakeHomePay),
+			UpdatedAt:            currentTime,
+			UpdatedBy:            userID,
+		},
+		entity.YearToDateTotalParam{
+			ID: total.ID,
+		},
+	)
+}
 
-	for _, reimbursement := range reimbursements {
-		totalPay += reimbursement.Amount
+// savePayrollRunEmployee records the outcome of an employee in a payroll run, an earlier failure of the
+// same employee is overwritten when the run is resumed.
+func (a *attendancePeriod) savePayrollRunEmployee(
+	ctx context.Context,
+	payrollRunEmployee entity.PayrollRunEmployee,
+	inputParam entity.PayrollRunEmployeeInputParam,
+) error {
+	if payrollRunEmployee.ID == 0 {
+		_, err := a.payrollRunEmployeeDom.Create(ctx, inputParam)
+		return err
+	}
 
-		inputParams = append(inputParams, entity.PayslipDetailInputParam{
-			ItemType:    entity.PayslipItemTypeReimbursement,
-			Description: reimbursement.Description,
-			Amount:      null.Float64From(reimbursement.Amount),
-		})
+	processError := inputParam.ProcessError
+	if !processError.Valid {
+		processError = null.String{SqlNull: true}
 	}
 
-	return totalPay, inputParams
+	return a.payrollRunEmployeeDom.Update(
+		ctx,
+		entity.PayrollRunEmployeeUpdateParam{
+			PayslipID:      inputParam.PayslipID,
+			EmployeeStatus: inputParam.EmployeeStatus,
+			ProcessError:   processError,
+			UpdatedAt:      inputParam.CreatedAt,
+			UpdatedBy:      inputParam.CreatedBy,
+		},
+		entity.PayrollRunEmployeeParam{
+			ID: payrollRunEmployee.ID,
+		},
+	)
 }
 
-func (a *attendancePeriod) getUserIDToReimbursements(
+func (a *attendancePeriod) getUserIDToPayrollRunEmployee(
 	ctx context.Context,
-	startDate null.Date,
-	endDate null.Date,
-) (map[int64][]entity.Reimbursement, error) {
-	userIDToReimbursements := make(map[int64][]entity.Reimbursement)
+	payrollRunID int64,
+) (
+	map[int64]entity.PayrollRunEmployee,
+	error,
+) {
+	userIDToPayrollRunEmployee := make(map[int64]entity.PayrollRunEmployee)
 
-	reimbursements, _, err := a.reimbursementDom.GetList(
+	payrollRunEmployees, _, err := a.payrollRunEmployeeDom.GetList(
 		ctx,
-		entity.ReimbursementParam{
-			ApprovedDateGTE: startDate,
-			ApprovedDateLTE: endDate,
+		entity.PayrollRunEmployeeParam{
+			PayrollRunID: payrollRunID,
+			BypassCache:  true,
 			QueryOption: query.Option{
 				IsActive:     true,
 				DisableLimit: true,
@@ -278,28 +484,83 @@ func (a *attendancePeriod) getUserIDToReimbursements(
 		},
 	)
 	if err != nil {
-		return userIDToReimbursements, err
+		return userIDToPayrollRunEmployee, err
 	}
 
-	for _, reimbursement := range reimbursements {
-		userIDToReimbursements[reimbursement.UserID] = append(userIDToReimbursements[reimbursement.UserID], reimbursement)
+	for _, payrollRunEmployee := range payrollRunEmployees {
+		userIDToPayrollRunEmployee[payrollRunEmployee.UserID] = payrollRunEmployee
 	}
 
-	return userIDToReimbursements, nil
+	return userIDToPayrollRunEmployee, nil
 }
 
-func (a *attendancePeriod) getUserIDToOvertimes(
+func (a *attendancePeriod) PubSubPreviewPayroll(
 	ctx context.Context,
-	startDate null.Date,
-	endDate null.Date,
-) (map[int64][]entity.Overtime, error) {
-	userIDToOvertimes := make(map[int64][]entity.Overtime)
+	message entity.PubSubMessage,
+) error {
+	var (
+		body dto.PubSubPreviewPayrollMessage
+		err  error
+	)
 
-	overtimes, _, err := a.overtimeDom.GetList(
+	defer func() {
+		a.handlePreviewPayrollFailure(ctx, body.LoginUser.ID, body.PayrollPreviewID, err)
+	}()
+
+	if err = a.json.Unmarshal([]byte(message.Payload), &body); err != nil {
+		return errors.NewWithCode(codes.CodeJSONUnmarshalError, "failed to unmarshal message body: %s", err.Error())
+	}
+
+	users, err := a.getPayrollEmployees(ctx, body.AttendancePeriod)
+	if err != nil {
+		return err
+	}
+
+	payrolls, err := a.calculatePayroll(ctx, body.AttendancePeriod, users)
+	if err != nil {
+		return err
+	}
+
+	result, err := a.json.Marshal(a.toPayrollPreview(body.AttendancePeriod, payrolls))
+	if err != nil {
+		return errors.NewWithCode(codes.CodeJSONMarshalError, "failed to marshal payroll preview result: %s", err.Error())
+	}
+
+	err = a.payrollPreviewDom.Update(
 		ctx,
-		entity.OvertimeParam{
-			ApprovedDateGTE: startDate,
-			ApprovedDateLTE: endDate,
+		entity.PayrollPreviewUpdateParam{
+			PreviewStatus: entity.PayrollPreviewStatusCompleted,
+			Result:        null.StringFrom(string(result)),
+			UpdatedAt:     null.TimeFrom(Now()),
+			UpdatedBy:     null.Int64From(body.LoginUser.ID),
+		},
+		entity.PayrollPreviewParam{
+			ID: body.PayrollPreviewID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// employeePayroll is the calculated, not yet stored, payslip of one employee.
+type employeePayroll struct {
+	User           entity.User
+	Payslip        entity.PayslipInputParam
+	CurrencyTotals []entity.PayslipCurrencyTotalInputParam
+	Details        []entity.PayslipDetailInputParam
+	LoanRepayments []loanRepayment
+}
+
+// getPayrollEmployees returns every active employee that is paid by the payroll of the attendance period,
+// employees hired after the period or terminated before it are left out.
+func (a *attendancePeriod) getPayrollEmployees(ctx context.Context, attendancePeriod entity.AttendancePeriod) ([]entity.User, error) {
+	users, _, err := a.userDom.GetList(
+		ctx,
+		entity.UserParam{
+			RoleID: entity.RoleIDUser,
 			QueryOption: query.Option{
 				IsActive:     true,
 				DisableLimit: true,
@@ -307,14 +568,691 @@ func (a *attendancePeriod) getUserIDToOvertimes(
 		},
 	)
 	if err != nil {
-		return userIDToOvertimes, err
+		return nil, err
 	}
 
-	for _, overtime := range overtimes {
-		userIDToOvertimes[overtime.UserID] = append(userIDToOvertimes[overtime.UserID], overtime)
+	employees := make([]entity.User, 0, len(users))
+	for _, user := range users {
+		if user.IsEmployedBetween(attendancePeriod.StartDate, attendancePeriod.EndDate) {
+			employees = append(employees, user)
+		}
 	}
 
-	return userIDToOvertimes, nil
+	return employees, nil
+}
+
+// payrollData is everything the payroll rules read besides the employee itself, loaded once per attendance period.
+type payrollData struct {
+	Holidays               []entity.Holiday
+	WorkSchedules          map[int64]entity.WorkSchedule
+	UserIDToWorkSchedules  map[int64][]entity.UserWorkSchedule
+	UserIDToSalaries       map[int64][]entity.UserSalary
+	UserAttendanceCount    map[int64]int64
+	UserIDToAttendances    map[int64][]entity.Attendance
+	AttendanceTypes        map[string]entity.AttendanceType
+	UserIDToReimbursements map[int64][]entity.Reimbursement
+	UserIDToOvertimes      map[int64][]entity.Overtime
+	UserIDToAllowances     map[int64][]entity.UserAllowance
+	AllowanceTypes         map[int64]entity.AllowanceType
+	UserIDToAdjustments    map[int64][]entity.PayrollAdjustment
+	UserIDToLoans          map[int64][]entity.Loan
+	PayComponents          []entity.PayComponent
+	TaxBrackets            []entity.TaxBracket
+	ExchangeRates          map[string]float64
+}
+
+func (a *attendancePeriod) getPayrollData(ctx context.Context, attendancePeriod entity.AttendancePeriod) (payrollData, error) {
+	data := payrollData{}
+
+	// Use goroutines to fetch data concurrently
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		var err error
+		data.Holidays, err = a.getHolidays(gctx, attendancePeriod.StartDate, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.WorkSchedules, err = a.getWorkSchedules(gctx)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToWorkSchedules, err = a.getUserIDToWorkSchedules(gctx, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToSalaries, err = a.getUserIDToSalaries(gctx, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserAttendanceCount, err = a.attendanceDom.CountUserAttendance(gctx, attendancePeriod.ID)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToAttendances, err = a.getUserIDToAttendances(gctx, attendancePeriod.ID)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.AttendanceTypes, err = a.getAttendanceTypes(gctx)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToReimbursements, err = a.getUserIDToReimbursements(gctx, attendancePeriod.StartDate, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToOvertimes, err = a.getUserIDToOvertimes(gctx, attendancePeriod.StartDate, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToAllowances, err = a.getUserIDToAllowances(gctx, attendancePeriod.StartDate, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.AllowanceTypes, err = a.getAllowanceTypes(gctx)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToAdjustments, err = a.getUserIDToAdjustments(gctx, attendancePeriod.ID)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.UserIDToLoans, err = a.getUserIDToLoans(gctx, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.PayComponents, err = a.getPayComponents(gctx)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.TaxBrackets, err = a.getTaxBrackets(gctx, attendancePeriod.EndDate)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		data.ExchangeRates, err = a.getExchangeRates(gctx, attendancePeriod.GetExchangeRateFixingDate())
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return payrollData{}, err
+	}
+
+	return data, nil
+}
+
+// calculateEmployeePayroll runs the payroll rules for one employee without storing anything.
+func (a *attendancePeriod) calculateEmployeePayroll(
+	attendancePeriod entity.AttendancePeriod,
+	data payrollData,
+	user entity.User,
+) (
+	employeePayroll,
+	error,
+) {
+	// days off of the work schedule and public holidays are neither worked nor missed
+	workCalendar := entity.NewWorkCalendar(data.UserIDToWorkSchedules[user.ID], data.WorkSchedules)
+	holidays := entity.NewHolidayCalendar(data.Holidays, user.Region)
+
+	// the salary effective at the end of the period is the one overtime and contributions are based on
+	salarySegments := attendancePeriod.SalarySegments(user, data.UserIDToSalaries[user.ID], workCalendar, holidays)
+	user.BaseSalary = salarySegments[len(salarySegments)-1].BaseSalary
+
+	input := payrollInput{
+		User:                user,
+		AttendancePeriod:    attendancePeriod,
+		WorkCalendar:        workCalendar,
+		Holidays:            holidays,
+		TotalWorkingDays:    attendancePeriod.TotalWorkingDays(workCalendar, holidays),
+		EmployedWorkingDays: attendancePeriod.EmployedWorkingDays(user, workCalendar, holidays),
+		SalarySegments:      salarySegments,
+		TotalAttendance:     data.UserAttendanceCount[user.ID],
+		Attendances:         data.UserIDToAttendances[user.ID],
+		AttendanceTypes:     data.AttendanceTypes,
+		Overtimes:           data.UserIDToOvertimes[user.ID],
+		Reimbursements:      data.UserIDToReimbursements[user.ID],
+		Allowances:          data.UserIDToAllowances[user.ID],
+		AllowanceTypes:      data.AllowanceTypes,
+		Adjustments:         data.UserIDToAdjustments[user.ID],
+		Loans:               data.UserIDToLoans[user.ID],
+		TaxBrackets:         data.TaxBrackets,
+		ExchangeRates:       data.ExchangeRates,
+	}
+
+	results, err := a.runPayrollRules(data.PayComponents, input)
+	if err != nil {
+		return employeePayroll{}, err
+	}
+
+	payslipInputParam, currencyTotalInputParams, payslipDetailInputParams, err := a.toPayslipInputParam(results, input)
+	if err != nil {
+		return employeePayroll{}, err
+	}
+
+	payslipInputParam.UserID = user.ID
+	payslipInputParam.AttendancePeriodID = attendancePeriod.ID
+
+	var loanRepayments []loanRepayment
+	for _, result := range results {
+		loanRepayments = append(loanRepayments, result.LoanRepayments...)
+	}
+
+	return employeePayroll{
+		User:           user,
+		Payslip:        payslipInputParam,
+		CurrencyTotals: currencyTotalInputParams,
+		Details:        payslipDetailInputParams,
+		LoanRepayments: loanRepayments,
+	}, nil
+}
+
+// calculatePayroll runs the payroll rules for every given employee over the attendance period.
+// Nothing is stored, so the same calculation backs both the real payroll run and its preview.
+func (a *attendancePeriod) calculatePayroll(
+	ctx context.Context,
+	attendancePeriod entity.AttendancePeriod,
+	users []entity.User,
+) (
+	[]employeePayroll,
+	error,
+) {
+	data, err := a.getPayrollData(ctx, attendancePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	payrolls := make([]employeePayroll, 0, len(users))
+	for _, user := range users {
+		payroll, err := a.calculateEmployeePayroll(attendancePeriod, data, user)
+		if err != nil {
+			return nil, err
+		}
+
+		payrolls = append(payrolls, payroll)
+	}
+
+	return payrolls, nil
+}
+
+func (a *attendancePeriod) handleGeneratePayrollFailure(ctx context.Context, userID, attendancePeriodID, payrollRunID int64, err error) {
+	if err == nil {
+		return
+	}
+
+	updateErr := a.payrollRunDom.Update(
+		ctx,
+		entity.PayrollRunUpdateParam{
+			RunStatus:    entity.PayrollRunStatusProcessError,
+			ProcessError: null.StringFrom(err.Error()),
+			FinishedAt:   null.TimeFrom(Now()),
+			UpdatedAt:    null.TimeFrom(Now()),
+			UpdatedBy:    null.Int64From(userID),
+		},
+		entity.PayrollRunParam{
+			ID: payrollRunID,
+		},
+	)
+	if updateErr != nil {
+		a.log.Error(ctx, fmt.Sprintf("failed to handle generate payroll failure: %s", updateErr.Error()))
+	}
+
+	updateErr = a.attendancePeriodDom.Update(
+		ctx,
+		entity.AttendancePeriodUpdateParam{
+			PeriodStatus:        entity.PeriodStatusProcessError,
+			PayrollProcessError: err.Error(),
+			UpdatedAt:           null.TimeFrom(Now()),
+			UpdatedBy:           null.Int64From(userID),
+		},
+		entity.AttendancePeriodParam{
+			ID: attendancePeriodID,
+		},
+	)
+	if updateErr != nil {
+		a.log.Error(ctx, fmt.Sprintf("failed to handle generate payroll failure: %s", updateErr.Error()))
+	}
+}
+
+func (a *attendancePeriod) handlePreviewPayrollFailure(ctx context.Context, userID, payrollPreviewID int64, err error) {
+	if err != nil {
+		err := a.payrollPreviewDom.Update(
+			ctx,
+			entity.PayrollPreviewUpdateParam{
+				PreviewStatus: entity.PayrollPreviewStatusFailed,
+				PreviewError:  null.StringFrom(err.Error()),
+				UpdatedAt:     null.TimeFrom(Now()),
+				UpdatedBy:     null.Int64From(userID),
+			},
+			entity.PayrollPreviewParam{
+				ID: payrollPreviewID,
+			},
+		)
+		if err != nil {
+			a.log.Error(ctx, fmt.Sprintf("failed to handle preview payroll failure: %s", err.Error()))
+		}
+	}
+}
+
+func (a *attendancePeriod) getUserIDToReimbursements(
+	ctx context.Context,
+	startDate null.Date,
+	endDate null.Date,
+) (map[int64][]entity.Reimbursement, error) {
+	userIDToReimbursements := make(map[int64][]entity.Reimbursement)
+
+	reimbursements, _, err := a.reimbursementDom.GetList(
+		ctx,
+		entity.ReimbursementParam{
+			ApprovedDateGTE: startDate,
+			ApprovedDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return userIDToReimbursements, err
+	}
+
+	for _, reimbursement := range reimbursements {
+		userIDToReimbursements[reimbursement.UserID] = append(userIDToReimbursements[reimbursement.UserID], reimbursement)
+	}
+
+	return userIDToReimbursements, nil
+}
+
+func (a *attendancePeriod) getUserIDToAttendances(ctx context.Context, attendancePeriodID int64) (map[int64][]entity.Attendance, error) {
+	userIDToAttendances := make(map[int64][]entity.Attendance)
+
+	attendances, _, err := a.attendanceDom.GetList(
+		ctx,
+		entity.AttendanceParam{
+			AttendancePeriodID: attendancePeriodID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return userIDToAttendances, err
+	}
+
+	for _, attendance := range attendances {
+		userIDToAttendances[attendance.UserID] = append(userIDToAttendances[attendance.UserID], attendance)
+	}
+
+	return userIDToAttendances, nil
+}
+
+// getAttendanceTypes returns the active attendance types by code, the attendances of a deactivated type are paid
+// without its daily allowance or limit.
+func (a *attendancePeriod) getAttendanceTypes(ctx context.Context) (map[string]entity.AttendanceType, error) {
+	attendanceTypes := make(map[string]entity.AttendanceType)
+
+	attendanceTypeList, _, err := a.attendanceTypeDom.GetList(
+		ctx,
+		entity.AttendanceTypeParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return attendanceTypes, err
+	}
+
+	for _, attendanceType := range attendanceTypeList {
+		attendanceTypes[attendanceType.Code] = attendanceType
+	}
+
+	return attendanceTypes, nil
+}
+
+func (a *attendancePeriod) getUserIDToOvertimes(
+	ctx context.Context,
+	startDate null.Date,
+	endDate null.Date,
+) (map[int64][]entity.Overtime, error) {
+	userIDToOvertimes := make(map[int64][]entity.Overtime)
+
+	overtimes, _, err := a.overtimeDom.GetList(
+		ctx,
+		entity.OvertimeParam{
+			ApprovedDateGTE: startDate,
+			ApprovedDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return userIDToOvertimes, err
+	}
+
+	for _, overtime := range overtimes {
+		userIDToOvertimes[overtime.UserID] = append(userIDToOvertimes[overtime.UserID], overtime)
+	}
+
+	return userIDToOvertimes, nil
+}
+
+// getUserIDToAllowances returns the allowances effective on any day of the period.
+func (a *attendancePeriod) getUserIDToAllowances(
+	ctx context.Context,
+	startDate null.Date,
+	endDate null.Date,
+) (map[int64][]entity.UserAllowance, error) {
+	userIDToAllowances := make(map[int64][]entity.UserAllowance)
+
+	userAllowances, _, err := a.userAllowanceDom.GetList(
+		ctx,
+		entity.UserAllowanceParam{
+			EffectiveDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return userIDToAllowances, err
+	}
+
+	for _, userAllowance := range userAllowances {
+		if !userAllowance.IsEffectiveBetween(startDate, endDate) {
+			continue
+		}
+
+		userIDToAllowances[userAllowance.UserID] = append(userIDToAllowances[userAllowance.UserID], userAllowance)
+	}
+
+	return userIDToAllowances, nil
+}
+
+func (a *attendancePeriod) getAllowanceTypes(ctx context.Context) (map[int64]entity.AllowanceType, error) {
+	allowanceTypes := make(map[int64]entity.AllowanceType)
+
+	allowanceTypeList, _, err := a.allowanceTypeDom.GetList(
+		ctx,
+		entity.AllowanceTypeParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return allowanceTypes, err
+	}
+
+	for _, allowanceType := range allowanceTypeList {
+		allowanceTypes[allowanceType.ID] = allowanceType
+	}
+
+	return allowanceTypes, nil
+}
+
+func (a *attendancePeriod) getWorkSchedules(ctx context.Context) (map[int64]entity.WorkSchedule, error) {
+	workSchedules := make(map[int64]entity.WorkSchedule)
+
+	workScheduleList, _, err := a.workScheduleDom.GetList(
+		ctx,
+		entity.WorkScheduleParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return workSchedules, err
+	}
+
+	for _, workSchedule := range workScheduleList {
+		workSchedules[workSchedule.ID] = workSchedule
+	}
+
+	return workSchedules, nil
+}
+
+// getUserIDToWorkSchedules returns the work schedule assignments that took effect by the end of the period,
+// the ones before the period are needed to know the schedule the period starts with.
+func (a *attendancePeriod) getUserIDToWorkSchedules(ctx context.Context, endDate null.Date) (map[int64][]entity.UserWorkSchedule, error) {
+	userIDToWorkSchedules := make(map[int64][]entity.UserWorkSchedule)
+
+	userWorkSchedules, _, err := a.userWorkScheduleDom.GetList(
+		ctx,
+		entity.UserWorkScheduleParam{
+			EffectiveDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return userIDToWorkSchedules, err
+	}
+
+	for _, userWorkSchedule := range userWorkSchedules {
+		userIDToWorkSchedules[userWorkSchedule.UserID] = append(userIDToWorkSchedules[userWorkSchedule.UserID], userWorkSchedule)
+	}
+
+	return userIDToWorkSchedules, nil
+}
+
+// getUserIDToSalaries returns the salary history of every employee up to the end of the period.
+func (a *attendancePeriod) getUserIDToSalaries(ctx context.Context, endDate null.Date) (map[int64][]entity.UserSalary, error) {
+	userIDToSalaries := make(map[int64][]entity.UserSalary)
+
+	userSalaries, _, err := a.userSalaryDom.GetList(
+		ctx,
+		entity.UserSalaryParam{
+			EffectiveDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return userIDToSalaries, err
+	}
+
+	for _, userSalary := range userSalaries {
+		userIDToSalaries[userSalary.UserID] = append(userIDToSalaries[userSalary.UserID], userSalary)
+	}
+
+	return userIDToSalaries, nil
+}
+
+// getHolidays returns the public holidays of every region that fall within the period.
+func (a *attendancePeriod) getHolidays(ctx context.Context, startDate, endDate null.Date) ([]entity.Holiday, error) {
+	holidays, _, err := a.holidayDom.GetList(
+		ctx,
+		entity.HolidayParam{
+			HolidayDateGTE: startDate,
+			HolidayDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"holiday_date"},
+			},
+		},
+	)
+	if err != nil {
+		return holidays, err
+	}
+
+	return holidays, nil
+}
+
+func (a *attendancePeriod) getUserIDToAdjustments(ctx context.Context, attendancePeriodID int64) (map[int64][]entity.PayrollAdjustment, error) {
+	userIDToAdjustments := make(map[int64][]entity.PayrollAdjustment)
+
+	adjustments, _, err := a.payrollAdjustmentDom.GetList(
+		ctx,
+		entity.PayrollAdjustmentParam{
+			AttendancePeriodID: attendancePeriodID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"id"},
+			},
+		},
+	)
+	if err != nil {
+		return userIDToAdjustments, err
+	}
+
+	for _, adjustment := range adjustments {
+		userIDToAdjustments[adjustment.UserID] = append(userIDToAdjustments[adjustment.UserID], adjustment)
+	}
+
+	return userIDToAdjustments, nil
+}
+
+// getUserIDToLoans returns the loans with an outstanding balance whose repayment starts by the end of the period.
+func (a *attendancePeriod) getUserIDToLoans(ctx context.Context, endDate null.Date) (map[int64][]entity.Loan, error) {
+	userIDToLoans := make(map[int64][]entity.Loan)
+
+	loans, _, err := a.loanDom.GetList(
+		ctx,
+		entity.LoanParam{
+			LoanStatus:   entity.LoanStatusActive,
+			StartDateLTE: endDate,
+			BypassCache:  true,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"start_date", "id"},
+			},
+		},
+	)
+	if err != nil {
+		return userIDToLoans, err
+	}
+
+	for _, loan := range loans {
+		userIDToLoans[loan.UserID] = append(userIDToLoans[loan.UserID], loan)
+	}
+
+	return userIDToLoans, nil
+}
+
+func (a *attendancePeriod) getPayComponents(ctx context.Context) ([]entity.PayComponent, error) {
+	payComponents, _, err := a.payComponentDom.GetList(
+		ctx,
+		entity.PayComponentParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"evaluation_order"},
+			},
+		},
+	)
+	if err != nil {
+		return payComponents, err
+	}
+
+	if len(payComponents) == 0 {
+		return payComponents, errors.NewWithCode(codes.CodeNotFound, "no active pay component found")
+	}
+
+	return payComponents, nil
+}
+
+// getTaxBrackets returns the version of the tax brackets in effect at the end of the period,
+// so that recomputing an old period keeps using the brackets of its time.
+func (a *attendancePeriod) getTaxBrackets(ctx context.Context, endDate null.Date) ([]entity.TaxBracket, error) {
+	taxBrackets, _, err := a.taxBracketDom.GetList(
+		ctx,
+		entity.TaxBracketParam{
+			EffectiveDateLTE: endDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-effective_date", "lower_bound"},
+			},
+		},
+	)
+	if err != nil {
+		return taxBrackets, err
+	}
+
+	return entity.GetEffectiveTaxBrackets(taxBrackets), nil
+}
+
+// getExchangeRates returns the latest rate of every currency fixed on or before the fixing date of the period,
+// so that recomputing an old period keeps converting with the rates of its time.
+func (a *attendancePeriod) getExchangeRates(ctx context.Context, fixingDate null.Date) (map[string]float64, error) {
+	exchangeRates, _, err := a.exchangeRateDom.GetList(
+		ctx,
+		entity.ExchangeRateParam{
+			FixingDateLTE: fixingDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-fixing_date"},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.GetEffectiveExchangeRates(exchangeRates), nil
 }
 
 func (a *attendancePeriod) setPayslipIDToDetails(
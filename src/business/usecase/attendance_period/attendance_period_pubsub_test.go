@@ -0,0 +1,277 @@
+package attendance_period
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	mock_parser "github.com/reyhanmichiels/go-pkg/v2/tests/mock/parser"
+	mock_payroll_run_employee "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payroll_run_employee"
+	mock_payslip "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payslip"
+	mock_payslip_currency_total "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payslip_currency_total"
+	mock_payslip_detail "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payslip_detail"
+	mock_transactor "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/transactor"
+	mock_year_to_date_total "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/year_to_date_total"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// payslipItemSums adds up payslip detail amounts by the prefix of their item type.
+type payslipItemSums struct {
+	Earning              entity.Money
+	Reimbursement        entity.Money
+	Deduction            entity.Money
+	EmployerContribution entity.Money
+}
+
+func (s *payslipItemSums) add(itemType string, amount entity.Money) {
+	switch {
+	case strings.HasPrefix(itemType, "EARNING_"):
+		s.Earning += amount
+	case strings.HasPrefix(itemType, "REIMBURSEMENT"):
+		s.Reimbursement += amount
+	case strings.HasPrefix(itemType, "DEDUCTION_"):
+		s.Deduction += amount
+	case strings.HasPrefix(itemType, "EMPLOYER_"):
+		s.EmployerContribution += amount
+	}
+}
+
+func Test_attendancePeriod_generateEmployeePayslip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactor := mock_transactor.NewMockInterface(ctrl)
+	mockPayslipDom := mock_payslip.NewMockInterface(ctrl)
+	mockPayslipDetailDom := mock_payslip_detail.NewMockInterface(ctrl)
+	mockPayslipCurrencyTotalDom := mock_payslip_currency_total.NewMockInterface(ctrl)
+	mockYearToDateTotalDom := mock_year_to_date_total.NewMockInterface(ctrl)
+	mockPayrollRunEmployeeDom := mock_payroll_run_employee.NewMockInterface(ctrl)
+	mockJson := mock_parser.NewMockJSONInterface(ctrl)
+	mockJson.EXPECT().Unmarshal(gomock.Any(), gomock.Any()).DoAndReturn(json.Unmarshal).AnyTimes()
+
+	uc := Init(InitParam{
+		Transactor:           mockTransactor,
+		Payslip:              mockPayslipDom,
+		PayslipDetail:        mockPayslipDetailDom,
+		PayslipCurrencyTotal: mockPayslipCurrencyTotalDom,
+		YearToDateTotal:      mockYearToDateTotalDom,
+		PayrollRunEmployee:   mockPayrollRunEmployeeDom,
+		Json:                 mockJson,
+	}).(*attendancePeriod)
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	restoreAll := func() {
+		Now = time.Now
+	}
+	defer restoreAll()
+
+	ctx := context.Background()
+
+	mockBody := dto.PubSubGeneratePayrollMessage{
+		AttendancePeriod: entity.AttendancePeriod{
+			ID:        1,
+			StartDate: null.DateFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+			EndDate:   null.DateFrom(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)),
+		},
+		PayrollRun: entity.PayrollRun{ID: 5},
+		LoginUser:  auth.User{ID: 100},
+	}
+
+	mockPayComponents := []entity.PayComponent{
+		{
+			Code:            entity.PayComponentCodeBasePay,
+			ItemType:        entity.PayslipItemTypeEarningBasePay,
+			EvaluationOrder: 1,
+		},
+		{
+			Code:            entity.PayComponentCodeEarningAdjustment,
+			ItemType:        entity.PayslipItemTypeEarningAdjustment,
+			EvaluationOrder: 2,
+		},
+		{
+			Code:            entity.PayComponentCodeReimbursement,
+			ItemType:        entity.PayslipItemTypeReimbursement,
+			EvaluationOrder: 3,
+		},
+		{
+			Code:            entity.PayComponentCodeTax,
+			ItemType:        entity.PayslipItemTypeDeductionTax,
+			EvaluationOrder: 4,
+			Config:          null.StringFrom(`{"periodsPerYear": 12, "personalRelief": 8050000}`),
+		},
+		{
+			Code:            entity.PayComponentCodePension,
+			Name:            "Pension",
+			ItemType:        entity.PayslipItemTypeDeductionContribution,
+			EvaluationOrder: 5,
+			Config:          null.StringFrom(`{"employeeRate": 0.02, "employerRate": 0.04}`),
+		},
+		{
+			Code:            entity.PayComponentCodeDeductionAdjustment,
+			ItemType:        entity.PayslipItemTypeDeductionAdjustment,
+			EvaluationOrder: 9,
+		},
+	}
+
+	mockTaxBrackets := []entity.TaxBracket{
+		{LowerBound: 0, UpperBound: entity.NullMoneyFrom(entity.NewMoney(6000000)), Rate: 0.05},
+		{LowerBound: entity.NewMoney(6000000), Rate: 0.1},
+	}
+
+	tests := []struct {
+		name           string
+		user           entity.User
+		data           payrollData
+		wantCurrencies []string
+	}{
+		{
+			name: "Single Currency",
+			user: entity.User{ID: 1, BaseSalary: entity.NewMoney(10000000)},
+			data: payrollData{
+				UserAttendanceCount: map[int64]int64{1: 19},
+				UserIDToReimbursements: map[int64][]entity.Reimbursement{
+					1: {{Description: "Taxi", Amount: entity.NewMoney(75000)}},
+				},
+				UserIDToAdjustments: map[int64][]entity.PayrollAdjustment{
+					1: {
+						{AdjustmentType: entity.PayrollAdjustmentTypeEarning, Amount: entity.NewMoney(250000), Reason: "Bonus"},
+						{AdjustmentType: entity.PayrollAdjustmentTypeDeduction, Amount: entity.NewMoney(100000), Reason: "Uniform"},
+					},
+				},
+				PayComponents: mockPayComponents,
+				TaxBrackets:   mockTaxBrackets,
+				ExchangeRates: map[string]float64{entity.ReportingCurrency: 1},
+			},
+			wantCurrencies: []string{entity.ReportingCurrency},
+		},
+		{
+			// every line converted on its own rounds to the cent, the stored totals must still match the lines
+			name: "Mixed Currency",
+			user: entity.User{ID: 1, BaseSalary: entity.NewMoney(2000), Currency: "USD"},
+			data: payrollData{
+				UserAttendanceCount: map[int64]int64{1: 19},
+				UserIDToReimbursements: map[int64][]entity.Reimbursement{
+					1: {
+						{Description: "Taxi", Amount: entity.NewMoney(75000), Currency: entity.ReportingCurrency},
+						{Description: "Hotel", Amount: entity.Money(12345), Currency: "SGD"},
+					},
+				},
+				UserIDToAdjustments: map[int64][]entity.PayrollAdjustment{
+					1: {
+						{AdjustmentType: entity.PayrollAdjustmentTypeEarning, Amount: entity.Money(33333), Currency: "SGD", Reason: "Bonus"},
+						{AdjustmentType: entity.PayrollAdjustmentTypeDeduction, Amount: entity.NewMoney(100000), Currency: entity.ReportingCurrency, Reason: "Uniform"},
+					},
+				},
+				PayComponents: mockPayComponents,
+				TaxBrackets:   mockTaxBrackets,
+				ExchangeRates: map[string]float64{entity.ReportingCurrency: 1, "USD": 16234.57, "SGD": 12111.11},
+			},
+			wantCurrencies: []string{entity.ReportingCurrency, "SGD", "USD"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				payslip        entity.Payslip
+				details        []entity.PayslipDetailInputParam
+				currencyTotals []entity.PayslipCurrencyTotalInputParam
+			)
+
+			mockTransactor.EXPECT().Execute(ctx, "txPubSubGenerateEmployeePayslip", gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+					return callback(ctx)
+				},
+			)
+			mockPayslipDom.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, inputParam entity.PayslipInputParam) (entity.Payslip, error) {
+					payslip = entity.Payslip{
+						ID:                     7,
+						UserID:                 inputParam.UserID,
+						BasePayComponent:       inputParam.BasePayComponent,
+						OvertimeComponent:      inputParam.OvertimeComponent,
+						AllowanceComponent:     inputParam.AllowanceComponent,
+						AdjustmentComponent:    inputParam.AdjustmentComponent,
+						ReimbursementComponent: inputParam.ReimbursementComponent,
+						DeductionComponent:     inputParam.DeductionComponent,
+						TaxDeduction:           inputParam.TaxDeduction,
+						EmployeeContribution:   inputParam.EmployeeContribution,
+						EmployerContribution:   inputParam.EmployerContribution,
+						TotalTakeHomePay:       inputParam.TotalTakeHomePay,
+					}
+					return payslip, nil
+				},
+			)
+			mockPayslipDetailDom.EXPECT().CreateMany(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, inputParams []entity.PayslipDetailInputParam) error {
+					details = inputParams
+					return nil
+				},
+			)
+			mockPayslipCurrencyTotalDom.EXPECT().CreateMany(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, inputParams []entity.PayslipCurrencyTotalInputParam) error {
+					currencyTotals = inputParams
+					return nil
+				},
+			)
+			mockYearToDateTotalDom.EXPECT().Get(ctx, gomock.Any()).Return(entity.YearToDateTotal{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			mockYearToDateTotalDom.EXPECT().Create(ctx, gomock.Any()).Return(entity.YearToDateTotal{}, nil)
+			mockPayrollRunEmployeeDom.EXPECT().Create(ctx, gomock.Any()).Return(entity.PayrollRunEmployee{}, nil)
+
+			err := uc.generateEmployeePayslip(ctx, mockBody, tt.data, tt.user, entity.PayrollRunEmployee{})
+			assert.NoError(t, err)
+
+			currencies := make([]string, 0, len(currencyTotals))
+			currencyRates := make(map[string]float64)
+			for _, total := range currencyTotals {
+				currencies = append(currencies, total.Currency)
+				currencyRates[total.Currency] = total.ExchangeRate
+			}
+			assert.Equal(t, tt.wantCurrencies, currencies)
+
+			var reportingSums payslipItemSums
+			currencySums := make(map[string]*payslipItemSums)
+			for _, detail := range details {
+				assert.Equal(t, payslip.ID, detail.PayslipID)
+
+				sums, ok := currencySums[detail.Currency]
+				if !ok {
+					sums = &payslipItemSums{}
+					currencySums[detail.Currency] = sums
+				}
+
+				sums.add(detail.ItemType, detail.Amount)
+				reportingSums.add(detail.ItemType, detail.Amount.MulRate(currencyRates[detail.Currency]))
+			}
+
+			// the written detail lines reconcile with the stored payslip to the cent
+			assert.Equal(t, payslip.BasePayComponent+payslip.OvertimeComponent+payslip.AllowanceComponent+payslip.AdjustmentComponent, reportingSums.Earning)
+			assert.Equal(t, payslip.ReimbursementComponent, reportingSums.Reimbursement)
+			assert.Equal(t, payslip.DeductionComponent, reportingSums.Deduction)
+			assert.Equal(t, payslip.EmployerContribution, reportingSums.EmployerContribution)
+			assert.Equal(t, payslip.TotalTakeHomePay, reportingSums.Earning+reportingSums.Reimbursement-reportingSums.Deduction)
+
+			// and with the stored totals of every currency they were paid in
+			for _, total := range currencyTotals {
+				sums := currencySums[total.Currency]
+				assert.Equal(t, total.BasePayComponent+total.OvertimeComponent+total.AllowanceComponent+total.AdjustmentComponent, sums.Earning, total.Currency)
+				assert.Equal(t, total.ReimbursementComponent, sums.Reimbursement, total.Currency)
+				assert.Equal(t, total.DeductionComponent, sums.Deduction, total.Currency)
+				assert.Equal(t, total.EmployerContribution, sums.EmployerContribution, total.Currency)
+				assert.Equal(t, total.TotalTakeHomePay, sums.Earning+sums.Reimbursement-sums.Deduction, total.Currency)
+			}
+		})
+	}
+}
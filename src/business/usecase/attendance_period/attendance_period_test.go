@@ -2,6 +2,7 @@ package attendance_period
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -12,14 +13,32 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/query"
 	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
 	mock_parser "github.com/reyhanmichiels/go-pkg/v2/tests/mock/parser"
+	mock_allowance_type "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/allowance_type"
 	mock_attendance "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance"
 	mock_attendance_period "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_period"
+	mock_attendance_type "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_type"
+	mock_exchange_rate "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/exchange_rate"
+	mock_holiday "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/holiday"
+	mock_loan "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/loan"
+	mock_loan_repayment "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/loan_repayment"
 	mock_overtime "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/overtime"
+	mock_pay_component "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/pay_component"
+	mock_payroll_adjustment "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payroll_adjustment"
+	mock_payroll_preview "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payroll_preview"
+	mock_payroll_run "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payroll_run"
+	mock_payroll_run_employee "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payroll_run_employee"
 	mock_payslip "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payslip"
+	mock_payslip_currency_total "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payslip_currency_total"
 	mock_payslip_detail "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payslip_detail"
 	mock_reimbursement "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/reimbursement"
+	mock_tax_bracket "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/tax_bracket"
 	mock_transactor "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/transactor"
 	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_allowance "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_allowance"
+	mock_user_salary "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_salary"
+	mock_user_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_work_schedule"
+	mock_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/work_schedule"
+	mock_year_to_date_total "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/year_to_date_total"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 	mock_publisher "github.com/reyhanmichies/employee-payroll-service/src/handler/pubsub/mock/publisher"
@@ -38,6 +57,7 @@ type mockField struct {
 	overtimeDom         *mock_overtime.MockInterface
 	payslipDom          *mock_payslip.MockInterface
 	payslipDetailDom    *mock_payslip_detail.MockInterface
+	payrollRunDom       *mock_payroll_run.MockInterface
 	json                *mock_parser.MockJSONInterface
 }
 
@@ -166,12 +186,14 @@ func Test_attendancePeriod_GeneratePayroll(t *testing.T) {
 	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
 	mockPublisher := mock_publisher.NewMockInterface(ctrl)
 	mockTransactor := mock_transactor.NewMockInterface(ctrl)
+	mockPayrollRunDom := mock_payroll_run.NewMockInterface(ctrl)
 
 	uc := Init(InitParam{
 		Auth:             mockAuth,
 		AttendancePeriod: mockAttendancePeriodDom,
 		Publisher:        mockPublisher,
 		Transactor:       mockTransactor,
+		PayrollRun:       mockPayrollRunDom,
 	})
 
 	mock := mockField{
@@ -179,6 +201,7 @@ func Test_attendancePeriod_GeneratePayroll(t *testing.T) {
 		attendancePeriodDom: mockAttendancePeriodDom,
 		publisher:           mockPublisher,
 		transactor:          mockTransactor,
+		payrollRunDom:       mockPayrollRunDom,
 	}
 
 	mockTime := time.Now()
@@ -210,7 +233,7 @@ func Test_attendancePeriod_GeneratePayroll(t *testing.T) {
 	}
 
 	mockAttendancePeriodUpdateParam := entity.AttendancePeriodUpdateParam{
-		PeriodStatus: entity.PeriodStatusProcessed,
+		PeriodStatus: entity.PeriodStatusProcessing,
 		UpdatedAt:    null.TimeFrom(mockTime),
 		UpdatedBy:    null.Int64From(mockLoginUser.ID),
 	}
@@ -222,6 +245,32 @@ func Test_attendancePeriod_GeneratePayroll(t *testing.T) {
 		PeriodStatus: entity.PeriodStatusClosed,
 	}
 
+	mockLatestPayrollRunParam := entity.PayrollRunParam{
+		AttendancePeriodID: 1,
+		BypassCache:        true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-version"},
+		},
+	}
+
+	mockPayrollRunInputParam := entity.PayrollRunInputParam{
+		AttendancePeriodID: 1,
+		Version:            1,
+		RunStatus:          entity.PayrollRunStatusProcessing,
+		CreatedAt:          null.TimeFrom(mockTime),
+		CreatedBy:          null.Int64From(mockLoginUser.ID),
+	}
+
+	mockPayrollRun := entity.PayrollRun{
+		ID:                 5,
+		AttendancePeriodID: 1,
+		Version:            1,
+		RunStatus:          entity.PayrollRunStatusProcessing,
+	}
+
 	tests := []struct {
 		name     string
 		args     args
@@ -250,12 +299,15 @@ func Test_attendancePeriod_GeneratePayroll(t *testing.T) {
 					},
 				)
 				mock.attendancePeriodDom.EXPECT().Update(_args.ctx, mockAttendancePeriodUpdateParam, mockAttendancePeriodParam).Return(nil)
+				mock.payrollRunDom.EXPECT().Get(_args.ctx, mockLatestPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+				mock.payrollRunDom.EXPECT().Create(_args.ctx, mockPayrollRunInputParam).Return(mockPayrollRun, nil)
 				mock.publisher.EXPECT().Publish(
 					_args.ctx,
 					entity.ExchangePayrollEvent,
 					entity.RoutingKeyPayrollCalculate,
 					dto.PubSubGeneratePayrollMessage{
 						AttendancePeriod: mockAttendancePeriod,
+						PayrollRun:       mockPayrollRun,
 						LoginUser:        mockLoginUser,
 					},
 				).Return(nil)
@@ -284,18 +336,63 @@ func Test_attendancePeriod_GeneratePayroll(t *testing.T) {
 					},
 				)
 				mock.attendancePeriodDom.EXPECT().Update(_args.ctx, mockAttendancePeriodUpdateParam, mockAttendancePeriodParam).Return(nil)
+				mock.payrollRunDom.EXPECT().Get(_args.ctx, mockLatestPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+				mock.payrollRunDom.EXPECT().Create(_args.ctx, mockPayrollRunInputParam).Return(mockPayrollRun, nil)
 				mock.publisher.EXPECT().Publish(
 					_args.ctx,
 					entity.ExchangePayrollEvent,
 					entity.RoutingKeyPayrollCalculate,
 					dto.PubSubGeneratePayrollMessage{
 						AttendancePeriod: mockAttendancePeriod,
+						PayrollRun:       mockPayrollRun,
 						LoginUser:        mockLoginUser,
 					},
 				).Return(assert.AnError)
 			},
 			wantErr: true,
 		},
+		{
+			name: "Process Error Period Must Be Resumed",
+			args: args{
+				ctx:                context.Background(),
+				attendancePeriodID: 1,
+			},
+			mockFunc: func(mock mockField, _args args) {
+				failedAttendancePeriod := mockAttendancePeriod
+				failedAttendancePeriod.PeriodStatus = entity.PeriodStatusProcessError
+
+				mock.auth.EXPECT().GetUserAuthInfo(_args.ctx).Return(mockLoginUser, nil)
+				mock.attendancePeriodDom.EXPECT().Get(_args.ctx, mockAttendancePeriodParam).Return(failedAttendancePeriod, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Failed Create Payroll Run",
+			args: args{
+				ctx:                context.Background(),
+				attendancePeriodID: 1,
+			},
+			mockFunc: func(mock mockField, _args args) {
+				mock.auth.EXPECT().GetUserAuthInfo(_args.ctx).Return(mockLoginUser, nil)
+				mock.attendancePeriodDom.EXPECT().Get(_args.ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mock.transactor.
+					EXPECT().
+					Execute(
+						_args.ctx,
+						"txGeneratePayroll",
+						gomock.Any(),
+						gomock.Any(),
+					).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(_args.ctx)
+					},
+				)
+				mock.attendancePeriodDom.EXPECT().Update(_args.ctx, mockAttendancePeriodUpdateParam, mockAttendancePeriodParam).Return(nil)
+				mock.payrollRunDom.EXPECT().Get(_args.ctx, mockLatestPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+				mock.payrollRunDom.EXPECT().Create(_args.ctx, mockPayrollRunInputParam).Return(entity.PayrollRun{}, assert.AnError)
+			},
+			wantErr: true,
+		},
 		{
 			name: "Failed Update",
 			args: args{
@@ -450,20 +547,30 @@ func Test_attendancePeriod_GeneratePayroll(t *testing.T) {
 	}
 }
 
-func Test_attendancePeriod_GeneratePayslip(t *testing.T) {
+func Test_attendancePeriod_RerunPayroll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockAuth := mock_auth.NewMockInterface(ctrl)
 	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
+	mockPublisher := mock_publisher.NewMockInterface(ctrl)
+	mockTransactor := mock_transactor.NewMockInterface(ctrl)
+	mockPayrollRunDom := mock_payroll_run.NewMockInterface(ctrl)
+	mockLoanDom := mock_loan.NewMockInterface(ctrl)
+	mockLoanRepaymentDom := mock_loan_repayment.NewMockInterface(ctrl)
 	mockPayslipDom := mock_payslip.NewMockInterface(ctrl)
-	mockPayslipDetailDom := mock_payslip_detail.NewMockInterface(ctrl)
+	mockYearToDateTotalDom := mock_year_to_date_total.NewMockInterface(ctrl)
 
 	uc := Init(InitParam{
 		Auth:             mockAuth,
 		AttendancePeriod: mockAttendancePeriodDom,
+		Publisher:        mockPublisher,
+		Transactor:       mockTransactor,
+		PayrollRun:       mockPayrollRunDom,
+		Loan:             mockLoanDom,
+		LoanRepayment:    mockLoanRepaymentDom,
 		Payslip:          mockPayslipDom,
-		PayslipDetail:    mockPayslipDetailDom,
+		YearToDateTotal:  mockYearToDateTotalDom,
 	})
 
 	mockTime := time.Now()
@@ -472,193 +579,1078 @@ func Test_attendancePeriod_GeneratePayslip(t *testing.T) {
 	}
 	defer func() { Now = time.Now }()
 
+	ctx := context.Background()
+
 	mockLoginUser := auth.User{
-		ID:    1,
-		Name:  "Test User",
-		Email: "test@example.com",
+		ID:     100,
+		Name:   "Test User",
+		Email:  "test@example.com",
+		RoleID: 1,
 	}
 
-	mockAttendancePeriodID := int64(1)
-	mockAttendancePeriod := entity.AttendancePeriod{
-		ID:        mockAttendancePeriodID,
-		StartDate: null.DateFrom(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)),
-		EndDate:   null.DateFrom(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)),
+	mockParam := dto.RerunPayrollParam{
+		Reason: "Overtime was approved after the payroll run",
 	}
 
-	mockPayslip := entity.Payslip{
-		ID:                     1,
-		UserID:                 mockLoginUser.ID,
-		AttendancePeriodID:     mockAttendancePeriodID,
-		BasePayComponent:       1000.0,
-		OvertimeComponent:      200.0,
-		ReimbursementComponent: 100.0,
-		TotalTakeHomePay:       1300.0,
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		ID: 1,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
 	}
 
-	mockPayslipDetails := []entity.PayslipDetail{
-		{
-			ID:          1,
-			PayslipID:   mockPayslip.ID,
-			ItemType:    entity.PayslipItemTypeEarningBasePay,
-			Description: "Base Pay for 20 Attendance on 22 Workdays",
-			Amount:      1000.0,
+	mockAttendancePeriod := entity.AttendancePeriod{
+		ID:           1,
+		StartDate:    null.DateFrom(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:      null.DateFrom(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)),
+		PeriodStatus: entity.PeriodStatusProcessed,
+	}
+
+	mockCurrentPayrollRunParam := entity.PayrollRunParam{
+		AttendancePeriodID: 1,
+		RunStatus:          entity.PayrollRunStatusProcessed,
+		QueryOption: query.Option{
+			IsActive: true,
 		},
-		{
-			ID:          2,
-			PayslipID:   mockPayslip.ID,
-			ItemType:    entity.PayslipItemTypeEarningOvertime,
-			Description: "Overtime 4 Hours on 2023-05-10",
-			Amount:      200.0,
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-version"},
 		},
-		{
-			ID:          3,
-			PayslipID:   mockPayslip.ID,
-			ItemType:    entity.PayslipItemTypeReimbursement,
-			Description: "Transport Reimbursement",
-			Amount:      100.0,
+	}
+
+	mockLatestPayrollRunParam := entity.PayrollRunParam{
+		AttendancePeriodID: 1,
+		BypassCache:        true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-version"},
 		},
 	}
 
-	expectedPayslip := dto.Payslip{
-		StartDate:          mockAttendancePeriod.StartDate,
-		EndDate:            mockAttendancePeriod.EndDate,
-		BasePayComponent:   mockPayslip.BasePayComponent,
-		OvertimeComponent:  mockPayslip.OvertimeComponent,
-		ReimburseComponent: mockPayslip.ReimbursementComponent,
-		TotalTakeHomePay:   mockPayslip.TotalTakeHomePay,
-		Details: []dto.PayslipDetail{
-			{
-				Type:        entity.PayslipItemTypeEarningBasePay,
-				Description: "Base Pay for 20 Attendance on 22 Workdays",
-				Amount:      1000.0,
-			},
-			{
-				Type:        entity.PayslipItemTypeEarningOvertime,
-				Description: "Overtime 4 Hours on 2023-05-10",
-				Amount:      200.0,
-			},
-			{
-				Type:        entity.PayslipItemTypeReimbursement,
-				Description: "Transport Reimbursement",
-				Amount:      100.0,
-			},
+	mockCurrentPayrollRun := entity.PayrollRun{
+		ID:                 5,
+		AttendancePeriodID: 1,
+		Version:            1,
+		RunStatus:          entity.PayrollRunStatusProcessed,
+	}
+
+	mockVoidedPayrollRun := mockCurrentPayrollRun
+	mockVoidedPayrollRun.RunStatus = entity.PayrollRunStatusVoided
+
+	mockNextPayrollRun := entity.PayrollRun{
+		ID:                 6,
+		AttendancePeriodID: 1,
+		Version:            2,
+		RunStatus:          entity.PayrollRunStatusProcessing,
+	}
+
+	mockProcessingParam := entity.AttendancePeriodUpdateParam{
+		PeriodStatus: entity.PeriodStatusProcessing,
+		UpdatedAt:    null.TimeFrom(mockTime),
+		UpdatedBy:    null.Int64From(mockLoginUser.ID),
+	}
+
+	mockVoidParam := entity.PayrollRunUpdateParam{
+		RunStatus:  entity.PayrollRunStatusVoided,
+		VoidReason: null.StringFrom(mockParam.Reason),
+		VoidedAt:   null.TimeFrom(mockTime),
+		VoidedBy:   null.Int64From(mockLoginUser.ID),
+		UpdatedAt:  null.TimeFrom(mockTime),
+		UpdatedBy:  null.Int64From(mockLoginUser.ID),
+	}
+
+	mockRepaymentParam := entity.LoanRepaymentParam{
+		PayrollRunID:    mockCurrentPayrollRun.ID,
+		RepaymentStatus: entity.LoanRepaymentStatusPaid,
+		BypassCache:     true,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
 		},
 	}
 
+	mockRepayment := entity.LoanRepayment{
+		ID:              1,
+		LoanID:          3,
+		UserID:          2,
+		PayrollRunID:    mockCurrentPayrollRun.ID,
+		Amount:          500000,
+		Currency:        entity.ReportingCurrency,
+		RepaymentStatus: entity.LoanRepaymentStatusPaid,
+	}
+
+	mockVoidedPayslip := entity.Payslip{
+		ID:                   7,
+		UserID:               2,
+		PayrollRunID:         mockCurrentPayrollRun.ID,
+		BasePayComponent:     entity.NewMoney(5000000),
+		DeductionComponent:   entity.NewMoney(300000),
+		TaxDeduction:         entity.NewMoney(250000),
+		EmployeeContribution: entity.NewMoney(50000),
+		TotalTakeHomePay:     entity.NewMoney(4700000),
+	}
+
+	mockYearToDateTotal := entity.YearToDateTotal{
+		ID:                   4,
+		UserID:               2,
+		Year:                 2023,
+		GrossEarnings:        entity.NewMoney(15000000),
+		TaxDeduction:         entity.NewMoney(750000),
+		EmployeeContribution: entity.NewMoney(150000),
+		TotalTakeHomePay:     entity.NewMoney(14100000),
+	}
+
+	mockLoan := entity.Loan{
+		ID:               3,
+		UserID:           2,
+		Principal:        1000000,
+		InstalmentCount:  2,
+		InstalmentAmount: 500000,
+		RemainingBalance: 0,
+		Currency:         entity.ReportingCurrency,
+		LoanStatus:       entity.LoanStatusPaidOff,
+	}
+
 	tests := []struct {
-		name               string
-		attendancePeriodID int64
-		mockFunc           func()
-		want               dto.Payslip
-		wantErr            bool
+		name     string
+		param    dto.RerunPayrollParam
+		mockFunc func()
+		wantErr  bool
+		errCode  codes.Code
 	}{
 		{
-			name:               "Success",
-			attendancePeriodID: mockAttendancePeriodID,
+			name:  "Success",
+			param: mockParam,
 			mockFunc: func() {
-				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
-				mockAttendancePeriodDom.EXPECT().Get(
-					gomock.Any(),
-					entity.AttendancePeriodParam{
-						ID: mockAttendancePeriodID,
-						QueryOption: query.Option{
-							IsActive: true,
-						},
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockCurrentPayrollRunParam).Return(mockCurrentPayrollRun, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txRerunPayroll", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
 					},
-				).Return(mockAttendancePeriod, nil)
-				mockPayslipDom.EXPECT().Get(
-					gomock.Any(),
-					entity.PayslipParam{
-						AttendancePeriodID: mockAttendancePeriodID,
-						UserID:             mockLoginUser.ID,
-						QueryOption: query.Option{
-							IsActive: true,
-						},
+				)
+				mockAttendancePeriodDom.EXPECT().Update(ctx, mockProcessingParam, entity.AttendancePeriodParam{ID: 1}).Return(nil)
+				mockPayrollRunDom.EXPECT().Update(ctx, mockVoidParam, entity.PayrollRunParam{ID: mockCurrentPayrollRun.ID}).Return(nil)
+				mockLoanRepaymentDom.EXPECT().GetList(ctx, mockRepaymentParam).Return([]entity.LoanRepayment{mockRepayment}, nil, nil)
+				mockLoanDom.EXPECT().Get(ctx, entity.LoanParam{ID: mockLoan.ID, BypassCache: true}).Return(mockLoan, nil)
+				mockLoanDom.EXPECT().Update(ctx, entity.LoanUpdateParam{
+					RemainingBalance: entity.NullMoneyFrom(500000),
+					LoanStatus:       entity.LoanStatusActive,
+					UpdatedAt:        null.TimeFrom(mockTime),
+					UpdatedBy:        null.Int64From(mockLoginUser.ID),
+				}, entity.LoanParam{ID: mockLoan.ID}).Return(nil)
+				mockLoanRepaymentDom.EXPECT().Update(ctx, entity.LoanRepaymentUpdateParam{
+					RepaymentStatus: entity.LoanRepaymentStatusReversed,
+					UpdatedAt:       null.TimeFrom(mockTime),
+					UpdatedBy:       null.Int64From(mockLoginUser.ID),
+				}, entity.LoanRepaymentParam{
+					PayrollRunID:    mockCurrentPayrollRun.ID,
+					RepaymentStatus: entity.LoanRepaymentStatusPaid,
+				}).Return(nil)
+				mockPayslipDom.EXPECT().GetList(ctx, entity.PayslipParam{
+					PayrollRunID: mockCurrentPayrollRun.ID,
+					BypassCache:  true,
+					QueryOption: query.Option{
+						IsActive:     true,
+						DisableLimit: true,
 					},
-				).Return(mockPayslip, nil)
-				mockPayslipDetailDom.EXPECT().GetList(
-					gomock.Any(),
-					entity.PayslipDetailParam{
-						PayslipID: mockPayslip.ID,
-						QueryOption: query.Option{
-							IsActive:     true,
-							DisableLimit: true,
-						},
+				}).Return([]entity.Payslip{mockVoidedPayslip}, nil, nil)
+				mockYearToDateTotalDom.EXPECT().Get(ctx, entity.YearToDateTotalParam{
+					UserID:      2,
+					Year:        2023,
+					BypassCache: true,
+					QueryOption: query.Option{
+						IsActive: true,
 					},
-				).Return(mockPayslipDetails, nil, nil)
+				}).Return(mockYearToDateTotal, nil)
+				mockYearToDateTotalDom.EXPECT().Update(ctx, entity.YearToDateTotalUpdateParam{
+					GrossEarnings:        entity.NullMoneyFrom(entity.NewMoney(10000000)),
+					TaxDeduction:         entity.NullMoneyFrom(entity.NewMoney(500000)),
+					EmployeeContribution: entity.NullMoneyFrom(entity.NewMoney(100000)),
+					EmployerContribution: entity.NullMoneyFrom(0),
+					TotalTakeHomePay:     entity.NullMoneyFrom(entity.NewMoney(9400000)),
+					UpdatedAt:            null.TimeFrom(mockTime),
+					UpdatedBy:            null.Int64From(mockLoginUser.ID),
+				}, entity.YearToDateTotalParam{ID: mockYearToDateTotal.ID}).Return(nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockLatestPayrollRunParam).Return(mockVoidedPayrollRun, nil)
+				mockPayrollRunDom.EXPECT().Create(ctx, entity.PayrollRunInputParam{
+					AttendancePeriodID: 1,
+					Version:            2,
+					RunStatus:          entity.PayrollRunStatusProcessing,
+					CreatedAt:          null.TimeFrom(mockTime),
+					CreatedBy:          null.Int64From(mockLoginUser.ID),
+				}).Return(mockNextPayrollRun, nil)
+				mockPublisher.EXPECT().Publish(
+					ctx,
+					entity.ExchangePayrollEvent,
+					entity.RoutingKeyPayrollCalculate,
+					dto.PubSubGeneratePayrollMessage{
+						AttendancePeriod: mockAttendancePeriod,
+						PayrollRun:       mockNextPayrollRun,
+						LoginUser:        mockLoginUser,
+					},
+				).Return(nil)
 			},
-			want:    expectedPayslip,
-			wantErr: false,
 		},
 		{
-			name:               "Failed PayslipDetail Generic Error",
-			attendancePeriodID: mockAttendancePeriodID,
+			name:  "Failed Reverse Loan Repayments",
+			param: mockParam,
 			mockFunc: func() {
-				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
-				mockAttendancePeriodDom.EXPECT().Get(
-					gomock.Any(),
-					entity.AttendancePeriodParam{
-						ID: mockAttendancePeriodID,
-						QueryOption: query.Option{
-							IsActive: true,
-						},
-					},
-				).Return(mockAttendancePeriod, nil)
-				mockPayslipDom.EXPECT().Get(
-					gomock.Any(),
-					entity.PayslipParam{
-						AttendancePeriodID: mockAttendancePeriodID,
-						UserID:             mockLoginUser.ID,
-						QueryOption: query.Option{
-							IsActive: true,
-						},
-					},
-				).Return(mockPayslip, nil)
-				mockPayslipDetailDom.EXPECT().GetList(
-					gomock.Any(),
-					entity.PayslipDetailParam{
-						PayslipID: mockPayslip.ID,
-						QueryOption: query.Option{
-							IsActive:     true,
-							DisableLimit: true,
-						},
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockCurrentPayrollRunParam).Return(mockCurrentPayrollRun, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txRerunPayroll", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
 					},
-				).Return(nil, nil, assert.AnError)
+				)
+				mockAttendancePeriodDom.EXPECT().Update(ctx, mockProcessingParam, entity.AttendancePeriodParam{ID: 1}).Return(nil)
+				mockPayrollRunDom.EXPECT().Update(ctx, mockVoidParam, entity.PayrollRunParam{ID: mockCurrentPayrollRun.ID}).Return(nil)
+				mockLoanRepaymentDom.EXPECT().GetList(ctx, mockRepaymentParam).Return(nil, nil, assert.AnError)
 			},
-			want:    dto.Payslip{},
 			wantErr: true,
 		},
 		{
-			name:               "Failed Payslip Not Found",
-			attendancePeriodID: mockAttendancePeriodID,
+			name:  "Failed Set Attendance Period Processing",
+			param: mockParam,
 			mockFunc: func() {
-				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
-				mockAttendancePeriodDom.EXPECT().Get(
-					gomock.Any(),
-					entity.AttendancePeriodParam{
-						ID: mockAttendancePeriodID,
-						QueryOption: query.Option{
-							IsActive: true,
-						},
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockCurrentPayrollRunParam).Return(mockCurrentPayrollRun, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txRerunPayroll", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
 					},
-				).Return(mockAttendancePeriod, nil)
-				mockPayslipDom.EXPECT().Get(
-					gomock.Any(),
-					entity.PayslipParam{
-						AttendancePeriodID: mockAttendancePeriodID,
-						UserID:             mockLoginUser.ID,
-						QueryOption: query.Option{
-							IsActive: true,
-						},
+				)
+				mockAttendancePeriodDom.EXPECT().Update(ctx, mockProcessingParam, entity.AttendancePeriodParam{ID: 1}).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Failed Void Payroll Run",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockCurrentPayrollRunParam).Return(mockCurrentPayrollRun, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txRerunPayroll", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
 					},
-				).Return(entity.Payslip{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+				)
+				mockAttendancePeriodDom.EXPECT().Update(ctx, mockProcessingParam, entity.AttendancePeriodParam{ID: 1}).Return(nil)
+				mockPayrollRunDom.EXPECT().Update(ctx, mockVoidParam, entity.PayrollRunParam{ID: mockCurrentPayrollRun.ID}).Return(assert.AnError)
 			},
-			want:    dto.Payslip{},
 			wantErr: true,
 		},
 		{
-			name:               "Failed Payslip Generic Error",
-			attendancePeriodID: mockAttendancePeriodID,
+			name:  "Failed Current Run Not Finished",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockCurrentPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name:  "Failed Attendance Period Not Processed",
+			param: mockParam,
+			mockFunc: func() {
+				closedAttendancePeriod := mockAttendancePeriod
+				closedAttendancePeriod.PeriodStatus = entity.PeriodStatusClosed
+
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(closedAttendancePeriod, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Failed Attendance Period Not Found",
+			param: mockParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Failed Empty Reason",
+			param: dto.RerunPayrollParam{Reason: " "},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.RerunPayroll(ctx, 1, tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendancePeriod.RerunPayroll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
+
+func Test_attendancePeriod_ResumePayrollRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
+	mockPublisher := mock_publisher.NewMockInterface(ctrl)
+	mockTransactor := mock_transactor.NewMockInterface(ctrl)
+	mockPayrollRunDom := mock_payroll_run.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:             mockAuth,
+		AttendancePeriod: mockAttendancePeriodDom,
+		Publisher:        mockPublisher,
+		Transactor:       mockTransactor,
+		PayrollRun:       mockPayrollRunDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     100,
+		Name:   "Test User",
+		Email:  "test@example.com",
+		RoleID: 1,
+	}
+
+	mockPayrollRunParam := entity.PayrollRunParam{
+		ID:          6,
+		BypassCache: true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockLatestPayrollRunParam := entity.PayrollRunParam{
+		AttendancePeriodID: 1,
+		BypassCache:        true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-version"},
+		},
+	}
+
+	mockFailedPayrollRun := entity.PayrollRun{
+		ID:                 6,
+		AttendancePeriodID: 1,
+		Version:            2,
+		RunStatus:          entity.PayrollRunStatusProcessError,
+		ProcessError:       null.StringFrom("failed to generate payslip of 3 employees"),
+	}
+
+	mockResumedPayrollRun := mockFailedPayrollRun
+	mockResumedPayrollRun.RunStatus = entity.PayrollRunStatusProcessing
+	mockResumedPayrollRun.ProcessError = null.String{}
+
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		ID: 1,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockAttendancePeriod := entity.AttendancePeriod{
+		ID:           1,
+		StartDate:    null.DateFrom(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:      null.DateFrom(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)),
+		PeriodStatus: entity.PeriodStatusProcessError,
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockPayrollRunParam).Return(mockFailedPayrollRun, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockLatestPayrollRunParam).Return(mockFailedPayrollRun, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txResumePayrollRun", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
+					},
+				)
+				mockAttendancePeriodDom.EXPECT().Update(ctx, entity.AttendancePeriodUpdateParam{
+					PeriodStatus: entity.PeriodStatusProcessing,
+					UpdatedAt:    null.TimeFrom(mockTime),
+					UpdatedBy:    null.Int64From(mockLoginUser.ID),
+				}, mockAttendancePeriodParam).Return(nil)
+				mockPayrollRunDom.EXPECT().Update(ctx, entity.PayrollRunUpdateParam{
+					RunStatus:    entity.PayrollRunStatusProcessing,
+					ProcessError: null.String{SqlNull: true},
+					UpdatedAt:    null.TimeFrom(mockTime),
+					UpdatedBy:    null.Int64From(mockLoginUser.ID),
+				}, entity.PayrollRunParam{ID: mockFailedPayrollRun.ID}).Return(nil)
+				mockPublisher.EXPECT().Publish(
+					ctx,
+					entity.ExchangePayrollEvent,
+					entity.RoutingKeyPayrollCalculate,
+					dto.PubSubGeneratePayrollMessage{
+						AttendancePeriod: mockAttendancePeriod,
+						PayrollRun:       mockResumedPayrollRun,
+						LoginUser:        mockLoginUser,
+					},
+				).Return(nil)
+			},
+		},
+		{
+			name: "Failed Publish Message",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockPayrollRunParam).Return(mockFailedPayrollRun, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockLatestPayrollRunParam).Return(mockFailedPayrollRun, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txResumePayrollRun", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
+					},
+				)
+				mockAttendancePeriodDom.EXPECT().Update(ctx, gomock.Any(), mockAttendancePeriodParam).Return(nil)
+				mockPayrollRunDom.EXPECT().Update(ctx, gomock.Any(), entity.PayrollRunParam{ID: mockFailedPayrollRun.ID}).Return(nil)
+				mockPublisher.EXPECT().Publish(ctx, entity.ExchangePayrollEvent, entity.RoutingKeyPayrollCalculate, gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Failed Payroll Run Superseded",
+			mockFunc: func() {
+				newerPayrollRun := mockFailedPayrollRun
+				newerPayrollRun.ID = 7
+				newerPayrollRun.Version = 3
+
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockPayrollRunParam).Return(mockFailedPayrollRun, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockLatestPayrollRunParam).Return(newerPayrollRun, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Failed Payroll Run Already Processed",
+			mockFunc: func() {
+				processedPayrollRun := mockFailedPayrollRun
+				processedPayrollRun.RunStatus = entity.PayrollRunStatusProcessed
+
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockPayrollRunParam).Return(processedPayrollRun, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Failed Payroll Run Being Processed",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockPayrollRunParam).Return(mockResumedPayrollRun, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name: "Failed Payroll Run Not Found",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.ResumePayrollRun(ctx, 6)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendancePeriod.ResumePayrollRun() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
+
+func Test_attendancePeriod_GetPayrollStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
+	mockPayrollRunDom := mock_payroll_run.NewMockInterface(ctrl)
+	mockPayrollRunEmployeeDom := mock_payroll_run_employee.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		AttendancePeriod:   mockAttendancePeriodDom,
+		PayrollRun:         mockPayrollRunDom,
+		PayrollRunEmployee: mockPayrollRunEmployeeDom,
+	})
+
+	ctx := context.Background()
+
+	mockStartedAt := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		ID:          1,
+		BypassCache: true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockAttendancePeriod := entity.AttendancePeriod{
+		ID:           1,
+		StartDate:    null.DateFrom(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:      null.DateFrom(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)),
+		PeriodStatus: entity.PeriodStatusProcessing,
+	}
+
+	mockLatestPayrollRunParam := entity.PayrollRunParam{
+		AttendancePeriodID: 1,
+		BypassCache:        true,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-version"},
+		},
+	}
+
+	mockPayrollRun := entity.PayrollRun{
+		ID:                 5,
+		AttendancePeriodID: 1,
+		Version:            1,
+		RunStatus:          entity.PayrollRunStatusProcessing,
+		TotalEmployee:      8,
+		ProcessedEmployee:  2,
+		FailedEmployee:     1,
+		StartedAt:          null.TimeFrom(mockStartedAt),
+	}
+
+	mockFailedPayrollRunEmployeeParam := entity.PayrollRunEmployeeParam{
+		PayrollRunID:   5,
+		EmployeeStatus: entity.PayrollRunEmployeeStatusFailed,
+		BypassCache:    true,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	mockFailedPayrollRunEmployees := []entity.PayrollRunEmployee{
+		{
+			ID:             1,
+			PayrollRunID:   5,
+			UserID:         11,
+			EmployeeStatus: entity.PayrollRunEmployeeStatusFailed,
+			ProcessError:   null.StringFrom("base salary is not set"),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     dto.PayrollStatus
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockLatestPayrollRunParam).Return(mockPayrollRun, nil)
+				mockPayrollRunEmployeeDom.EXPECT().GetList(ctx, mockFailedPayrollRunEmployeeParam).Return(mockFailedPayrollRunEmployees, nil, nil)
+			},
+			want: dto.PayrollStatus{
+				AttendancePeriodID: 1,
+				PeriodStatus:       entity.PeriodStatusProcessing,
+				PayrollRunID:       5,
+				Version:            1,
+				State:              entity.PayrollRunStatusProcessing,
+				TotalEmployee:      8,
+				ProcessedEmployee:  2,
+				FailedEmployee:     1,
+				Progress:           25,
+				StartedAt:          null.TimeFrom(mockStartedAt),
+				Failures: []dto.PayrollStatusFailure{
+					{
+						UserID:       11,
+						ProcessError: "base salary is not set",
+					},
+				},
+			},
+		},
+		{
+			name: "Failed Get Failed Employees",
+			mockFunc: func() {
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockLatestPayrollRunParam).Return(mockPayrollRun, nil)
+				mockPayrollRunEmployeeDom.EXPECT().GetList(ctx, mockFailedPayrollRunEmployeeParam).Return(nil, nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Failed Payroll Not Run",
+			mockFunc: func() {
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(ctx, mockLatestPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name: "Failed Attendance Period Not Found",
+			mockFunc: func() {
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetPayrollStatus(ctx, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendancePeriod.GetPayrollStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_attendancePeriod_GeneratePayslip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
+	mockPayslipDom := mock_payslip.NewMockInterface(ctrl)
+	mockPayslipDetailDom := mock_payslip_detail.NewMockInterface(ctrl)
+	mockPayrollRunDom := mock_payroll_run.NewMockInterface(ctrl)
+	mockPayslipCurrencyTotalDom := mock_payslip_currency_total.NewMockInterface(ctrl)
+	mockYearToDateTotalDom := mock_year_to_date_total.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:                 mockAuth,
+		AttendancePeriod:     mockAttendancePeriodDom,
+		Payslip:              mockPayslipDom,
+		PayslipDetail:        mockPayslipDetailDom,
+		PayrollRun:           mockPayrollRunDom,
+		PayslipCurrencyTotal: mockPayslipCurrencyTotalDom,
+		YearToDateTotal:      mockYearToDateTotalDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:    1,
+		Name:  "Test User",
+		Email: "test@example.com",
+	}
+
+	mockAttendancePeriodID := int64(1)
+	mockAttendancePeriod := entity.AttendancePeriod{
+		ID:        mockAttendancePeriodID,
+		StartDate: null.DateFrom(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   null.DateFrom(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)),
+	}
+
+	mockCurrentPayrollRunParam := entity.PayrollRunParam{
+		AttendancePeriodID: mockAttendancePeriodID,
+		RunStatus:          entity.PayrollRunStatusProcessed,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-version"},
+		},
+	}
+
+	mockPayrollRun := entity.PayrollRun{
+		ID:                 7,
+		AttendancePeriodID: mockAttendancePeriodID,
+		Version:            2,
+		RunStatus:          entity.PayrollRunStatusProcessed,
+	}
+
+	mockPayslip := entity.Payslip{
+		ID:                     1,
+		UserID:                 mockLoginUser.ID,
+		AttendancePeriodID:     mockAttendancePeriodID,
+		PayrollRunID:           mockPayrollRun.ID,
+		BasePayComponent:       1000.0,
+		OvertimeComponent:      200.0,
+		ReimbursementComponent: 100.0,
+		TotalTakeHomePay:       1300.0,
+	}
+
+	mockPayslipDetails := []entity.PayslipDetail{
+		{
+			ID:          1,
+			PayslipID:   mockPayslip.ID,
+			ItemType:    entity.PayslipItemTypeEarningBasePay,
+			Description: "Base Pay for 20 Attendance on 22 Workdays",
+			Amount:      1000.0,
+			Currency:    entity.ReportingCurrency,
+		},
+		{
+			ID:          2,
+			PayslipID:   mockPayslip.ID,
+			ItemType:    entity.PayslipItemTypeEarningOvertime,
+			Description: "Overtime 4 Hours on 2023-05-10",
+			Amount:      200.0,
+			Currency:    entity.ReportingCurrency,
+		},
+		{
+			ID:          3,
+			PayslipID:   mockPayslip.ID,
+			ItemType:    entity.PayslipItemTypeReimbursement,
+			Description: "Transport Reimbursement",
+			Amount:      100.0,
+			Currency:    entity.ReportingCurrency,
+		},
+	}
+
+	mockPayslipCurrencyTotalParam := entity.PayslipCurrencyTotalParam{
+		PayslipID: mockPayslip.ID,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"currency"},
+		},
+	}
+
+	mockPayslipCurrencyTotals := []entity.PayslipCurrencyTotal{
+		{
+			ID:                     1,
+			PayslipID:              mockPayslip.ID,
+			PayrollRunID:           mockPayrollRun.ID,
+			Currency:               entity.ReportingCurrency,
+			ExchangeRate:           1,
+			BasePayComponent:       1000.0,
+			OvertimeComponent:      200.0,
+			ReimbursementComponent: 100.0,
+			TotalTakeHomePay:       1300.0,
+		},
+	}
+
+	mockYearToDateTotalParam := entity.YearToDateTotalParam{
+		UserID: mockLoginUser.ID,
+		Year:   2023,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockYearToDateTotal := entity.YearToDateTotal{
+		ID:               3,
+		UserID:           mockLoginUser.ID,
+		Year:             2023,
+		GrossEarnings:    6000.0,
+		TotalTakeHomePay: 6500.0,
+	}
+
+	expectedPayslip := dto.Payslip{
+		StartDate:            mockAttendancePeriod.StartDate,
+		EndDate:              mockAttendancePeriod.EndDate,
+		Version:              mockPayrollRun.Version,
+		ReportingCurrency:    entity.ReportingCurrency,
+		BasePayComponent:     mockPayslip.BasePayComponent,
+		OvertimeComponent:    mockPayslip.OvertimeComponent,
+		ReimburseComponent:   mockPayslip.ReimbursementComponent,
+		DeductionComponent:   mockPayslip.DeductionComponent,
+		EmployerContribution: mockPayslip.EmployerContribution,
+		TotalTakeHomePay:     mockPayslip.TotalTakeHomePay,
+		YearToDate: dto.YearToDate{
+			Year:             2023,
+			Currency:         entity.ReportingCurrency,
+			GrossEarnings:    6000.0,
+			TotalTakeHomePay: 6500.0,
+		},
+		CurrencyTotals: []dto.PayslipCurrencyTotal{
+			{
+				Currency:           entity.ReportingCurrency,
+				ExchangeRate:       1,
+				BasePayComponent:   1000.0,
+				OvertimeComponent:  200.0,
+				ReimburseComponent: 100.0,
+				TotalTakeHomePay:   1300.0,
+			},
+		},
+		Details: []dto.PayslipDetail{
+			{
+				Type:        entity.PayslipItemTypeEarningBasePay,
+				Description: "Base Pay for 20 Attendance on 22 Workdays",
+				Amount:      1000.0,
+				Currency:    entity.ReportingCurrency,
+			},
+			{
+				Type:        entity.PayslipItemTypeEarningOvertime,
+				Description: "Overtime 4 Hours on 2023-05-10",
+				Amount:      200.0,
+				Currency:    entity.ReportingCurrency,
+			},
+			{
+				Type:        entity.PayslipItemTypeReimbursement,
+				Description: "Transport Reimbursement",
+				Amount:      100.0,
+				Currency:    entity.ReportingCurrency,
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		attendancePeriodID int64
+		mockFunc           func()
+		want               dto.Payslip
+		wantErr            bool
+	}{
+		{
+			name:               "Success",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(
+					gomock.Any(),
+					entity.AttendancePeriodParam{
+						ID: mockAttendancePeriodID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
+				mockPayslipDom.EXPECT().Get(
+					gomock.Any(),
+					entity.PayslipParam{
+						PayrollRunID: mockPayrollRun.ID,
+						UserID:       mockLoginUser.ID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockPayslip, nil)
+				mockPayslipDetailDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.PayslipDetailParam{
+						PayslipID: mockPayslip.ID,
+						QueryOption: query.Option{
+							IsActive:     true,
+							DisableLimit: true,
+						},
+					},
+				).Return(mockPayslipDetails, nil, nil)
+				mockPayslipCurrencyTotalDom.EXPECT().GetList(gomock.Any(), mockPayslipCurrencyTotalParam).Return(mockPayslipCurrencyTotals, nil, nil)
+				mockYearToDateTotalDom.EXPECT().Get(gomock.Any(), mockYearToDateTotalParam).Return(mockYearToDateTotal, nil)
+			},
+			want:    expectedPayslip,
+			wantErr: false,
+		},
+		{
+			name:               "Failed YearToDateTotal Generic Error",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(
+					gomock.Any(),
+					entity.AttendancePeriodParam{
+						ID: mockAttendancePeriodID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
+				mockPayslipDom.EXPECT().Get(
+					gomock.Any(),
+					entity.PayslipParam{
+						PayrollRunID: mockPayrollRun.ID,
+						UserID:       mockLoginUser.ID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockPayslip, nil)
+				mockPayslipDetailDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.PayslipDetailParam{
+						PayslipID: mockPayslip.ID,
+						QueryOption: query.Option{
+							IsActive:     true,
+							DisableLimit: true,
+						},
+					},
+				).Return(mockPayslipDetails, nil, nil)
+				mockPayslipCurrencyTotalDom.EXPECT().GetList(gomock.Any(), mockPayslipCurrencyTotalParam).Return(mockPayslipCurrencyTotals, nil, nil)
+				mockYearToDateTotalDom.EXPECT().Get(gomock.Any(), mockYearToDateTotalParam).Return(entity.YearToDateTotal{}, assert.AnError)
+			},
+			want:    dto.Payslip{},
+			wantErr: true,
+		},
+		{
+			name:               "Failed PayslipCurrencyTotal Generic Error",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(
+					gomock.Any(),
+					entity.AttendancePeriodParam{
+						ID: mockAttendancePeriodID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
+				mockPayslipDom.EXPECT().Get(
+					gomock.Any(),
+					entity.PayslipParam{
+						PayrollRunID: mockPayrollRun.ID,
+						UserID:       mockLoginUser.ID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockPayslip, nil)
+				mockPayslipDetailDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.PayslipDetailParam{
+						PayslipID: mockPayslip.ID,
+						QueryOption: query.Option{
+							IsActive:     true,
+							DisableLimit: true,
+						},
+					},
+				).Return(mockPayslipDetails, nil, nil)
+				mockPayslipCurrencyTotalDom.EXPECT().GetList(gomock.Any(), mockPayslipCurrencyTotalParam).Return(nil, nil, assert.AnError)
+			},
+			want:    dto.Payslip{},
+			wantErr: true,
+		},
+		{
+			name:               "Failed PayslipDetail Generic Error",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(
+					gomock.Any(),
+					entity.AttendancePeriodParam{
+						ID: mockAttendancePeriodID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
+				mockPayslipDom.EXPECT().Get(
+					gomock.Any(),
+					entity.PayslipParam{
+						PayrollRunID: mockPayrollRun.ID,
+						UserID:       mockLoginUser.ID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockPayslip, nil)
+				mockPayslipDetailDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.PayslipDetailParam{
+						PayslipID: mockPayslip.ID,
+						QueryOption: query.Option{
+							IsActive:     true,
+							DisableLimit: true,
+						},
+					},
+				).Return(nil, nil, assert.AnError)
+			},
+			want:    dto.Payslip{},
+			wantErr: true,
+		},
+		{
+			name:               "Failed Payslip Not Found",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(
+					gomock.Any(),
+					entity.AttendancePeriodParam{
+						ID: mockAttendancePeriodID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
+				mockPayslipDom.EXPECT().Get(
+					gomock.Any(),
+					entity.PayslipParam{
+						PayrollRunID: mockPayrollRun.ID,
+						UserID:       mockLoginUser.ID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(entity.Payslip{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			want:    dto.Payslip{},
+			wantErr: true,
+		},
+		{
+			name:               "Failed Payslip Generic Error",
+			attendancePeriodID: mockAttendancePeriodID,
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
 				mockAttendancePeriodDom.EXPECT().Get(
@@ -670,16 +1662,36 @@ func Test_attendancePeriod_GeneratePayslip(t *testing.T) {
 						},
 					},
 				).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
 				mockPayslipDom.EXPECT().Get(
 					gomock.Any(),
-					entity.PayslipParam{
-						AttendancePeriodID: mockAttendancePeriodID,
-						UserID:             mockLoginUser.ID,
+					entity.PayslipParam{
+						PayrollRunID: mockPayrollRun.ID,
+						UserID:       mockLoginUser.ID,
+						QueryOption: query.Option{
+							IsActive: true,
+						},
+					},
+				).Return(entity.Payslip{}, assert.AnError)
+			},
+			want:    dto.Payslip{},
+			wantErr: true,
+		},
+		{
+			name:               "Failed No Processed Payroll Run",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(gomock.Any()).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(
+					gomock.Any(),
+					entity.AttendancePeriodParam{
+						ID: mockAttendancePeriodID,
 						QueryOption: query.Option{
 							IsActive: true,
 						},
 					},
-				).Return(entity.Payslip{}, assert.AnError)
+				).Return(mockAttendancePeriod, nil)
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
 			},
 			want:    dto.Payslip{},
 			wantErr: true,
@@ -752,11 +1764,15 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 	mockUserDom := mock_user.NewMockInterface(ctrl)
 	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
 	mockPayslipDom := mock_payslip.NewMockInterface(ctrl)
+	mockPayrollRunDom := mock_payroll_run.NewMockInterface(ctrl)
+	mockPayslipCurrencyTotalDom := mock_payslip_currency_total.NewMockInterface(ctrl)
 
 	uc := Init(InitParam{
-		User:             mockUserDom,
-		AttendancePeriod: mockAttendancePeriodDom,
-		Payslip:          mockPayslipDom,
+		User:                 mockUserDom,
+		AttendancePeriod:     mockAttendancePeriodDom,
+		Payslip:              mockPayslipDom,
+		PayrollRun:           mockPayrollRunDom,
+		PayslipCurrencyTotal: mockPayslipCurrencyTotalDom,
 	})
 
 	// Set up test data
@@ -772,6 +1788,30 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 			Name:       "User 2",
 			BaseSalary: 12000,
 		},
+		// joined after the period, so the run has no payslip of them
+		{
+			ID:         3,
+			Name:       "User 3",
+			BaseSalary: 11000,
+		},
+	}
+
+	mockCurrentPayrollRunParam := entity.PayrollRunParam{
+		AttendancePeriodID: mockAttendancePeriodID,
+		RunStatus:          entity.PayrollRunStatusProcessed,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-version"},
+		},
+	}
+
+	mockPayrollRun := entity.PayrollRun{
+		ID:                 7,
+		AttendancePeriodID: mockAttendancePeriodID,
+		Version:            2,
+		RunStatus:          entity.PayrollRunStatusProcessed,
 	}
 
 	mockPayslips := []entity.Payslip{
@@ -779,9 +1819,11 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 			ID:                     1,
 			UserID:                 1,
 			AttendancePeriodID:     mockAttendancePeriodID,
-			BasePayComponent:       9000,
+			BasePayComponent:       9700,
 			OvertimeComponent:      500,
 			ReimbursementComponent: 300,
+			DeductionComponent:     700,
+			EmployerContribution:   400,
 			TotalTakeHomePay:       9800,
 		},
 		{
@@ -791,23 +1833,78 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 			BasePayComponent:       12000,
 			OvertimeComponent:      0,
 			ReimbursementComponent: 200,
+			EmployerContribution:   600,
 			TotalTakeHomePay:       12200,
 		},
 	}
 
+	mockPayslipCurrencyTotalParam := entity.PayslipCurrencyTotalParam{
+		PayrollRunID: mockPayrollRun.ID,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"currency"},
+		},
+	}
+
+	// the second employee is paid in a foreign currency
+	mockPayslipCurrencyTotals := []entity.PayslipCurrencyTotal{
+		{
+			PayslipID:              1,
+			PayrollRunID:           mockPayrollRun.ID,
+			Currency:               entity.ReportingCurrency,
+			ExchangeRate:           1,
+			BasePayComponent:       9700,
+			OvertimeComponent:      500,
+			ReimbursementComponent: 300,
+			DeductionComponent:     700,
+			EmployerContribution:   400,
+			TotalTakeHomePay:       9800,
+		},
+		{
+			PayslipID:              2,
+			PayrollRunID:           mockPayrollRun.ID,
+			Currency:               "USD",
+			ExchangeRate:           100,
+			BasePayComponent:       120,
+			ReimbursementComponent: 2,
+			EmployerContribution:   6,
+			TotalTakeHomePay:       122,
+		},
+	}
+
 	expectedSummary := dto.PayslipSummary{
+		PayrollRunID:             mockPayrollRun.ID,
+		Version:                  mockPayrollRun.Version,
+		RunStatus:                mockPayrollRun.RunStatus,
+		ReportingCurrency:        entity.ReportingCurrency,
 		TotalEmployeeTakeHomePay: 22000,
+		TotalEmployerCost:        23700,
 		TotalEmployee:            int64(2),
+		CurrencyTotals: []dto.CurrencyPayout{
+			{Currency: entity.ReportingCurrency, TakeHomePay: 9800, EmployerCost: 10900},
+			{Currency: "USD", TakeHomePay: 122, EmployerCost: 128},
+		},
 		EmployeePayouts: []dto.EmployeePayout{
 			{
-				ID:          1,
-				Name:        "User 1",
-				TakeHomePay: 9800,
+				ID:           1,
+				Name:         "User 1",
+				TakeHomePay:  9800,
+				EmployerCost: 10900,
+				Payouts: []dto.CurrencyPayout{
+					{Currency: entity.ReportingCurrency, TakeHomePay: 9800, EmployerCost: 10900},
+				},
 			},
 			{
-				ID:          2,
-				Name:        "User 2",
-				TakeHomePay: 12200,
+				ID:           2,
+				Name:         "User 2",
+				TakeHomePay:  12200,
+				EmployerCost: 12800,
+				Payouts: []dto.CurrencyPayout{
+					{Currency: "USD", TakeHomePay: 122, EmployerCost: 128},
+				},
 			},
 		},
 	}
@@ -824,12 +1921,12 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 			name:               "Success",
 			attendancePeriodID: mockAttendancePeriodID,
 			mockFunc: func() {
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
 				mockUserDom.EXPECT().GetList(
 					gomock.Any(),
 					entity.UserParam{
 						RoleID: entity.RoleIDUser,
 						QueryOption: query.Option{
-							IsActive:     true,
 							DisableLimit: true,
 						},
 					},
@@ -838,30 +1935,70 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 				mockPayslipDom.EXPECT().GetList(
 					gomock.Any(),
 					entity.PayslipParam{
-						AttendancePeriodID: mockAttendancePeriodID,
+						PayrollRunID: mockPayrollRun.ID,
 						QueryOption: query.Option{
 							IsActive:     true,
 							DisableLimit: true,
 						},
 					},
 				).Return(mockPayslips, nil, nil)
+				mockPayslipCurrencyTotalDom.EXPECT().GetList(gomock.Any(), mockPayslipCurrencyTotalParam).Return(mockPayslipCurrencyTotals, nil, nil)
 			},
 			want:    expectedSummary,
 			wantErr: false,
 		},
 		{
-			name:               "Failed GetList Users",
+			name:               "Failed getPayslipIDToCurrencyTotals",
 			attendancePeriodID: mockAttendancePeriodID,
 			mockFunc: func() {
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
 				mockUserDom.EXPECT().GetList(
 					gomock.Any(),
 					entity.UserParam{
 						RoleID: entity.RoleIDUser,
+						QueryOption: query.Option{
+							DisableLimit: true,
+						},
+					},
+				).Return(mockUsers, nil, nil)
+
+				mockPayslipDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.PayslipParam{
+						PayrollRunID: mockPayrollRun.ID,
 						QueryOption: query.Option{
 							IsActive:     true,
 							DisableLimit: true,
 						},
 					},
+				).Return(mockPayslips, nil, nil)
+				mockPayslipCurrencyTotalDom.EXPECT().GetList(gomock.Any(), mockPayslipCurrencyTotalParam).Return(nil, nil, assert.AnError)
+			},
+			want:    dto.PayslipSummary{},
+			wantErr: true,
+		},
+		{
+			name:               "Failed No Processed Payroll Run",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(entity.PayrollRun{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			want:    dto.PayslipSummary{},
+			wantErr: true,
+		},
+		{
+			name:               "Failed GetList Users",
+			attendancePeriodID: mockAttendancePeriodID,
+			mockFunc: func() {
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
+				mockUserDom.EXPECT().GetList(
+					gomock.Any(),
+					entity.UserParam{
+						RoleID: entity.RoleIDUser,
+						QueryOption: query.Option{
+							DisableLimit: true,
+						},
+					},
 				).Return(nil, nil, assert.AnError)
 			},
 			want:    dto.PayslipSummary{},
@@ -871,12 +2008,12 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 			name:               "Failed getUserIDToPayslip",
 			attendancePeriodID: mockAttendancePeriodID,
 			mockFunc: func() {
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
 				mockUserDom.EXPECT().GetList(
 					gomock.Any(),
 					entity.UserParam{
 						RoleID: entity.RoleIDUser,
 						QueryOption: query.Option{
-							IsActive:     true,
 							DisableLimit: true,
 						},
 					},
@@ -885,7 +2022,7 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 				mockPayslipDom.EXPECT().GetList(
 					gomock.Any(),
 					entity.PayslipParam{
-						AttendancePeriodID: mockAttendancePeriodID,
+						PayrollRunID: mockPayrollRun.ID,
 						QueryOption: query.Option{
 							IsActive:     true,
 							DisableLimit: true,
@@ -900,12 +2037,12 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 			name:               "No Payslips Found",
 			attendancePeriodID: mockAttendancePeriodID,
 			mockFunc: func() {
+				mockPayrollRunDom.EXPECT().Get(gomock.Any(), mockCurrentPayrollRunParam).Return(mockPayrollRun, nil)
 				mockUserDom.EXPECT().GetList(
 					gomock.Any(),
 					entity.UserParam{
 						RoleID: entity.RoleIDUser,
 						QueryOption: query.Option{
-							IsActive:     true,
 							DisableLimit: true,
 						},
 					},
@@ -914,7 +2051,7 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 				mockPayslipDom.EXPECT().GetList(
 					gomock.Any(),
 					entity.PayslipParam{
-						AttendancePeriodID: mockAttendancePeriodID,
+						PayrollRunID: mockPayrollRun.ID,
 						QueryOption: query.Option{
 							IsActive:     true,
 							DisableLimit: true,
@@ -946,6 +2083,429 @@ func Test_attendancePeriod_GeneratePayslipSummary(t *testing.T) {
 	}
 }
 
+func Test_attendancePeriod_PreviewPayroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
+	mockPublisher := mock_publisher.NewMockInterface(ctrl)
+	mockTransactor := mock_transactor.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+	mockAttendanceDom := mock_attendance.NewMockInterface(ctrl)
+	mockReimbursementDom := mock_reimbursement.NewMockInterface(ctrl)
+	mockOvertimeDom := mock_overtime.NewMockInterface(ctrl)
+	mockPayComponentDom := mock_pay_component.NewMockInterface(ctrl)
+	mockTaxBracketDom := mock_tax_bracket.NewMockInterface(ctrl)
+	mockPayrollPreviewDom := mock_payroll_preview.NewMockInterface(ctrl)
+	mockExchangeRateDom := mock_exchange_rate.NewMockInterface(ctrl)
+	mockAllowanceTypeDom := mock_allowance_type.NewMockInterface(ctrl)
+	mockUserAllowanceDom := mock_user_allowance.NewMockInterface(ctrl)
+	mockPayrollAdjustmentDom := mock_payroll_adjustment.NewMockInterface(ctrl)
+	mockLoanDom := mock_loan.NewMockInterface(ctrl)
+	mockHolidayDom := mock_holiday.NewMockInterface(ctrl)
+	mockWorkScheduleDom := mock_work_schedule.NewMockInterface(ctrl)
+	mockUserWorkScheduleDom := mock_user_work_schedule.NewMockInterface(ctrl)
+	mockUserSalaryDom := mock_user_salary.NewMockInterface(ctrl)
+	mockAttendanceTypeDom := mock_attendance_type.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:              mockAuth,
+		AttendancePeriod:  mockAttendancePeriodDom,
+		Publisher:         mockPublisher,
+		Transactor:        mockTransactor,
+		User:              mockUserDom,
+		Attendance:        mockAttendanceDom,
+		Reimbursement:     mockReimbursementDom,
+		Overtime:          mockOvertimeDom,
+		PayComponent:      mockPayComponentDom,
+		TaxBracket:        mockTaxBracketDom,
+		PayrollPreview:    mockPayrollPreviewDom,
+		ExchangeRate:      mockExchangeRateDom,
+		AllowanceType:     mockAllowanceTypeDom,
+		UserAllowance:     mockUserAllowanceDom,
+		PayrollAdjustment: mockPayrollAdjustmentDom,
+		Loan:              mockLoanDom,
+		Holiday:           mockHolidayDom,
+		WorkSchedule:      mockWorkScheduleDom,
+		UserWorkSchedule:  mockUserWorkScheduleDom,
+		UserSalary:        mockUserSalaryDom,
+		AttendanceType:    mockAttendanceTypeDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     100,
+		Name:   "Test User",
+		Email:  "test@example.com",
+		RoleID: 1,
+	}
+
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		ID: 1,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockAttendancePeriod := entity.AttendancePeriod{
+		ID:           1,
+		StartDate:    null.DateFrom(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:      null.DateFrom(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)),
+		PeriodStatus: entity.PeriodStatusClosed,
+	}
+
+	mockProcessedAttendancePeriod := mockAttendancePeriod
+	mockProcessedAttendancePeriod.PeriodStatus = entity.PeriodStatusProcessed
+
+	mockUserParam := entity.UserParam{
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	}
+
+	mockUsers := []entity.User{
+		{
+			ID:         1,
+			Name:       "User 1",
+			BaseSalary: entity.NewMoney(2300000),
+		},
+	}
+
+	mockManyUsers := make([]entity.User, payrollPreviewSyncLimit+1)
+	for i := range mockManyUsers {
+		mockManyUsers[i] = entity.User{ID: int64(i + 1)}
+	}
+
+	mockPayComponents := []entity.PayComponent{
+		{
+			Code:            entity.PayComponentCodeBasePay,
+			ItemType:        entity.PayslipItemTypeEarningBasePay,
+			EvaluationOrder: 1,
+		},
+	}
+
+	mockPayrollPreview := entity.PayrollPreview{
+		ID:                 10,
+		AttendancePeriodID: mockAttendancePeriod.ID,
+		PreviewStatus:      entity.PayrollPreviewStatusPending,
+		TotalEmployee:      int64(len(mockManyUsers)),
+	}
+
+	mockCalculationFunc := func() {
+		mockAttendanceDom.EXPECT().CountUserAttendance(gomock.Any(), mockAttendancePeriod.ID).Return(entity.UserAttendanceCount{1: 20}, nil)
+		mockAttendanceDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Attendance{}, nil, nil)
+		mockReimbursementDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Reimbursement{}, nil, nil)
+		mockOvertimeDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Overtime{}, nil, nil)
+		mockPayComponentDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return(mockPayComponents, nil, nil)
+		mockTaxBracketDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.TaxBracket{}, nil, nil)
+		mockExchangeRateDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.ExchangeRate{}, nil, nil)
+		mockAllowanceTypeDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.AllowanceType{}, nil, nil)
+		mockUserAllowanceDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.UserAllowance{}, nil, nil)
+		mockPayrollAdjustmentDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.PayrollAdjustment{}, nil, nil)
+		mockLoanDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Loan{}, nil, nil)
+		mockHolidayDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Holiday{}, nil, nil)
+		mockWorkScheduleDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.WorkSchedule{}, nil, nil)
+		mockUserWorkScheduleDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.UserWorkSchedule{}, nil, nil)
+		mockUserSalaryDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.UserSalary{}, nil, nil)
+		mockAttendanceTypeDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.AttendanceType{}, nil, nil)
+	}
+
+	tests := []struct {
+		name               string
+		attendancePeriodID int64
+		mockFunc           func()
+		want               dto.PayrollPreview
+		wantErr            bool
+		errCode            codes.Code
+	}{
+		{
+			name:               "Success Preview Within Request",
+			attendancePeriodID: 1,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockUserDom.EXPECT().GetList(ctx, mockUserParam).Return(mockUsers, nil, nil)
+				mockCalculationFunc()
+			},
+			want: dto.PayrollPreview{
+				PreviewStatus:            entity.PayrollPreviewStatusCompleted,
+				AttendancePeriodID:       mockAttendancePeriod.ID,
+				StartDate:                mockAttendancePeriod.StartDate,
+				EndDate:                  mockAttendancePeriod.EndDate,
+				TotalEmployee:            1,
+				ReportingCurrency:        entity.ReportingCurrency,
+				TotalEmployeeTakeHomePay: entity.NewMoney(2000000),
+				TotalEmployerCost:        entity.NewMoney(2000000),
+				CurrencyTotals: []dto.CurrencyPayout{
+					{Currency: entity.ReportingCurrency, TakeHomePay: entity.NewMoney(2000000), EmployerCost: entity.NewMoney(2000000)},
+				},
+				Employees: []dto.EmployeePayrollPreview{
+					{
+						ID:               1,
+						Name:             "User 1",
+						BasePayComponent: entity.NewMoney(2000000),
+						TotalTakeHomePay: entity.NewMoney(2000000),
+						EmployerCost:     entity.NewMoney(2000000),
+						CurrencyTotals: []dto.PayslipCurrencyTotal{
+							{
+								Currency:         entity.ReportingCurrency,
+								ExchangeRate:     1,
+								BasePayComponent: entity.NewMoney(2000000),
+								TotalTakeHomePay: entity.NewMoney(2000000),
+							},
+						},
+						Details: []dto.PayslipDetail{
+							{
+								Type:        entity.PayslipItemTypeEarningBasePay,
+								Description: "Base Pay for 20 Attendance on 23 Workdays",
+								Amount:      entity.NewMoney(2000000),
+								Currency:    entity.ReportingCurrency,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:               "Success Preview In Background",
+			attendancePeriodID: 1,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockUserDom.EXPECT().GetList(ctx, mockUserParam).Return(mockManyUsers, nil, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txPreviewPayroll", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
+					},
+				)
+				mockPayrollPreviewDom.EXPECT().Create(ctx, entity.PayrollPreviewInputParam{
+					AttendancePeriodID: mockAttendancePeriod.ID,
+					PreviewStatus:      entity.PayrollPreviewStatusPending,
+					TotalEmployee:      int64(len(mockManyUsers)),
+					CreatedAt:          null.TimeFrom(mockTime),
+					CreatedBy:          null.Int64From(mockLoginUser.ID),
+				}).Return(mockPayrollPreview, nil)
+				mockPublisher.EXPECT().Publish(
+					ctx,
+					entity.ExchangePayrollEvent,
+					entity.RoutingKeyPayrollPreview,
+					dto.PubSubPreviewPayrollMessage{
+						PayrollPreviewID: mockPayrollPreview.ID,
+						AttendancePeriod: mockAttendancePeriod,
+						LoginUser:        mockLoginUser,
+					},
+				).Return(nil)
+			},
+			want: dto.PayrollPreview{
+				PreviewID:          mockPayrollPreview.ID,
+				PreviewStatus:      entity.PayrollPreviewStatusPending,
+				AttendancePeriodID: mockAttendancePeriod.ID,
+				StartDate:          mockAttendancePeriod.StartDate,
+				EndDate:            mockAttendancePeriod.EndDate,
+				TotalEmployee:      int64(len(mockManyUsers)),
+			},
+		},
+		{
+			name:               "Failed Publish",
+			attendancePeriodID: 1,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockUserDom.EXPECT().GetList(ctx, mockUserParam).Return(mockManyUsers, nil, nil)
+				mockTransactor.EXPECT().Execute(ctx, "txPreviewPayroll", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ interface{}, callback func(context.Context) error) error {
+						return callback(ctx)
+					},
+				)
+				mockPayrollPreviewDom.EXPECT().Create(ctx, gomock.Any()).Return(mockPayrollPreview, nil)
+				mockPublisher.EXPECT().Publish(ctx, entity.ExchangePayrollEvent, entity.RoutingKeyPayrollPreview, gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name:               "Failed Attendance Period Already Processed",
+			attendancePeriodID: 1,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockProcessedAttendancePeriod, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:               "Failed Attendance Period Not Found",
+			attendancePeriodID: 1,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:               "Failed Calculate Payroll",
+			attendancePeriodID: 1,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(mockAttendancePeriod, nil)
+				mockUserDom.EXPECT().GetList(ctx, mockUserParam).Return(mockUsers, nil, nil)
+				mockAttendanceDom.EXPECT().CountUserAttendance(gomock.Any(), mockAttendancePeriod.ID).Return(nil, assert.AnError)
+				mockAttendanceDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Attendance{}, nil, nil).AnyTimes()
+				mockReimbursementDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Reimbursement{}, nil, nil).AnyTimes()
+				mockOvertimeDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Overtime{}, nil, nil).AnyTimes()
+				mockPayComponentDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return(mockPayComponents, nil, nil).AnyTimes()
+				mockTaxBracketDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.TaxBracket{}, nil, nil).AnyTimes()
+				mockExchangeRateDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.ExchangeRate{}, nil, nil).AnyTimes()
+				mockAllowanceTypeDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.AllowanceType{}, nil, nil).AnyTimes()
+				mockUserAllowanceDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.UserAllowance{}, nil, nil).AnyTimes()
+				mockPayrollAdjustmentDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.PayrollAdjustment{}, nil, nil).AnyTimes()
+				mockLoanDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Loan{}, nil, nil).AnyTimes()
+				mockHolidayDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.Holiday{}, nil, nil).AnyTimes()
+				mockWorkScheduleDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.WorkSchedule{}, nil, nil).AnyTimes()
+				mockUserWorkScheduleDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.UserWorkSchedule{}, nil, nil).AnyTimes()
+				mockUserSalaryDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.UserSalary{}, nil, nil).AnyTimes()
+				mockAttendanceTypeDom.EXPECT().GetList(gomock.Any(), gomock.Any()).Return([]entity.AttendanceType{}, nil, nil).AnyTimes()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.PreviewPayroll(ctx, tt.attendancePeriodID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendancePeriod.PreviewPayroll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_attendancePeriod_GetPayrollPreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJson := mock_parser.NewMockJSONInterface(ctrl)
+	mockPayrollPreviewDom := mock_payroll_preview.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Json:           mockJson,
+		PayrollPreview: mockPayrollPreviewDom,
+	})
+
+	ctx := context.Background()
+
+	mockPayrollPreviewParam := entity.PayrollPreviewParam{
+		ID: 10,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockResult := `{"attendancePeriodId":1,"totalEmployee":1,"totalEmployeeTakeHomePay":2000000.00,"employees":[{"id":1,"name":"User 1","totalTakeHomePay":2000000.00}]}`
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     dto.PayrollPreview
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success Completed",
+			mockFunc: func() {
+				mockPayrollPreviewDom.EXPECT().Get(ctx, mockPayrollPreviewParam).Return(entity.PayrollPreview{
+					ID:                 10,
+					AttendancePeriodID: 1,
+					PreviewStatus:      entity.PayrollPreviewStatusCompleted,
+					TotalEmployee:      1,
+					Result:             null.StringFrom(mockResult),
+				}, nil)
+				mockJson.EXPECT().Unmarshal([]byte(mockResult), gomock.Any()).DoAndReturn(
+					func(data []byte, v interface{}) error {
+						return json.Unmarshal(data, v)
+					},
+				)
+			},
+			want: dto.PayrollPreview{
+				PreviewID:                10,
+				PreviewStatus:            entity.PayrollPreviewStatusCompleted,
+				AttendancePeriodID:       1,
+				TotalEmployee:            1,
+				TotalEmployeeTakeHomePay: entity.NewMoney(2000000),
+				Employees: []dto.EmployeePayrollPreview{
+					{
+						ID:               1,
+						Name:             "User 1",
+						TotalTakeHomePay: entity.NewMoney(2000000),
+					},
+				},
+			},
+		},
+		{
+			name: "Success Pending",
+			mockFunc: func() {
+				mockPayrollPreviewDom.EXPECT().Get(ctx, mockPayrollPreviewParam).Return(entity.PayrollPreview{
+					ID:                 10,
+					AttendancePeriodID: 1,
+					PreviewStatus:      entity.PayrollPreviewStatusPending,
+					TotalEmployee:      1,
+				}, nil)
+			},
+			want: dto.PayrollPreview{
+				PreviewID:          10,
+				PreviewStatus:      entity.PayrollPreviewStatusPending,
+				AttendancePeriodID: 1,
+				TotalEmployee:      1,
+			},
+		},
+		{
+			name: "Failed Not Found",
+			mockFunc: func() {
+				mockPayrollPreviewDom.EXPECT().Get(ctx, mockPayrollPreviewParam).Return(entity.PayrollPreview{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetPayrollPreview(ctx, 10)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attendancePeriod.GetPayrollPreview() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_attendancePeriod_GetCurrentAttendancePeriod(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,104 @@
+package exchange_rate
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	exchangeRateDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/exchange_rate"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetExchangeRates(ctx context.Context, fixingDate null.Date) ([]entity.ExchangeRate, error)
+	CreateExchangeRates(ctx context.Context, inputParam dto.CreateExchangeRatesParam) error
+}
+
+type exchangeRate struct {
+	auth            auth.Interface
+	exchangeRateDom exchangeRateDom.Interface
+}
+
+type InitParam struct {
+	Auth         auth.Interface
+	ExchangeRate exchangeRateDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &exchangeRate{
+		auth:            param.Auth,
+		exchangeRateDom: param.ExchangeRate,
+	}
+}
+
+// GetExchangeRates returns the latest rate of every currency fixed on or before the given date, or today when no date is given.
+func (e *exchangeRate) GetExchangeRates(ctx context.Context, fixingDate null.Date) ([]entity.ExchangeRate, error) {
+	if !fixingDate.Valid {
+		fixingDate = null.DateFrom(Now())
+	}
+
+	exchangeRates, _, err := e.exchangeRateDom.GetList(
+		ctx,
+		entity.ExchangeRateParam{
+			FixingDateLTE: fixingDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"currency", "-fixing_date"},
+			},
+		},
+	)
+	if err != nil {
+		return exchangeRates, err
+	}
+
+	// rates are sorted by currency, the first rate of every currency is the latest one
+	effectiveExchangeRates := []entity.ExchangeRate{}
+	for _, exchangeRate := range exchangeRates {
+		if len(effectiveExchangeRates) > 0 && effectiveExchangeRates[len(effectiveExchangeRates)-1].Currency == exchangeRate.Currency {
+			continue
+		}
+
+		effectiveExchangeRates = append(effectiveExchangeRates, exchangeRate)
+	}
+
+	if len(effectiveExchangeRates) == 0 {
+		return effectiveExchangeRates, errors.NewWithCode(codes.CodeNotFound, "no exchange rate fixed on or before %s", fixingDate.Time.Format(time.DateOnly))
+	}
+
+	return effectiveExchangeRates, nil
+}
+
+// CreateExchangeRates fixes the rates of the given currencies on a date, the rates fixed on earlier dates
+// are kept so that payroll of older periods is still converted at the rates of its time.
+func (e *exchangeRate) CreateExchangeRates(ctx context.Context, inputParam dto.CreateExchangeRatesParam) error {
+	loginUser, err := e.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	err = e.exchangeRateDom.CreateMany(ctx, inputParam.ToExchangeRateInputParams(null.TimeFrom(Now()), loginUser.ID))
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLUniqueConstraint:
+			return errors.NewWithCode(codes.CodeConflict, "exchange rates fixed on %s already exist", inputParam.FixingDate.Time.Format(time.DateOnly))
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package exchange_rate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_exchange_rate "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/exchange_rate"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_exchangeRate_GetExchangeRates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExchangeRateDom := mock_exchange_rate.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		ExchangeRate: mockExchangeRateDom,
+	})
+
+	mockFixingDate := null.DateFrom(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+	mockSelectParam := entity.ExchangeRateParam{
+		FixingDateLTE: mockFixingDate,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"currency", "-fixing_date"},
+		},
+	}
+
+	latestFixing := null.DateFrom(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+	olderFixing := null.DateFrom(time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC))
+	mockExchangeRates := []entity.ExchangeRate{
+		{ID: 3, Currency: "SGD", Rate: 12100, FixingDate: olderFixing},
+		{ID: 4, Currency: "USD", Rate: 16300, FixingDate: latestFixing},
+		{ID: 2, Currency: "USD", Rate: 16250, FixingDate: olderFixing},
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     []entity.ExchangeRate
+		wantErr  bool
+	}{
+		{
+			name: "Success Picks Latest Rate Of Every Currency",
+			mockFunc: func() {
+				mockExchangeRateDom.EXPECT().GetList(context.Background(), mockSelectParam).Return(mockExchangeRates, nil, nil)
+			},
+			want:    mockExchangeRates[:2],
+			wantErr: false,
+		},
+		{
+			name: "No Exchange Rate Fixed",
+			mockFunc: func() {
+				mockExchangeRateDom.EXPECT().GetList(context.Background(), mockSelectParam).Return([]entity.ExchangeRate{}, nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "GetList Error",
+			mockFunc: func() {
+				mockExchangeRateDom.EXPECT().GetList(context.Background(), mockSelectParam).Return(nil, nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetExchangeRates(context.Background(), mockFixingDate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("exchangeRate.GetExchangeRates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_exchangeRate_CreateExchangeRates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockExchangeRateDom := mock_exchange_rate.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:         mockAuth,
+		ExchangeRate: mockExchangeRateDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockInputParam := dto.CreateExchangeRatesParam{
+		FixingDate: null.DateFrom(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)),
+		Rates: []dto.ExchangeRateParam{
+			{Currency: "USD", Rate: 16250.5},
+			{Currency: "SGD", Rate: 12100},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateExchangeRatesParam
+		mockFunc func()
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockExchangeRateDom.EXPECT().CreateMany(
+					context.Background(),
+					mockInputParam.ToExchangeRateInputParams(null.TimeFrom(mockTime), mockLoginUser.ID),
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Rate Already Fixed",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockExchangeRateDom.EXPECT().CreateMany(context.Background(), gomock.Any()).Return(errors.NewWithCode(codes.CodeSQLUniqueConstraint, "duplicate key"))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name: "Validation Error Reporting Currency",
+			input: dto.CreateExchangeRatesParam{
+				FixingDate: mockInputParam.FixingDate,
+				Rates: []dto.ExchangeRateParam{
+					{Currency: entity.ReportingCurrency, Rate: 1},
+				},
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Validation Error Invalid Currency",
+			input: dto.CreateExchangeRatesParam{
+				FixingDate: mockInputParam.FixingDate,
+				Rates: []dto.ExchangeRateParam{
+					{Currency: "usd", Rate: 16250.5},
+				},
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "GetUserAuthInfo Error",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(auth.User{}, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.CreateExchangeRates(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("exchangeRate.CreateExchangeRates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errCode != 0 {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package holiday
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	holidayDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/holiday"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetHolidays(ctx context.Context, year int, region string) ([]entity.Holiday, error)
+	CreateHolidays(ctx context.Context, inputParam dto.CreateHolidaysParam) error
+	DeleteHoliday(ctx context.Context, holidayID int64) error
+	UpdateUserRegion(ctx context.Context, userID int64, inputParam dto.UpdateUserRegionParam) error
+}
+
+type holiday struct {
+	auth       auth.Interface
+	holidayDom holidayDom.Interface
+	userDom    userDom.Interface
+}
+
+type InitParam struct {
+	Auth    auth.Interface
+	Holiday holidayDom.Interface
+	User    userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &holiday{
+		auth:       param.Auth,
+		holidayDom: param.Holiday,
+		userDom:    param.User,
+	}
+}
+
+// GetHolidays returns the holidays of a year, or of the current year when no year is given.
+// A region narrows the list down to the holidays of that region, the nationwide holidays are always included.
+func (h *holiday) GetHolidays(ctx context.Context, year int, region string) ([]entity.Holiday, error) {
+	if year == 0 {
+		year = Now().Year()
+	}
+
+	param := entity.HolidayParam{
+		HolidayDateGTE: null.DateFrom(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		HolidayDateLTE: null.DateFrom(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"holiday_date", "region"},
+		},
+	}
+
+	if region = dto.NormalizeRegion(region); region != "" {
+		param.Regions = []string{"", region}
+	}
+
+	holidays, _, err := h.holidayDom.GetList(ctx, param)
+	if err != nil {
+		return holidays, err
+	}
+
+	return holidays, nil
+}
+
+// CreateHolidays imports a batch of holidays at once, the whole batch is rejected when any of its
+// holidays is already in the calendar. Payroll runs after the import exclude them from the working days.
+func (h *holiday) CreateHolidays(ctx context.Context, inputParam dto.CreateHolidaysParam) error {
+	loginUser, err := h.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	err = h.holidayDom.CreateMany(ctx, inputParam.ToHolidayInputParams(null.TimeFrom(Now()), loginUser.ID))
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLUniqueConstraint:
+			return errors.NewWithCode(codes.CodeConflict, "some holidays already exist for their date and region")
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *holiday) DeleteHoliday(ctx context.Context, holidayID int64) error {
+	loginUser, err := h.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.holidayDom.Get(
+		ctx,
+		entity.HolidayParam{
+			ID: holidayID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeNotFound, "holiday not found")
+		default:
+			return err
+		}
+	}
+
+	return h.holidayDom.Update(
+		ctx,
+		entity.HolidayUpdateParam{
+			Status:    null.Int64From(-1),
+			UpdatedAt: null.TimeFrom(Now()),
+			UpdatedBy: null.Int64From(loginUser.ID),
+			DeletedAt: null.TimeFrom(Now()),
+			DeletedBy: null.Int64From(loginUser.ID),
+		},
+		entity.HolidayParam{
+			ID: holidayID,
+		},
+	)
+}
+
+// UpdateUserRegion sets the region whose holidays an employee observes on top of the nationwide holidays.
+func (h *holiday) UpdateUserRegion(ctx context.Context, userID int64, inputParam dto.UpdateUserRegionParam) error {
+	loginUser, err := h.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = h.userDom.Update(
+		ctx,
+		inputParam.ToUserUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+		entity.UserParam{
+			ID: userID,
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLNoRowsAffected:
+			return errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package holiday
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_holiday "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/holiday"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_holiday_GetHolidays(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHolidayDom := mock_holiday.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Holiday: mockHolidayDom,
+	})
+
+	Now = func() time.Time {
+		return time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockParam := entity.HolidayParam{
+		HolidayDateGTE: null.DateFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		HolidayDateLTE: null.DateFrom(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"holiday_date", "region"},
+		},
+	}
+
+	mockRegionParam := mockParam
+	mockRegionParam.Regions = []string{"", "BALI"}
+
+	mockHolidays := []entity.Holiday{
+		{ID: 1, HolidayDate: null.DateFrom(time.Date(2026, 3, 19, 0, 0, 0, 0, time.UTC)), Name: "Nyepi", Region: "BALI"},
+		{ID: 2, HolidayDate: null.DateFrom(time.Date(2026, 8, 17, 0, 0, 0, 0, time.UTC)), Name: "Independence Day"},
+	}
+
+	tests := []struct {
+		name     string
+		year     int
+		region   string
+		mockFunc func()
+		want     []entity.Holiday
+		wantErr  bool
+	}{
+		{
+			name: "Success Current Year",
+			mockFunc: func() {
+				mockHolidayDom.EXPECT().GetList(ctx, mockParam).Return(mockHolidays, nil, nil)
+			},
+			want:    mockHolidays,
+			wantErr: false,
+		},
+		{
+			name:   "Success Region Includes Nationwide Holidays",
+			year:   2026,
+			region: " bali ",
+			mockFunc: func() {
+				mockHolidayDom.EXPECT().GetList(ctx, mockRegionParam).Return(mockHolidays, nil, nil)
+			},
+			want:    mockHolidays,
+			wantErr: false,
+		},
+		{
+			name: "Database Error",
+			mockFunc: func() {
+				mockHolidayDom.EXPECT().GetList(ctx, mockParam).Return(nil, nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetHolidays(ctx, tt.year, tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("holiday.GetHolidays() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_holiday_CreateHolidays(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockHolidayDom := mock_holiday.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:    mockAuth,
+		Holiday: mockHolidayDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockIndependenceDay := null.DateFrom(time.Date(2026, 8, 17, 0, 0, 0, 0, time.UTC))
+	mockNyepi := null.DateFrom(time.Date(2026, 3, 19, 0, 0, 0, 0, time.UTC))
+
+	mockInputParam := dto.CreateHolidaysParam{
+		Holidays: []dto.HolidayParam{
+			{HolidayDate: mockIndependenceDay, Name: "Independence Day"},
+			{HolidayDate: mockNyepi, Name: "Nyepi", Region: "bali"},
+		},
+	}
+
+	mockHolidayInputParams := []entity.HolidayInputParam{
+		{HolidayDate: mockIndependenceDay, Name: "Independence Day", CreatedAt: null.TimeFrom(mockTime), CreatedBy: null.Int64From(mockLoginUser.ID)},
+		{HolidayDate: mockNyepi, Name: "Nyepi", Region: "BALI", CreatedAt: null.TimeFrom(mockTime), CreatedBy: null.Int64From(mockLoginUser.ID)},
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateHolidaysParam
+		mockFunc func()
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockHolidayDom.EXPECT().CreateMany(ctx, mockHolidayInputParams).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Holiday Already Exists",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockHolidayDom.EXPECT().CreateMany(ctx, mockHolidayInputParams).Return(errors.NewWithCode(codes.CodeSQLUniqueConstraint, "duplicate"))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name: "Duplicate Holiday In Batch",
+			input: dto.CreateHolidaysParam{
+				Holidays: []dto.HolidayParam{
+					{HolidayDate: mockNyepi, Name: "Nyepi", Region: "BALI"},
+					{HolidayDate: mockNyepi, Name: "Day of Silence", Region: "bali"},
+				},
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Missing Holiday Name",
+			input: dto.CreateHolidaysParam{Holidays: []dto.HolidayParam{{HolidayDate: mockNyepi, Name: " "}}},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.CreateHolidays(ctx, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("holiday.CreateHolidays() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	loanDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/loan"
+	loanRepaymentDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/loan_repayment"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetUserLoans(ctx context.Context, userID int64) ([]entity.Loan, error)
+	CreateLoan(ctx context.Context, userID int64, inputParam dto.CreateLoanParam) (entity.Loan, error)
+	GetLoanRepayments(ctx context.Context, loanID int64) ([]entity.LoanRepayment, error)
+}
+
+type loan struct {
+	auth             auth.Interface
+	loanDom          loanDom.Interface
+	loanRepaymentDom loanRepaymentDom.Interface
+	userDom          userDom.Interface
+}
+
+type InitParam struct {
+	Auth          auth.Interface
+	Loan          loanDom.Interface
+	LoanRepayment loanRepaymentDom.Interface
+	User          userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &loan{
+		auth:             param.Auth,
+		loanDom:          param.Loan,
+		loanRepaymentDom: param.LoanRepayment,
+		userDom:          param.User,
+	}
+}
+
+func (l *loan) GetUserLoans(ctx context.Context, userID int64) ([]entity.Loan, error) {
+	loans, _, err := l.loanDom.GetList(
+		ctx,
+		entity.LoanParam{
+			UserID: userID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-start_date", "-id"},
+			},
+		},
+	)
+	if err != nil {
+		return loans, err
+	}
+
+	return loans, nil
+}
+
+// CreateLoan lends the principal to an employee in their salary currency, it is repaid by an instalment
+// withheld from every payroll of a period ending on or after the start date.
+func (l *loan) CreateLoan(ctx context.Context, userID int64, inputParam dto.CreateLoanParam) (entity.Loan, error) {
+	loginUser, err := l.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.Loan{}, err
+	}
+
+	employee, err := l.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID:     userID,
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.Loan{}, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return entity.Loan{}, err
+		}
+	}
+
+	return l.loanDom.Create(ctx, inputParam.ToLoanInputParam(employee, null.TimeFrom(Now()), loginUser.ID))
+}
+
+// GetLoanRepayments returns every instalment withheld for a loan, including the ones reversed by a payroll rerun.
+func (l *loan) GetLoanRepayments(ctx context.Context, loanID int64) ([]entity.LoanRepayment, error) {
+	_, err := l.loanDom.Get(
+		ctx,
+		entity.LoanParam{
+			ID: loanID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return nil, errors.NewWithCode(codes.CodeNotFound, "loan not found")
+		default:
+			return nil, err
+		}
+	}
+
+	repayments, _, err := l.loanRepaymentDom.GetList(
+		ctx,
+		entity.LoanRepaymentParam{
+			LoanID: loanID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"id"},
+			},
+		},
+	)
+	if err != nil {
+		return repayments, err
+	}
+
+	return repayments, nil
+}
@@ -0,0 +1,245 @@
+package loan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_loan "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/loan"
+	mock_loan_repayment "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/loan_repayment"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_loan_CreateLoan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockLoanDom := mock_loan.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth: mockAuth,
+		Loan: mockLoanDom,
+		User: mockUserDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockEmployee := entity.User{
+		ID:       11,
+		RoleID:   entity.RoleIDUser,
+		Currency: "USD",
+	}
+	mockUserParam := entity.UserParam{
+		ID:     mockEmployee.ID,
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockStartDate := null.DateFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	mockInputParam := dto.CreateLoanParam{
+		Description:     "Laptop loan",
+		Principal:       entity.NewMoney(1000),
+		InstalmentCount: 3,
+		StartDate:       mockStartDate,
+	}
+
+	mockLoan := entity.Loan{
+		ID:               5,
+		UserID:           mockEmployee.ID,
+		Description:      "Laptop loan",
+		Principal:        entity.NewMoney(1000),
+		InstalmentCount:  3,
+		InstalmentAmount: entity.Money(33334),
+		RemainingBalance: entity.NewMoney(1000),
+		Currency:         "USD",
+		StartDate:        mockStartDate,
+		LoanStatus:       entity.LoanStatusActive,
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateLoanParam
+		mockFunc func()
+		want     entity.Loan
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success Instalment Rounded Up",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(mockEmployee, nil)
+				mockLoanDom.EXPECT().Create(ctx, entity.LoanInputParam{
+					UserID:           mockEmployee.ID,
+					Description:      "Laptop loan",
+					Principal:        entity.NewMoney(1000),
+					InstalmentCount:  3,
+					InstalmentAmount: entity.Money(33334),
+					RemainingBalance: entity.NewMoney(1000),
+					Currency:         "USD",
+					StartDate:        mockStartDate,
+					LoanStatus:       entity.LoanStatusActive,
+					CreatedAt:        null.TimeFrom(mockTime),
+					CreatedBy:        null.Int64From(mockLoginUser.ID),
+				}).Return(mockLoan, nil)
+			},
+			want:    mockLoan,
+			wantErr: false,
+		},
+		{
+			name:  "User Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Invalid Instalment Count",
+			input: dto.CreateLoanParam{Description: "Laptop loan", Principal: entity.NewMoney(1000), StartDate: mockStartDate},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Missing Start Date",
+			input: dto.CreateLoanParam{Description: "Laptop loan", Principal: entity.NewMoney(1000), InstalmentCount: 3},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateLoan(ctx, mockEmployee.ID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loan.CreateLoan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_loan_GetLoanRepayments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLoanDom := mock_loan.NewMockInterface(ctrl)
+	mockLoanRepaymentDom := mock_loan_repayment.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Loan:          mockLoanDom,
+		LoanRepayment: mockLoanRepaymentDom,
+	})
+
+	ctx := context.Background()
+
+	mockLoanID := int64(5)
+	mockLoanParam := entity.LoanParam{
+		ID: mockLoanID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	mockRepaymentParam := entity.LoanRepaymentParam{
+		LoanID: mockLoanID,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"id"},
+		},
+	}
+
+	mockRepayments := []entity.LoanRepayment{
+		{ID: 1, LoanID: mockLoanID, PayrollRunID: 2, Amount: entity.Money(33334), RepaymentStatus: entity.LoanRepaymentStatusReversed},
+		{ID: 2, LoanID: mockLoanID, PayrollRunID: 3, Amount: entity.Money(33334), RepaymentStatus: entity.LoanRepaymentStatusPaid},
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     []entity.LoanRepayment
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockLoanDom.EXPECT().Get(ctx, mockLoanParam).Return(entity.Loan{ID: mockLoanID}, nil)
+				mockLoanRepaymentDom.EXPECT().GetList(ctx, mockRepaymentParam).Return(mockRepayments, nil, nil)
+			},
+			want:    mockRepayments,
+			wantErr: false,
+		},
+		{
+			name: "Loan Not Found",
+			mockFunc: func() {
+				mockLoanDom.EXPECT().Get(ctx, mockLoanParam).Return(entity.Loan{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetLoanRepayments(ctx, mockLoanID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loan.GetLoanRepayments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
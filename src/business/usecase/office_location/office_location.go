@@ -0,0 +1,209 @@
+package office_location
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	officeLocationDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/office_location"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	userOfficeLocationDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_office_location"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetOfficeLocations(ctx context.Context) ([]entity.OfficeLocation, error)
+	CreateOfficeLocation(ctx context.Context, inputParam dto.CreateOfficeLocationParam) (entity.OfficeLocation, error)
+	GetUserOfficeLocations(ctx context.Context, userID int64) ([]entity.UserOfficeLocation, error)
+	CreateUserOfficeLocation(ctx context.Context, userID int64, inputParam dto.CreateUserOfficeLocationParam) (entity.UserOfficeLocation, error)
+	DeleteUserOfficeLocation(ctx context.Context, userOfficeLocationID int64) error
+}
+
+type officeLocation struct {
+	auth                  auth.Interface
+	officeLocationDom     officeLocationDom.Interface
+	userOfficeLocationDom userOfficeLocationDom.Interface
+	userDom               userDom.Interface
+}
+
+type InitParam struct {
+	Auth               auth.Interface
+	OfficeLocation     officeLocationDom.Interface
+	UserOfficeLocation userOfficeLocationDom.Interface
+	User               userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &officeLocation{
+		auth:                  param.Auth,
+		officeLocationDom:     param.OfficeLocation,
+		userOfficeLocationDom: param.UserOfficeLocation,
+		userDom:               param.User,
+	}
+}
+
+func (o *officeLocation) GetOfficeLocations(ctx context.Context) ([]entity.OfficeLocation, error) {
+	officeLocations, _, err := o.officeLocationDom.GetList(
+		ctx,
+		entity.OfficeLocationParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"name"},
+			},
+		},
+	)
+	if err != nil {
+		return officeLocations, err
+	}
+
+	return officeLocations, nil
+}
+
+func (o *officeLocation) CreateOfficeLocation(ctx context.Context, inputParam dto.CreateOfficeLocationParam) (entity.OfficeLocation, error) {
+	loginUser, err := o.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.OfficeLocation{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.OfficeLocation{}, err
+	}
+
+	return o.officeLocationDom.Create(ctx, inputParam.ToOfficeLocationInputParam(null.TimeFrom(Now()), loginUser.ID))
+}
+
+func (o *officeLocation) GetUserOfficeLocations(ctx context.Context, userID int64) ([]entity.UserOfficeLocation, error) {
+	userOfficeLocations, _, err := o.userOfficeLocationDom.GetList(
+		ctx,
+		entity.UserOfficeLocationParam{
+			UserID: userID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return userOfficeLocations, err
+	}
+
+	return userOfficeLocations, nil
+}
+
+// CreateUserOfficeLocation allows an employee to check in at an office location. Once an employee has an office
+// location assigned, they can only check in at the office locations assigned to them.
+func (o *officeLocation) CreateUserOfficeLocation(
+	ctx context.Context,
+	userID int64,
+	inputParam dto.CreateUserOfficeLocationParam,
+) (
+	entity.UserOfficeLocation,
+	error,
+) {
+	loginUser, err := o.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.UserOfficeLocation{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.UserOfficeLocation{}, err
+	}
+
+	_, err = o.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID:     userID,
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.UserOfficeLocation{}, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return entity.UserOfficeLocation{}, err
+		}
+	}
+
+	_, err = o.officeLocationDom.Get(
+		ctx,
+		entity.OfficeLocationParam{
+			ID: inputParam.OfficeLocationID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.UserOfficeLocation{}, errors.NewWithCode(codes.CodeNotFound, "office location not found")
+		default:
+			return entity.UserOfficeLocation{}, err
+		}
+	}
+
+	userOfficeLocation, err := o.userOfficeLocationDom.Create(ctx, inputParam.ToUserOfficeLocationInputParam(userID, null.TimeFrom(Now()), loginUser.ID))
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLUniqueConstraint:
+			return entity.UserOfficeLocation{}, errors.NewWithCode(codes.CodeConflict, "user is already allowed at this office location")
+		default:
+			return entity.UserOfficeLocation{}, err
+		}
+	}
+
+	return userOfficeLocation, nil
+}
+
+func (o *officeLocation) DeleteUserOfficeLocation(ctx context.Context, userOfficeLocationID int64) error {
+	loginUser, err := o.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = o.userOfficeLocationDom.Get(
+		ctx,
+		entity.UserOfficeLocationParam{
+			ID: userOfficeLocationID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeNotFound, "user office location not found")
+		default:
+			return err
+		}
+	}
+
+	return o.userOfficeLocationDom.Update(
+		ctx,
+		entity.UserOfficeLocationUpdateParam{
+			Status:    null.Int64From(-1),
+			UpdatedAt: null.TimeFrom(Now()),
+			UpdatedBy: null.Int64From(loginUser.ID),
+			DeletedAt: null.TimeFrom(Now()),
+			DeletedBy: null.Int64From(loginUser.ID),
+		},
+		entity.UserOfficeLocationParam{
+			ID: userOfficeLocationID,
+		},
+	)
+}
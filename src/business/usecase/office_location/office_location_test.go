@@ -0,0 +1,268 @@
+package office_location
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_office_location "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/office_location"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_office_location "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_office_location"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_officeLocation_CreateOfficeLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockOfficeLocationDom := mock_office_location.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:           mockAuth,
+		OfficeLocation: mockOfficeLocationDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockInputParam := dto.CreateOfficeLocationParam{
+		Name:         "Jakarta Head Office",
+		Latitude:     null.Float64From(-6.2253),
+		Longitude:    null.Float64From(106.7993),
+		RadiusMeters: 150,
+	}
+
+	mockOfficeLocation := entity.OfficeLocation{
+		ID:           1,
+		Name:         mockInputParam.Name,
+		Latitude:     mockInputParam.Latitude.Float64,
+		Longitude:    mockInputParam.Longitude.Float64,
+		RadiusMeters: mockInputParam.RadiusMeters,
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateOfficeLocationParam
+		mockFunc func()
+		want     entity.OfficeLocation
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockOfficeLocationDom.EXPECT().Create(
+					context.Background(),
+					mockInputParam.ToOfficeLocationInputParam(null.TimeFrom(mockTime), mockLoginUser.ID),
+				).Return(mockOfficeLocation, nil)
+			},
+			want:    mockOfficeLocation,
+			wantErr: false,
+		},
+		{
+			name: "Missing Coordinates",
+			input: dto.CreateOfficeLocationParam{
+				Name:         "Jakarta Head Office",
+				RadiusMeters: 150,
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Non Positive Radius",
+			input: dto.CreateOfficeLocationParam{
+				Name:      "Jakarta Head Office",
+				Latitude:  null.Float64From(-6.2253),
+				Longitude: null.Float64From(106.7993),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateOfficeLocation(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("officeLocation.CreateOfficeLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_officeLocation_CreateUserOfficeLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockOfficeLocationDom := mock_office_location.NewMockInterface(ctrl)
+	mockUserOfficeLocationDom := mock_user_office_location.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:               mockAuth,
+		OfficeLocation:     mockOfficeLocationDom,
+		UserOfficeLocation: mockUserOfficeLocationDom,
+		User:               mockUserDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockUserID := int64(2)
+
+	mockInputParam := dto.CreateUserOfficeLocationParam{
+		OfficeLocationID: 1,
+	}
+
+	mockUserParam := entity.UserParam{
+		ID:     mockUserID,
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockOfficeLocationParam := entity.OfficeLocationParam{
+		ID: mockInputParam.OfficeLocationID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockUserOfficeLocation := entity.UserOfficeLocation{
+		ID:               1,
+		UserID:           mockUserID,
+		OfficeLocationID: mockInputParam.OfficeLocationID,
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateUserOfficeLocationParam
+		mockFunc func()
+		want     entity.UserOfficeLocation
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockOfficeLocationDom.EXPECT().Get(context.Background(), mockOfficeLocationParam).Return(entity.OfficeLocation{ID: 1}, nil)
+				mockUserOfficeLocationDom.EXPECT().Create(
+					context.Background(),
+					mockInputParam.ToUserOfficeLocationInputParam(mockUserID, null.TimeFrom(mockTime), mockLoginUser.ID),
+				).Return(mockUserOfficeLocation, nil)
+			},
+			want:    mockUserOfficeLocation,
+			wantErr: false,
+		},
+		{
+			name:  "Missing Office Location",
+			input: dto.CreateUserOfficeLocationParam{},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "User Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Office Location Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockOfficeLocationDom.EXPECT().Get(context.Background(), mockOfficeLocationParam).Return(entity.OfficeLocation{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Already Allowed At Office Location",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockOfficeLocationDom.EXPECT().Get(context.Background(), mockOfficeLocationParam).Return(entity.OfficeLocation{ID: 1}, nil)
+				mockUserOfficeLocationDom.EXPECT().Create(context.Background(), gomock.Any()).Return(entity.UserOfficeLocation{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, "duplicate"))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateUserOfficeLocation(context.Background(), mockUserID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("officeLocation.CreateUserOfficeLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -8,7 +8,13 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/codes"
 	"github.com/reyhanmichiels/go-pkg/v2/errors"
 	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	attendanceDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance"
+	holidayDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/holiday"
 	overtimeDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/overtime"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	userWorkScheduleDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_work_schedule"
+	workScheduleDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/work_schedule"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 )
@@ -20,19 +26,34 @@ type Interface interface {
 }
 
 type overtime struct {
-	auth        auth.Interface
-	overtimeDom overtimeDom.Interface
+	auth                auth.Interface
+	overtimeDom         overtimeDom.Interface
+	attendanceDom       attendanceDom.Interface
+	holidayDom          holidayDom.Interface
+	userDom             userDom.Interface
+	workScheduleDom     workScheduleDom.Interface
+	userWorkScheduleDom userWorkScheduleDom.Interface
 }
 
 type InitParam struct {
-	Auth        auth.Interface
-	OvertimeDom overtimeDom.Interface
+	Auth             auth.Interface
+	OvertimeDom      overtimeDom.Interface
+	Attendance       attendanceDom.Interface
+	Holiday          holidayDom.Interface
+	User             userDom.Interface
+	WorkSchedule     workScheduleDom.Interface
+	UserWorkSchedule userWorkScheduleDom.Interface
 }
 
 func Init(param InitParam) Interface {
 	return &overtime{
-		auth:        param.Auth,
-		overtimeDom: param.OvertimeDom,
+		auth:                param.Auth,
+		overtimeDom:         param.OvertimeDom,
+		attendanceDom:       param.Attendance,
+		holidayDom:          param.Holiday,
+		userDom:             param.User,
+		workScheduleDom:     param.WorkSchedule,
+		userWorkScheduleDom: param.UserWorkSchedule,
 	}
 }
 
@@ -51,6 +72,10 @@ func (o *overtime) Create(
 		return entity.Overtime{}, err
 	}
 
+	if err := o.validateRecordedHours(ctx, loginUser.ID, inputParam); err != nil {
+		return entity.Overtime{}, err
+	}
+
 	overtimeInputParam := inputParam.ToOvertimeInputParam(currentTime, loginUser.ID)
 	overtimeInputParam.MockApprovalData(currentTime) // TODO: remove this line on production, this is only used for testing purpose
 	overtime, err := o.overtimeDom.Create(ctx, overtimeInputParam)
@@ -65,3 +90,195 @@ func (o *overtime) Create(
 
 	return overtime, nil
 }
+
+// validateRecordedHours checks the overtime claimed on a working day against the hours the user recorded beyond the
+// shift of their work schedule. Attendance is only recorded on working days, so the overtime of rest days and public
+// holidays is taken as claimed.
+func (o *overtime) validateRecordedHours(ctx context.Context, userID int64, inputParam dto.CreateOvertimeParam) error {
+	overtimeDate := inputParam.OvertimeDate.Time
+
+	workSchedule, err := o.getWorkSchedule(ctx, userID, overtimeDate)
+	if err != nil {
+		return err
+	}
+
+	if !workSchedule.IsWorkDay(overtimeDate.Weekday()) {
+		return nil
+	}
+
+	isHoliday, err := o.isObservedHoliday(ctx, userID, overtimeDate)
+	if err != nil {
+		return err
+	}
+
+	if isHoliday {
+		return nil
+	}
+
+	attendance, err := o.attendanceDom.Get(
+		ctx,
+		entity.AttendanceParam{
+			UserID:         userID,
+			AttendanceDate: inputParam.OvertimeDate,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeBadRequest, "no attendance recorded on %s", overtimeDate.Format(time.DateOnly))
+		default:
+			return err
+		}
+	}
+
+	if !attendance.CheckOutAt.Valid {
+		return errors.NewWithCode(codes.CodeBadRequest, "no check-out recorded on %s", overtimeDate.Format(time.DateOnly))
+	}
+
+	if hoursBeyondShift := attendance.HoursBeyondShift(workSchedule); inputParam.OvertimeHour.Float64 > hoursBeyondShift {
+		return errors.NewWithCode(
+			codes.CodeBadRequest,
+			"overtimeHour cannot exceed the %.2f hours recorded beyond the shift on %s",
+			hoursBeyondShift,
+			overtimeDate.Format(time.DateOnly),
+		)
+	}
+
+	return nil
+}
+
+// getWorkSchedule returns the work schedule the user is assigned on the given date,
+// or the DefaultWorkSchedule when they have none. A deactivated schedule is skipped for the one assigned before it,
+// the same way payroll counts the working days of the user.
+func (o *overtime) getWorkSchedule(ctx context.Context, userID int64, date time.Time) (entity.WorkSchedule, error) {
+	userWorkSchedule, err := o.userWorkScheduleDom.Get(
+		ctx,
+		entity.UserWorkScheduleParam{
+			UserID:           userID,
+			EffectiveDateLTE: null.DateFrom(date),
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.DefaultWorkSchedule, nil
+		default:
+			return entity.WorkSchedule{}, err
+		}
+	}
+
+	workSchedule, err := o.workScheduleDom.Get(
+		ctx,
+		entity.WorkScheduleParam{
+			ID: userWorkSchedule.WorkScheduleID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return o.getWorkCalendarSchedule(ctx, userID, date)
+		default:
+			return entity.WorkSchedule{}, err
+		}
+	}
+
+	return workSchedule, nil
+}
+
+// getWorkCalendarSchedule returns the work schedule in effect on the given date from every work schedule assignment
+// of the user, only the active work schedules are in effect.
+func (o *overtime) getWorkCalendarSchedule(ctx context.Context, userID int64, date time.Time) (entity.WorkSchedule, error) {
+	userWorkSchedules, _, err := o.userWorkScheduleDom.GetList(
+		ctx,
+		entity.UserWorkScheduleParam{
+			UserID:           userID,
+			EffectiveDateLTE: null.DateFrom(date),
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return entity.WorkSchedule{}, err
+	}
+
+	workScheduleIDs := make([]int64, 0, len(userWorkSchedules))
+	for _, userWorkSchedule := range userWorkSchedules {
+		workScheduleIDs = append(workScheduleIDs, userWorkSchedule.WorkScheduleID)
+	}
+
+	workScheduleList, _, err := o.workScheduleDom.GetList(
+		ctx,
+		entity.WorkScheduleParam{
+			IDs: workScheduleIDs,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return entity.WorkSchedule{}, err
+	}
+
+	workSchedules := make(map[int64]entity.WorkSchedule, len(workScheduleList))
+	for _, workSchedule := range workScheduleList {
+		workSchedules[workSchedule.ID] = workSchedule
+	}
+
+	return entity.NewWorkCalendar(userWorkSchedules, workSchedules).GetWorkSchedule(date), nil
+}
+
+// isObservedHoliday reports whether the user observes a public holiday on the given date.
+func (o *overtime) isObservedHoliday(ctx context.Context, userID int64, date time.Time) (bool, error) {
+	holidays, _, err := o.holidayDom.GetList(
+		ctx,
+		entity.HolidayParam{
+			HolidayDate: null.DateFrom(date),
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if len(holidays) == 0 {
+		return false, nil
+	}
+
+	// the region of the user only matters when there is a holiday to observe
+	user, err := o.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID: userID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := entity.NewHolidayCalendar(holidays, user.Region)[date.Format(time.DateOnly)]
+	return ok, nil
+}
@@ -9,8 +9,14 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/codes"
 	"github.com/reyhanmichiels/go-pkg/v2/errors"
 	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
 	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_attendance "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance"
+	mock_holiday "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/holiday"
 	mock_overtime "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/overtime"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_work_schedule"
+	mock_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/work_schedule"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 	"github.com/stretchr/testify/assert"
@@ -23,13 +29,23 @@ func Test_overtime_Create(t *testing.T) {
 
 	mockAuth := mock_auth.NewMockInterface(ctrl)
 	mockOvertimeDom := mock_overtime.NewMockInterface(ctrl)
+	mockAttendanceDom := mock_attendance.NewMockInterface(ctrl)
+	mockHolidayDom := mock_holiday.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+	mockWorkScheduleDom := mock_work_schedule.NewMockInterface(ctrl)
+	mockUserWorkScheduleDom := mock_user_work_schedule.NewMockInterface(ctrl)
 
 	uc := Init(InitParam{
-		Auth:        mockAuth,
-		OvertimeDom: mockOvertimeDom,
+		Auth:             mockAuth,
+		OvertimeDom:      mockOvertimeDom,
+		Attendance:       mockAttendanceDom,
+		Holiday:          mockHolidayDom,
+		User:             mockUserDom,
+		WorkSchedule:     mockWorkScheduleDom,
+		UserWorkSchedule: mockUserWorkScheduleDom,
 	})
 
-	mockTime := time.Now()
+	mockTime := time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC) // A Friday
 	Now = func() time.Time {
 		return mockTime
 	}
@@ -53,6 +69,32 @@ func Test_overtime_Create(t *testing.T) {
 		ID: 1,
 	}
 
+	mockOvertimeDate := null.DateFrom(mockTime.Add(-24 * time.Hour))
+	mockNoWorkScheduleErr := errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "")
+
+	mockAttendanceParam := entity.AttendanceParam{
+		UserID:         mockLoginUser.ID,
+		AttendanceDate: mockOvertimeDate,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	// checked in at nine and out at seven, two hours beyond the nine to five shift
+	mockAttendance := entity.Attendance{
+		ID:             1,
+		UserID:         mockLoginUser.ID,
+		AttendanceDate: mockOvertimeDate,
+		CheckInAt:      null.TimeFrom(time.Date(2023, 10, 5, 9, 0, 0, 0, time.UTC)),
+		CheckOutAt:     null.TimeFrom(time.Date(2023, 10, 5, 19, 0, 0, 0, time.UTC)),
+		WorkedMinutes:  600,
+	}
+
+	mockRecordedDay := func() {
+		mockUserWorkScheduleDom.EXPECT().Get(context.Background(), gomock.Any()).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+		mockHolidayDom.EXPECT().GetList(context.Background(), gomock.Any()).Return([]entity.Holiday{}, nil, nil)
+	}
+
 	tests := []struct {
 		name     string
 		input    dto.CreateOvertimeParam
@@ -64,15 +106,86 @@ func Test_overtime_Create(t *testing.T) {
 			input: mockInputParam,
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockRecordedDay()
+				mockAttendanceDom.EXPECT().Get(context.Background(), mockAttendanceParam).Return(mockAttendance, nil)
 				mockOvertimeDom.EXPECT().Create(context.Background(), mockOvertimeInputParam).Return(mockOvertime, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "Success On Rest Day Without Attendance",
+			input: dto.CreateOvertimeParam{
+				OvertimeDate: null.DateFrom(time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)), // A Saturday
+				OvertimeHour: null.Float64From(2),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(context.Background(), gomock.Any()).Return(entity.UserWorkSchedule{}, mockNoWorkScheduleErr)
+				mockOvertimeDom.EXPECT().Create(context.Background(), gomock.Any()).Return(mockOvertime, nil)
+			},
+			wantErr: false,
+		},
+		{
+			// the assigned work schedule was deactivated, Saturday is still a working day of the schedule before it
+			name: "Working Day Of Work Schedule Before Deactivated One Without Attendance",
+			input: dto.CreateOvertimeParam{
+				OvertimeDate: null.DateFrom(time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)), // A Saturday
+				OvertimeHour: null.Float64From(2),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserWorkScheduleDom.EXPECT().Get(context.Background(), gomock.Any()).Return(entity.UserWorkSchedule{WorkScheduleID: 3}, nil)
+				mockWorkScheduleDom.EXPECT().Get(context.Background(), entity.WorkScheduleParam{ID: 3, QueryOption: query.Option{IsActive: true}}).Return(entity.WorkSchedule{}, mockNoWorkScheduleErr)
+				mockUserWorkScheduleDom.EXPECT().GetList(context.Background(), gomock.Any()).Return([]entity.UserWorkSchedule{
+					{WorkScheduleID: 2, EffectiveDate: null.DateFrom(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))},
+					{WorkScheduleID: 3, EffectiveDate: null.DateFrom(time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC))},
+				}, nil, nil)
+				mockWorkScheduleDom.EXPECT().GetList(context.Background(), gomock.Any()).Return([]entity.WorkSchedule{{ID: 2, Name: "Warehouse", WorkDays: "TUE,WED,THU,FRI,SAT"}}, nil, nil)
+				mockHolidayDom.EXPECT().GetList(context.Background(), gomock.Any()).Return([]entity.Holiday{}, nil, nil)
+				mockAttendanceDom.EXPECT().Get(context.Background(), gomock.Any()).Return(entity.Attendance{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Overtime Exceeds Recorded Hours",
+			input: dto.CreateOvertimeParam{
+				OvertimeDate: mockOvertimeDate,
+				OvertimeHour: null.Float64From(2.5),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockRecordedDay()
+				mockAttendanceDom.EXPECT().Get(context.Background(), mockAttendanceParam).Return(mockAttendance, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "No Attendance Recorded",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockRecordedDay()
+				mockAttendanceDom.EXPECT().Get(context.Background(), mockAttendanceParam).Return(entity.Attendance{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, ""))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "No Check Out Recorded",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockRecordedDay()
+				mockAttendanceDom.EXPECT().Get(context.Background(), mockAttendanceParam).Return(entity.Attendance{ID: 1, CheckInAt: mockAttendance.CheckInAt}, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name:  "Duplicate Overtime Submission",
 			input: mockInputParam,
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockRecordedDay()
+				mockAttendanceDom.EXPECT().Get(context.Background(), mockAttendanceParam).Return(mockAttendance, nil)
 				mockOvertimeDom.EXPECT().Create(context.Background(), mockOvertimeInputParam).Return(entity.Overtime{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, ""))
 			},
 			wantErr: true,
@@ -82,6 +195,8 @@ func Test_overtime_Create(t *testing.T) {
 			input: mockInputParam,
 			mockFunc: func() {
 				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockRecordedDay()
+				mockAttendanceDom.EXPECT().Get(context.Background(), mockAttendanceParam).Return(mockAttendance, nil)
 				mockOvertimeDom.EXPECT().Create(context.Background(), mockOvertimeInputParam).Return(entity.Overtime{}, assert.AnError)
 			},
 			wantErr: true,
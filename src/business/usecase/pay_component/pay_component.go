@@ -0,0 +1,91 @@
+package pay_component
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	payComponentDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/pay_component"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetList(ctx context.Context) ([]entity.PayComponent, error)
+	Update(ctx context.Context, payComponentID int64, inputParam dto.UpdatePayComponentParam) error
+}
+
+type payComponent struct {
+	auth            auth.Interface
+	payComponentDom payComponentDom.Interface
+}
+
+type InitParam struct {
+	Auth         auth.Interface
+	PayComponent payComponentDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &payComponent{
+		auth:            param.Auth,
+		payComponentDom: param.PayComponent,
+	}
+}
+
+func (p *payComponent) GetList(ctx context.Context) ([]entity.PayComponent, error) {
+	payComponents, _, err := p.payComponentDom.GetList(
+		ctx,
+		entity.PayComponentParam{
+			QueryOption: query.Option{
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"evaluation_order"},
+			},
+		},
+	)
+	if err != nil {
+		return payComponents, err
+	}
+
+	return payComponents, nil
+}
+
+func (p *payComponent) Update(
+	ctx context.Context,
+	payComponentID int64,
+	inputParam dto.UpdatePayComponentParam,
+) error {
+	loginUser, err := p.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	err = p.payComponentDom.Update(
+		ctx,
+		inputParam.ToPayComponentUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+		entity.PayComponentParam{
+			ID: payComponentID,
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLNoRowsAffected:
+			return errors.NewWithCode(codes.CodeNotFound, "pay component not found")
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package pay_component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_pay_component "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/pay_component"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_payComponent_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockPayComponentDom := mock_pay_component.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:         mockAuth,
+		PayComponent: mockPayComponentDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockInputParam := dto.UpdatePayComponentParam{
+		EvaluationOrder: null.Int64From(2),
+		Config:          null.StringFrom(`{"hoursPerDay": 8}`),
+	}
+
+	mockSelectParam := entity.PayComponentParam{
+		ID: 1,
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.UpdatePayComponentParam
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockPayComponentDom.EXPECT().Update(
+					context.Background(),
+					mockInputParam.ToPayComponentUpdateParam(null.TimeFrom(mockTime), mockLoginUser.ID),
+					mockSelectParam,
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Pay Component Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockPayComponentDom.EXPECT().Update(
+					context.Background(),
+					mockInputParam.ToPayComponentUpdateParam(null.TimeFrom(mockTime), mockLoginUser.ID),
+					mockSelectParam,
+				).Return(errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no pay component updated"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Validation Error Invalid Config",
+			input: dto.UpdatePayComponentParam{Config: null.StringFrom("{invalid")},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Validation Error Empty Param",
+			input: dto.UpdatePayComponentParam{},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "GetUserAuthInfo Error",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(auth.User{}, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.Update(context.Background(), 1, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("payComponent.Update() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
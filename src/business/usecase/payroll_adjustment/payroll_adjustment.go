@@ -0,0 +1,189 @@
+package payroll_adjustment
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	attendancePeriodDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/attendance_period"
+	payrollAdjustmentDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/payroll_adjustment"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetPayrollAdjustments(ctx context.Context, attendancePeriodID int64) ([]entity.PayrollAdjustment, error)
+	CreatePayrollAdjustment(ctx context.Context, attendancePeriodID int64, inputParam dto.CreatePayrollAdjustmentParam) (entity.PayrollAdjustment, error)
+	CancelPayrollAdjustment(ctx context.Context, payrollAdjustmentID int64) error
+}
+
+type payrollAdjustment struct {
+	auth                 auth.Interface
+	attendancePeriodDom  attendancePeriodDom.Interface
+	payrollAdjustmentDom payrollAdjustmentDom.Interface
+	userDom              userDom.Interface
+}
+
+type InitParam struct {
+	Auth              auth.Interface
+	AttendancePeriod  attendancePeriodDom.Interface
+	PayrollAdjustment payrollAdjustmentDom.Interface
+	User              userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &payrollAdjustment{
+		auth:                 param.Auth,
+		attendancePeriodDom:  param.AttendancePeriod,
+		payrollAdjustmentDom: param.PayrollAdjustment,
+		userDom:              param.User,
+	}
+}
+
+func (p *payrollAdjustment) GetPayrollAdjustments(ctx context.Context, attendancePeriodID int64) ([]entity.PayrollAdjustment, error) {
+	payrollAdjustments, _, err := p.payrollAdjustmentDom.GetList(
+		ctx,
+		entity.PayrollAdjustmentParam{
+			AttendancePeriodID: attendancePeriodID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"fk_user_id", "id"},
+			},
+		},
+	)
+	if err != nil {
+		return payrollAdjustments, err
+	}
+
+	return payrollAdjustments, nil
+}
+
+// CreatePayrollAdjustment attaches a one-off earning or deduction of an employee to an attendance period,
+// it is picked up by the next payroll run of the period.
+func (p *payrollAdjustment) CreatePayrollAdjustment(
+	ctx context.Context,
+	attendancePeriodID int64,
+	inputParam dto.CreatePayrollAdjustmentParam,
+) (
+	entity.PayrollAdjustment,
+	error,
+) {
+	loginUser, err := p.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.PayrollAdjustment{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.PayrollAdjustment{}, err
+	}
+
+	if err := p.validateAttendancePeriodUnlocked(ctx, attendancePeriodID); err != nil {
+		return entity.PayrollAdjustment{}, err
+	}
+
+	employee, err := p.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID:     inputParam.UserID,
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.PayrollAdjustment{}, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return entity.PayrollAdjustment{}, err
+		}
+	}
+
+	return p.payrollAdjustmentDom.Create(ctx, inputParam.ToPayrollAdjustmentInputParam(attendancePeriodID, employee, null.TimeFrom(Now()), loginUser.ID))
+}
+
+// CancelPayrollAdjustment deactivates an adjustment so that the next payroll run of its period ignores it.
+func (p *payrollAdjustment) CancelPayrollAdjustment(ctx context.Context, payrollAdjustmentID int64) error {
+	loginUser, err := p.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	adjustment, err := p.payrollAdjustmentDom.Get(
+		ctx,
+		entity.PayrollAdjustmentParam{
+			ID: payrollAdjustmentID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeNotFound, "payroll adjustment not found")
+		default:
+			return err
+		}
+	}
+
+	if err := p.validateAttendancePeriodUnlocked(ctx, adjustment.AttendancePeriodID); err != nil {
+		return err
+	}
+
+	return p.payrollAdjustmentDom.Update(
+		ctx,
+		entity.PayrollAdjustmentUpdateParam{
+			Status:    null.Int64From(-1),
+			UpdatedAt: null.TimeFrom(Now()),
+			UpdatedBy: null.Int64From(loginUser.ID),
+			DeletedAt: null.TimeFrom(Now()),
+			DeletedBy: null.Int64From(loginUser.ID),
+		},
+		entity.PayrollAdjustmentParam{
+			ID: payrollAdjustmentID,
+		},
+	)
+}
+
+// validateAttendancePeriodUnlocked rejects changes to the adjustments of a period whose payroll is running
+// or has been processed, so a payslip always matches the adjustments it was calculated from.
+func (p *payrollAdjustment) validateAttendancePeriodUnlocked(ctx context.Context, attendancePeriodID int64) error {
+	attendancePeriod, err := p.attendancePeriodDom.Get(
+		ctx,
+		entity.AttendancePeriodParam{
+			ID: attendancePeriodID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return errors.NewWithCode(codes.CodeNotFound, "attendance period not found")
+		default:
+			return err
+		}
+	}
+
+	switch attendancePeriod.PeriodStatus {
+	case entity.PeriodStatusProcessed:
+		return errors.NewWithCode(codes.CodeBadRequest, "attendance period has already been processed, its adjustments are locked")
+	case entity.PeriodStatusProcessing:
+		return errors.NewWithCode(codes.CodeConflict, "attendance period is currently being processed")
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package payroll_adjustment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_attendance_period "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/attendance_period"
+	mock_payroll_adjustment "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/payroll_adjustment"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_payrollAdjustment_CreatePayrollAdjustment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
+	mockPayrollAdjustmentDom := mock_payroll_adjustment.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:              mockAuth,
+		AttendancePeriod:  mockAttendancePeriodDom,
+		PayrollAdjustment: mockPayrollAdjustmentDom,
+		User:              mockUserDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockAttendancePeriodID := int64(3)
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		ID: mockAttendancePeriodID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockEmployee := entity.User{
+		ID:       11,
+		RoleID:   entity.RoleIDUser,
+		Currency: "USD",
+	}
+	mockUserParam := entity.UserParam{
+		ID:     mockEmployee.ID,
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockInputParam := dto.CreatePayrollAdjustmentParam{
+		UserID:         mockEmployee.ID,
+		AdjustmentType: entity.PayrollAdjustmentTypeEarning,
+		Amount:         entity.NewMoney(500),
+		Reason:         "Q2 performance bonus",
+	}
+
+	mockPayrollAdjustment := entity.PayrollAdjustment{
+		ID:                 7,
+		AttendancePeriodID: mockAttendancePeriodID,
+		UserID:             mockEmployee.ID,
+		AdjustmentType:     entity.PayrollAdjustmentTypeEarning,
+		Amount:             entity.NewMoney(500),
+		Currency:           "USD",
+		Reason:             "Q2 performance bonus",
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreatePayrollAdjustmentParam
+		mockFunc func()
+		want     entity.PayrollAdjustment
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success In Salary Currency",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{ID: mockAttendancePeriodID, PeriodStatus: entity.PeriodStatusClosed}, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(mockEmployee, nil)
+				mockPayrollAdjustmentDom.EXPECT().Create(ctx, entity.PayrollAdjustmentInputParam{
+					AttendancePeriodID: mockAttendancePeriodID,
+					UserID:             mockEmployee.ID,
+					AdjustmentType:     entity.PayrollAdjustmentTypeEarning,
+					Amount:             entity.NewMoney(500),
+					Currency:           "USD",
+					Reason:             "Q2 performance bonus",
+					CreatedAt:          null.TimeFrom(mockTime),
+					CreatedBy:          null.Int64From(mockLoginUser.ID),
+				}).Return(mockPayrollAdjustment, nil)
+			},
+			want:    mockPayrollAdjustment,
+			wantErr: false,
+		},
+		{
+			name:  "Attendance Period Processed",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{ID: mockAttendancePeriodID, PeriodStatus: entity.PeriodStatusProcessed}, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Attendance Period Processing",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{ID: mockAttendancePeriodID, PeriodStatus: entity.PeriodStatusProcessing}, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+		{
+			name:  "Attendance Period Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "User Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{ID: mockAttendancePeriodID, PeriodStatus: entity.PeriodStatusOpen}, nil)
+				mockUserDom.EXPECT().Get(ctx, mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Invalid Adjustment Type",
+			input: dto.CreatePayrollAdjustmentParam{UserID: mockEmployee.ID, AdjustmentType: "BONUS", Amount: entity.NewMoney(500), Reason: "bonus"},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreatePayrollAdjustment(ctx, mockAttendancePeriodID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("payrollAdjustment.CreatePayrollAdjustment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_payrollAdjustment_CancelPayrollAdjustment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockAttendancePeriodDom := mock_attendance_period.NewMockInterface(ctrl)
+	mockPayrollAdjustmentDom := mock_payroll_adjustment.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:              mockAuth,
+		AttendancePeriod:  mockAttendancePeriodDom,
+		PayrollAdjustment: mockPayrollAdjustmentDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	ctx := context.Background()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockPayrollAdjustmentID := int64(7)
+	mockPayrollAdjustmentParam := entity.PayrollAdjustmentParam{
+		ID: mockPayrollAdjustmentID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	mockPayrollAdjustment := entity.PayrollAdjustment{
+		ID:                 mockPayrollAdjustmentID,
+		AttendancePeriodID: 3,
+	}
+	mockAttendancePeriodParam := entity.AttendancePeriodParam{
+		ID: mockPayrollAdjustment.AttendancePeriodID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollAdjustmentDom.EXPECT().Get(ctx, mockPayrollAdjustmentParam).Return(mockPayrollAdjustment, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{PeriodStatus: entity.PeriodStatusClosed}, nil)
+				mockPayrollAdjustmentDom.EXPECT().Update(
+					ctx,
+					entity.PayrollAdjustmentUpdateParam{
+						Status:    null.Int64From(-1),
+						UpdatedAt: null.TimeFrom(mockTime),
+						UpdatedBy: null.Int64From(mockLoginUser.ID),
+						DeletedAt: null.TimeFrom(mockTime),
+						DeletedBy: null.Int64From(mockLoginUser.ID),
+					},
+					entity.PayrollAdjustmentParam{ID: mockPayrollAdjustmentID},
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Locked After Processed",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollAdjustmentDom.EXPECT().Get(ctx, mockPayrollAdjustmentParam).Return(mockPayrollAdjustment, nil)
+				mockAttendancePeriodDom.EXPECT().Get(ctx, mockAttendancePeriodParam).Return(entity.AttendancePeriod{PeriodStatus: entity.PeriodStatusProcessed}, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name: "Payroll Adjustment Not Found",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(ctx).Return(mockLoginUser, nil)
+				mockPayrollAdjustmentDom.EXPECT().Get(ctx, mockPayrollAdjustmentParam).Return(entity.PayrollAdjustment{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.CancelPayrollAdjustment(ctx, mockPayrollAdjustmentID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("payrollAdjustment.CancelPayrollAdjustment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			}
+		})
+	}
+}
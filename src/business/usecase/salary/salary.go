@@ -0,0 +1,109 @@
+package salary
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	userSalaryDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_salary"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetUserSalaries(ctx context.Context, userID int64) ([]entity.UserSalary, error)
+	CreateUserSalary(ctx context.Context, userID int64, inputParam dto.CreateUserSalaryParam) (entity.UserSalary, error)
+}
+
+type salary struct {
+	auth          auth.Interface
+	userSalaryDom userSalaryDom.Interface
+	userDom       userDom.Interface
+}
+
+type InitParam struct {
+	Auth       auth.Interface
+	UserSalary userSalaryDom.Interface
+	User       userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &salary{
+		auth:          param.Auth,
+		userSalaryDom: param.UserSalary,
+		userDom:       param.User,
+	}
+}
+
+func (s *salary) GetUserSalaries(ctx context.Context, userID int64) ([]entity.UserSalary, error) {
+	userSalaries, _, err := s.userSalaryDom.GetList(
+		ctx,
+		entity.UserSalaryParam{
+			UserID: userID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return userSalaries, err
+	}
+
+	return userSalaries, nil
+}
+
+// CreateUserSalary records a salary of an employee from the effective date on. The salary history is only
+// added to, so the salary every period was paid at stays on record. An employee gets at most one salary per
+// effective date.
+func (s *salary) CreateUserSalary(ctx context.Context, userID int64, inputParam dto.CreateUserSalaryParam) (entity.UserSalary, error) {
+	loginUser, err := s.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.UserSalary{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.UserSalary{}, err
+	}
+
+	_, err = s.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID:     userID,
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.UserSalary{}, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return entity.UserSalary{}, err
+		}
+	}
+
+	userSalary, err := s.userSalaryDom.Create(ctx, inputParam.ToUserSalaryInputParam(userID, null.TimeFrom(Now()), loginUser.ID))
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLUniqueConstraint:
+			return entity.UserSalary{}, errors.NewWithCode(codes.CodeConflict, "user already has a salary effective from %s", inputParam.EffectiveDate.Time.Format(time.DateOnly))
+		default:
+			return entity.UserSalary{}, err
+		}
+	}
+
+	return userSalary, nil
+}
@@ -0,0 +1,141 @@
+package salary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_salary "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_salary"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_salary_CreateUserSalary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockUserSalaryDom := mock_user_salary.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:       mockAuth,
+		UserSalary: mockUserSalaryDom,
+		User:       mockUserDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockUserID := int64(2)
+
+	mockInputParam := dto.CreateUserSalaryParam{
+		BaseSalary:    entity.NewMoney(12000000),
+		EffectiveDate: null.DateFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	mockUserParam := entity.UserParam{
+		ID:     mockUserID,
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockUserSalary := entity.UserSalary{
+		ID:            1,
+		UserID:        mockUserID,
+		BaseSalary:    mockInputParam.BaseSalary,
+		EffectiveDate: mockInputParam.EffectiveDate,
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateUserSalaryParam
+		mockFunc func()
+		want     entity.UserSalary
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockUserSalaryDom.EXPECT().Create(
+					context.Background(),
+					mockInputParam.ToUserSalaryInputParam(mockUserID, null.TimeFrom(mockTime), mockLoginUser.ID),
+				).Return(mockUserSalary, nil)
+			},
+			want:    mockUserSalary,
+			wantErr: false,
+		},
+		{
+			name:  "Invalid Base Salary",
+			input: dto.CreateUserSalaryParam{EffectiveDate: mockInputParam.EffectiveDate},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "User Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Salary Already Effective On Date",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockUserSalaryDom.EXPECT().Create(context.Background(), gomock.Any()).Return(entity.UserSalary{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, "duplicate"))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateUserSalary(context.Background(), mockUserID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("salary.CreateUserSalary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package tax
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	taxBracketDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/tax_bracket"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetTaxBrackets(ctx context.Context, effectiveDate null.Date) ([]entity.TaxBracket, error)
+	CreateTaxBrackets(ctx context.Context, inputParam dto.CreateTaxBracketsParam) error
+	UpdateUserTaxStatus(ctx context.Context, userID int64, inputParam dto.UpdateUserTaxStatusParam) error
+}
+
+type tax struct {
+	auth          auth.Interface
+	taxBracketDom taxBracketDom.Interface
+	userDom       userDom.Interface
+}
+
+type InitParam struct {
+	Auth       auth.Interface
+	TaxBracket taxBracketDom.Interface
+	User       userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &tax{
+		auth:          param.Auth,
+		taxBracketDom: param.TaxBracket,
+		userDom:       param.User,
+	}
+}
+
+// GetTaxBrackets returns the version of the tax table in effect on the given date, or today when no date is given.
+func (t *tax) GetTaxBrackets(ctx context.Context, effectiveDate null.Date) ([]entity.TaxBracket, error) {
+	if !effectiveDate.Valid {
+		effectiveDate = null.DateFrom(Now())
+	}
+
+	taxBrackets, _, err := t.taxBracketDom.GetList(
+		ctx,
+		entity.TaxBracketParam{
+			EffectiveDateLTE: effectiveDate,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-effective_date", "lower_bound"},
+			},
+		},
+	)
+	if err != nil {
+		return taxBrackets, err
+	}
+
+	taxBrackets = entity.GetEffectiveTaxBrackets(taxBrackets)
+	if len(taxBrackets) == 0 {
+		return taxBrackets, errors.NewWithCode(codes.CodeNotFound, "no tax bracket effective on %s", effectiveDate.Time.Format(time.DateOnly))
+	}
+
+	return taxBrackets, nil
+}
+
+// CreateTaxBrackets publishes a new version of the tax table, the existing versions are kept
+// so that payroll of older periods is still computed with the brackets of its time.
+func (t *tax) CreateTaxBrackets(ctx context.Context, inputParam dto.CreateTaxBracketsParam) error {
+	loginUser, err := t.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	_, err = t.taxBracketDom.Get(
+		ctx,
+		entity.TaxBracketParam{
+			EffectiveDate: inputParam.EffectiveDate,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err == nil {
+		return errors.NewWithCode(codes.CodeConflict, "tax brackets effective on %s already exist", inputParam.EffectiveDate.Time.Format(time.DateOnly))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return err
+	}
+
+	return t.taxBracketDom.CreateMany(ctx, inputParam.ToTaxBracketInputParams(null.TimeFrom(Now()), loginUser.ID))
+}
+
+func (t *tax) UpdateUserTaxStatus(ctx context.Context, userID int64, inputParam dto.UpdateUserTaxStatusParam) error {
+	loginUser, err := t.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	err = t.userDom.Update(
+		ctx,
+		inputParam.ToUserUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+		entity.UserParam{
+			ID: userID,
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLNoRowsAffected:
+			return errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
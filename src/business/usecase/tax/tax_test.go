@@ -0,0 +1,214 @@
+package tax
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_tax_bracket "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/tax_bracket"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_tax_GetTaxBrackets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaxBracketDom := mock_tax_bracket.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		TaxBracket: mockTaxBracketDom,
+	})
+
+	mockEffectiveDate := null.DateFrom(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+	mockSelectParam := entity.TaxBracketParam{
+		EffectiveDateLTE: mockEffectiveDate,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-effective_date", "lower_bound"},
+		},
+	}
+
+	latestVersion := null.DateFrom(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	olderVersion := null.DateFrom(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	mockTaxBrackets := []entity.TaxBracket{
+		{ID: 3, EffectiveDate: latestVersion, LowerBound: 0, UpperBound: entity.NullMoneyFrom(entity.NewMoney(60000000)), Rate: 0.05},
+		{ID: 4, EffectiveDate: latestVersion, LowerBound: entity.NewMoney(60000000), Rate: 0.15},
+		{ID: 1, EffectiveDate: olderVersion, LowerBound: 0, UpperBound: entity.NullMoneyFrom(entity.NewMoney(50000000)), Rate: 0.05},
+		{ID: 2, EffectiveDate: olderVersion, LowerBound: entity.NewMoney(50000000), Rate: 0.15},
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     []entity.TaxBracket
+		wantErr  bool
+	}{
+		{
+			name: "Success Picks Latest Version",
+			mockFunc: func() {
+				mockTaxBracketDom.EXPECT().GetList(context.Background(), mockSelectParam).Return(mockTaxBrackets, nil, nil)
+			},
+			want:    mockTaxBrackets[:2],
+			wantErr: false,
+		},
+		{
+			name: "No Tax Bracket Effective",
+			mockFunc: func() {
+				mockTaxBracketDom.EXPECT().GetList(context.Background(), mockSelectParam).Return([]entity.TaxBracket{}, nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "GetList Error",
+			mockFunc: func() {
+				mockTaxBracketDom.EXPECT().GetList(context.Background(), mockSelectParam).Return(nil, nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetTaxBrackets(context.Background(), mockEffectiveDate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("tax.GetTaxBrackets() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_tax_CreateTaxBrackets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockTaxBracketDom := mock_tax_bracket.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:       mockAuth,
+		TaxBracket: mockTaxBracketDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockInputParam := dto.CreateTaxBracketsParam{
+		EffectiveDate: null.DateFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Brackets: []dto.TaxBracketParam{
+			{LowerBound: 0, UpperBound: entity.NullMoneyFrom(entity.NewMoney(60000000)), Rate: 0.05},
+			{LowerBound: entity.NewMoney(60000000), Rate: 0.15},
+		},
+	}
+
+	mockSelectParam := entity.TaxBracketParam{
+		EffectiveDate: mockInputParam.EffectiveDate,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateTaxBracketsParam
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockTaxBracketDom.EXPECT().Get(context.Background(), mockSelectParam).Return(entity.TaxBracket{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+				mockTaxBracketDom.EXPECT().CreateMany(
+					context.Background(),
+					mockInputParam.ToTaxBracketInputParams(null.TimeFrom(mockTime), mockLoginUser.ID),
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Version Already Exists",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockTaxBracketDom.EXPECT().Get(context.Background(), mockSelectParam).Return(entity.TaxBracket{ID: 1}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Validation Error Gap Between Brackets",
+			input: dto.CreateTaxBracketsParam{
+				EffectiveDate: mockInputParam.EffectiveDate,
+				Brackets: []dto.TaxBracketParam{
+					{LowerBound: 0, UpperBound: entity.NullMoneyFrom(entity.NewMoney(60000000)), Rate: 0.05},
+					{LowerBound: entity.NewMoney(70000000), Rate: 0.15},
+				},
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Validation Error Open Ended Bracket Not Last",
+			input: dto.CreateTaxBracketsParam{
+				EffectiveDate: mockInputParam.EffectiveDate,
+				Brackets: []dto.TaxBracketParam{
+					{LowerBound: 0, Rate: 0.05},
+					{LowerBound: 0, Rate: 0.15},
+				},
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "GetUserAuthInfo Error",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(auth.User{}, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := uc.CreateTaxBrackets(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("tax.CreateTaxBrackets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
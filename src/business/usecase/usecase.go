@@ -6,20 +6,42 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/log"
 	"github.com/reyhanmichiels/go-pkg/v2/parser"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/domain"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/allowance"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/attendance"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/attendance_period"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/exchange_rate"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/holiday"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/loan"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/office_location"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/overtime"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/pay_component"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/payroll_adjustment"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/reimbursement"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/salary"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/tax"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/year_to_date"
 	"github.com/reyhanmichies/employee-payroll-service/src/handler/pubsub/publisher"
 )
 
 type Usecases struct {
-	User             user.Interface
-	AttendancePeriod attendance_period.Interface
-	Attendance       attendance.Interface
-	Overtime         overtime.Interface
-	Reimbursement    reimbursement.Interface
+	User              user.Interface
+	AttendancePeriod  attendance_period.Interface
+	Attendance        attendance.Interface
+	Overtime          overtime.Interface
+	Reimbursement     reimbursement.Interface
+	PayComponent      pay_component.Interface
+	Tax               tax.Interface
+	ExchangeRate      exchange_rate.Interface
+	Allowance         allowance.Interface
+	PayrollAdjustment payroll_adjustment.Interface
+	Loan              loan.Interface
+	Holiday           holiday.Interface
+	WorkSchedule      work_schedule.Interface
+	Salary            salary.Interface
+	YearToDate        year_to_date.Interface
+	OfficeLocation    office_location.Interface
 }
 
 type InitParam struct {
@@ -33,10 +55,21 @@ type InitParam struct {
 
 func Init(param InitParam) *Usecases {
 	return &Usecases{
-		User:             user.Init(user.InitParam{UserDomain: param.Dom.User, Auth: param.Auth, Hash: param.Hash}),
-		AttendancePeriod: attendance_period.Init(attendance_period.InitParam{Auth: param.Auth, AttendancePeriod: param.Dom.AttendancePeriod, Publisher: param.Publisher, Transactor: param.Dom.Transactor, Json: param.Json, Log: param.Log, Payslip: param.Dom.Payslip, PayslipDetail: param.Dom.PayslipDetail, User: param.Dom.User, Overtime: param.Dom.Overtime, Reimbursement: param.Dom.Reimbursement, Attendance: param.Dom.Attendance}),
-		Attendance:       attendance.Init(attendance.InitParam{Auth: param.Auth, AttendancePeriod: param.Dom.AttendancePeriod, Attendance: param.Dom.Attendance}),
-		Overtime:         overtime.Init(overtime.InitParam{Auth: param.Auth, OvertimeDom: param.Dom.Overtime}),
-		Reimbursement:    reimbursement.Init(reimbursement.InitParam{Auth: param.Auth, Reimbursement: param.Dom.Reimbursement}),
+		User:              user.Init(user.InitParam{UserDomain: param.Dom.User, Auth: param.Auth, Hash: param.Hash}),
+		AttendancePeriod:  attendance_period.Init(attendance_period.InitParam{Auth: param.Auth, AttendancePeriod: param.Dom.AttendancePeriod, Publisher: param.Publisher, Transactor: param.Dom.Transactor, Json: param.Json, Log: param.Log, Payslip: param.Dom.Payslip, PayslipDetail: param.Dom.PayslipDetail, User: param.Dom.User, Overtime: param.Dom.Overtime, Reimbursement: param.Dom.Reimbursement, Attendance: param.Dom.Attendance, PayComponent: param.Dom.PayComponent, TaxBracket: param.Dom.TaxBracket, PayrollPreview: param.Dom.PayrollPreview, PayrollRun: param.Dom.PayrollRun, PayrollRunEmployee: param.Dom.PayrollRunEmployee, ExchangeRate: param.Dom.ExchangeRate, PayslipCurrencyTotal: param.Dom.PayslipCurrencyTotal, AllowanceType: param.Dom.AllowanceType, UserAllowance: param.Dom.UserAllowance, PayrollAdjustment: param.Dom.PayrollAdjustment, Loan: param.Dom.Loan, LoanRepayment: param.Dom.LoanRepayment, Holiday: param.Dom.Holiday, WorkSchedule: param.Dom.WorkSchedule, UserWorkSchedule: param.Dom.UserWorkSchedule, UserSalary: param.Dom.UserSalary, YearToDateTotal: param.Dom.YearToDateTotal, AttendanceType: param.Dom.AttendanceType}),
+		Attendance:        attendance.Init(attendance.InitParam{Auth: param.Auth, AttendancePeriod: param.Dom.AttendancePeriod, Attendance: param.Dom.Attendance, Holiday: param.Dom.Holiday, User: param.Dom.User, WorkSchedule: param.Dom.WorkSchedule, UserWorkSchedule: param.Dom.UserWorkSchedule, OfficeLocation: param.Dom.OfficeLocation, UserOfficeLocation: param.Dom.UserOfficeLocation, AttendanceCorrection: param.Dom.AttendanceCorrection, AttendanceType: param.Dom.AttendanceType, Transactor: param.Dom.Transactor}),
+		Overtime:          overtime.Init(overtime.InitParam{Auth: param.Auth, OvertimeDom: param.Dom.Overtime, Attendance: param.Dom.Attendance, Holiday: param.Dom.Holiday, User: param.Dom.User, WorkSchedule: param.Dom.WorkSchedule, UserWorkSchedule: param.Dom.UserWorkSchedule}),
+		Reimbursement:     reimbursement.Init(reimbursement.InitParam{Auth: param.Auth, Reimbursement: param.Dom.Reimbursement}),
+		PayComponent:      pay_component.Init(pay_component.InitParam{Auth: param.Auth, PayComponent: param.Dom.PayComponent}),
+		Tax:               tax.Init(tax.InitParam{Auth: param.Auth, TaxBracket: param.Dom.TaxBracket, User: param.Dom.User}),
+		ExchangeRate:      exchange_rate.Init(exchange_rate.InitParam{Auth: param.Auth, ExchangeRate: param.Dom.ExchangeRate}),
+		Allowance:         allowance.Init(allowance.InitParam{Auth: param.Auth, AllowanceType: param.Dom.AllowanceType, UserAllowance: param.Dom.UserAllowance, User: param.Dom.User}),
+		PayrollAdjustment: payroll_adjustment.Init(payroll_adjustment.InitParam{Auth: param.Auth, AttendancePeriod: param.Dom.AttendancePeriod, PayrollAdjustment: param.Dom.PayrollAdjustment, User: param.Dom.User}),
+		Loan:              loan.Init(loan.InitParam{Auth: param.Auth, Loan: param.Dom.Loan, LoanRepayment: param.Dom.LoanRepayment, User: param.Dom.User}),
+		Holiday:           holiday.Init(holiday.InitParam{Auth: param.Auth, Holiday: param.Dom.Holiday, User: param.Dom.User}),
+		WorkSchedule:      work_schedule.Init(work_schedule.InitParam{Auth: param.Auth, WorkSchedule: param.Dom.WorkSchedule, UserWorkSchedule: param.Dom.UserWorkSchedule, User: param.Dom.User}),
+		Salary:            salary.Init(salary.InitParam{Auth: param.Auth, UserSalary: param.Dom.UserSalary, User: param.Dom.User}),
+		YearToDate:        year_to_date.Init(year_to_date.InitParam{Auth: param.Auth, YearToDateTotal: param.Dom.YearToDateTotal, User: param.Dom.User}),
+		OfficeLocation:    office_location.Init(office_location.InitParam{Auth: param.Auth, OfficeLocation: param.Dom.OfficeLocation, UserOfficeLocation: param.Dom.UserOfficeLocation, User: param.Dom.User}),
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/null"
 	"github.com/reyhanmichiels/go-pkg/v2/query"
 	userDomain "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 )
 
@@ -22,6 +23,7 @@ type Interface interface {
 	SignIn(ctx context.Context, param entity.UserLoginParam) (entity.UserLoginResponse, error)
 	Get(ctx context.Context, param entity.UserParam) (entity.User, error)
 	RefreshToken(ctx context.Context, param entity.RefreshTokenParam) (entity.UserLoginResponse, error)
+	UpdateUserEmployment(ctx context.Context, userID int64, inputParam dto.UpdateUserEmploymentParam) error
 }
 
 type user struct {
@@ -163,6 +165,37 @@ func (u *user) RefreshToken(ctx context.Context, param entity.RefreshTokenParam)
 	return response, nil
 }
 
+// UpdateUserEmployment sets the hire and termination dates of an employee, the payroll only pays
+// the working days between them.
+func (u *user) UpdateUserEmployment(ctx context.Context, userID int64, inputParam dto.UpdateUserEmploymentParam) error {
+	loginUser, err := u.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return err
+	}
+
+	err = u.user.Update(
+		ctx,
+		inputParam.ToUserUpdateParam(null.TimeFrom(Now()), loginUser.ID),
+		entity.UserParam{
+			ID: userID,
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLNoRowsAffected:
+			return errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (u *user) issueToken(ctx context.Context, userID int64) (string, string, error) {
 	accessToken, err := u.auth.CreateAccessToken(userID)
 	if err != nil {
@@ -0,0 +1,172 @@
+package work_schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	userWorkScheduleDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user_work_schedule"
+	workScheduleDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetWorkSchedules(ctx context.Context) ([]entity.WorkSchedule, error)
+	CreateWorkSchedule(ctx context.Context, inputParam dto.CreateWorkScheduleParam) (entity.WorkSchedule, error)
+	GetUserWorkSchedules(ctx context.Context, userID int64) ([]entity.UserWorkSchedule, error)
+	CreateUserWorkSchedule(ctx context.Context, userID int64, inputParam dto.CreateUserWorkScheduleParam) (entity.UserWorkSchedule, error)
+}
+
+type workSchedule struct {
+	auth                auth.Interface
+	workScheduleDom     workScheduleDom.Interface
+	userWorkScheduleDom userWorkScheduleDom.Interface
+	userDom             userDom.Interface
+}
+
+type InitParam struct {
+	Auth             auth.Interface
+	WorkSchedule     workScheduleDom.Interface
+	UserWorkSchedule userWorkScheduleDom.Interface
+	User             userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &workSchedule{
+		auth:                param.Auth,
+		workScheduleDom:     param.WorkSchedule,
+		userWorkScheduleDom: param.UserWorkSchedule,
+		userDom:             param.User,
+	}
+}
+
+func (w *workSchedule) GetWorkSchedules(ctx context.Context) ([]entity.WorkSchedule, error) {
+	workSchedules, _, err := w.workScheduleDom.GetList(
+		ctx,
+		entity.WorkScheduleParam{
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"name"},
+			},
+		},
+	)
+	if err != nil {
+		return workSchedules, err
+	}
+
+	return workSchedules, nil
+}
+
+func (w *workSchedule) CreateWorkSchedule(ctx context.Context, inputParam dto.CreateWorkScheduleParam) (entity.WorkSchedule, error) {
+	loginUser, err := w.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.WorkSchedule{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.WorkSchedule{}, err
+	}
+
+	return w.workScheduleDom.Create(ctx, inputParam.ToWorkScheduleInputParam(null.TimeFrom(Now()), loginUser.ID))
+}
+
+func (w *workSchedule) GetUserWorkSchedules(ctx context.Context, userID int64) ([]entity.UserWorkSchedule, error) {
+	userWorkSchedules, _, err := w.userWorkScheduleDom.GetList(
+		ctx,
+		entity.UserWorkScheduleParam{
+			UserID: userID,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+			PaginationParam: entity.PaginationParam{
+				SortBy: []string{"-effective_date"},
+			},
+		},
+	)
+	if err != nil {
+		return userWorkSchedules, err
+	}
+
+	return userWorkSchedules, nil
+}
+
+// CreateUserWorkSchedule assigns a work schedule to an employee from the effective date on, it replaces
+// the schedule they had until then. An employee gets at most one assignment per effective date.
+func (w *workSchedule) CreateUserWorkSchedule(
+	ctx context.Context,
+	userID int64,
+	inputParam dto.CreateUserWorkScheduleParam,
+) (
+	entity.UserWorkSchedule,
+	error,
+) {
+	loginUser, err := w.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.UserWorkSchedule{}, err
+	}
+
+	if err := inputParam.Validate(); err != nil {
+		return entity.UserWorkSchedule{}, err
+	}
+
+	_, err = w.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID:     userID,
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.UserWorkSchedule{}, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return entity.UserWorkSchedule{}, err
+		}
+	}
+
+	_, err = w.workScheduleDom.Get(
+		ctx,
+		entity.WorkScheduleParam{
+			ID: inputParam.WorkScheduleID,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return entity.UserWorkSchedule{}, errors.NewWithCode(codes.CodeNotFound, "work schedule not found")
+		default:
+			return entity.UserWorkSchedule{}, err
+		}
+	}
+
+	userWorkSchedule, err := w.userWorkScheduleDom.Create(ctx, inputParam.ToUserWorkScheduleInputParam(userID, null.TimeFrom(Now()), loginUser.ID))
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLUniqueConstraint:
+			return entity.UserWorkSchedule{}, errors.NewWithCode(codes.CodeConflict, "user already has a work schedule effective from %s", inputParam.EffectiveDate.Time.Format(time.DateOnly))
+		default:
+			return entity.UserWorkSchedule{}, err
+		}
+	}
+
+	return userWorkSchedule, nil
+}
@@ -0,0 +1,364 @@
+package work_schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_user_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user_work_schedule"
+	mock_work_schedule "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/work_schedule"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_workSchedule_CreateWorkSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockWorkScheduleDom := mock_work_schedule.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:         mockAuth,
+		WorkSchedule: mockWorkScheduleDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockInputParam := dto.CreateWorkScheduleParam{
+		Name:     "Warehouse",
+		WorkDays: []string{"TUE", "WED", "THU", "FRI", "SAT"},
+	}
+
+	mockWorkSchedule := entity.WorkSchedule{
+		ID:         1,
+		Name:       "Warehouse",
+		WorkDays:   "TUE,WED,THU,FRI,SAT",
+		ShiftStart: "09:00",
+		ShiftEnd:   "17:00",
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateWorkScheduleParam
+		mockFunc func()
+		want     entity.WorkSchedule
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockWorkScheduleDom.EXPECT().Create(
+					context.Background(),
+					entity.WorkScheduleInputParam{
+						Name:        "Warehouse",
+						WorkDays:    "TUE,WED,THU,FRI,SAT",
+						ShiftStart:  "09:00",
+						ShiftEnd:    "17:00",
+						TimeZone:    entity.DefaultTimeZone,
+						PenaltyType: entity.WorkSchedulePenaltyTypeNone,
+						CreatedAt:   null.TimeFrom(mockTime),
+						CreatedBy:   null.Int64From(mockLoginUser.ID),
+					},
+				).Return(mockWorkSchedule, nil)
+			},
+			want:    mockWorkSchedule,
+			wantErr: false,
+		},
+		{
+			name: "Success With Night Shift",
+			input: dto.CreateWorkScheduleParam{
+				Name:       "Night Warehouse",
+				WorkDays:   []string{"MON", "TUE"},
+				ShiftStart: "22:00",
+				ShiftEnd:   "06:00",
+				TimeZone:   "Asia/Makassar",
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockWorkScheduleDom.EXPECT().Create(
+					context.Background(),
+					entity.WorkScheduleInputParam{
+						Name:        "Night Warehouse",
+						WorkDays:    "MON,TUE",
+						ShiftStart:  "22:00",
+						ShiftEnd:    "06:00",
+						TimeZone:    "Asia/Makassar",
+						PenaltyType: entity.WorkSchedulePenaltyTypeNone,
+						CreatedAt:   null.TimeFrom(mockTime),
+						CreatedBy:   null.Int64From(mockLoginUser.ID),
+					},
+				).Return(mockWorkSchedule, nil)
+			},
+			want:    mockWorkSchedule,
+			wantErr: false,
+		},
+		{
+			name: "Success With Per Minute Penalty",
+			input: dto.CreateWorkScheduleParam{
+				Name:             "Warehouse",
+				WorkDays:         []string{"TUE", "WED", "THU", "FRI", "SAT"},
+				GraceMinutes:     10,
+				PenaltyType:      entity.WorkSchedulePenaltyTypePerMinute,
+				PenaltyPerMinute: entity.NewMoney(2000),
+			},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockWorkScheduleDom.EXPECT().Create(
+					context.Background(),
+					entity.WorkScheduleInputParam{
+						Name:             "Warehouse",
+						WorkDays:         "TUE,WED,THU,FRI,SAT",
+						ShiftStart:       "09:00",
+						ShiftEnd:         "17:00",
+						TimeZone:         entity.DefaultTimeZone,
+						GraceMinutes:     10,
+						PenaltyType:      entity.WorkSchedulePenaltyTypePerMinute,
+						PenaltyPerMinute: entity.NewMoney(2000),
+						CreatedAt:        null.TimeFrom(mockTime),
+						CreatedBy:        null.Int64From(mockLoginUser.ID),
+					},
+				).Return(mockWorkSchedule, nil)
+			},
+			want:    mockWorkSchedule,
+			wantErr: false,
+		},
+		{
+			name:  "Half Day Penalty Without Lates",
+			input: dto.CreateWorkScheduleParam{Name: "Warehouse", WorkDays: []string{"MON"}, PenaltyType: entity.WorkSchedulePenaltyTypeHalfDay},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Invalid Time Zone",
+			input: dto.CreateWorkScheduleParam{Name: "Warehouse", WorkDays: []string{"MON"}, TimeZone: "WIB"},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Invalid Penalty Type",
+			input: dto.CreateWorkScheduleParam{Name: "Warehouse", WorkDays: []string{"MON"}, PenaltyType: "WARNING"},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Invalid Shift Time",
+			input: dto.CreateWorkScheduleParam{Name: "Warehouse", WorkDays: []string{"MON"}, ShiftStart: "9am", ShiftEnd: "17:00"},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Invalid Work Day",
+			input: dto.CreateWorkScheduleParam{Name: "Warehouse", WorkDays: []string{"TUESDAY"}},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "Duplicate Work Day",
+			input: dto.CreateWorkScheduleParam{Name: "Warehouse", WorkDays: []string{"MON", "MON"}},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateWorkSchedule(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("workSchedule.CreateWorkSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_workSchedule_CreateUserWorkSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockWorkScheduleDom := mock_work_schedule.NewMockInterface(ctrl)
+	mockUserWorkScheduleDom := mock_user_work_schedule.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:             mockAuth,
+		WorkSchedule:     mockWorkScheduleDom,
+		UserWorkSchedule: mockUserWorkScheduleDom,
+		User:             mockUserDom,
+	})
+
+	mockTime := time.Now()
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     1,
+		Name:   "Admin",
+		RoleID: entity.RoleIDAdmin,
+	}
+
+	mockUserID := int64(2)
+
+	mockInputParam := dto.CreateUserWorkScheduleParam{
+		WorkScheduleID: 1,
+		EffectiveDate:  null.DateFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	mockUserParam := entity.UserParam{
+		ID:     mockUserID,
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockWorkScheduleParam := entity.WorkScheduleParam{
+		ID: mockInputParam.WorkScheduleID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	mockUserWorkSchedule := entity.UserWorkSchedule{
+		ID:             1,
+		UserID:         mockUserID,
+		WorkScheduleID: mockInputParam.WorkScheduleID,
+		EffectiveDate:  mockInputParam.EffectiveDate,
+	}
+
+	tests := []struct {
+		name     string
+		input    dto.CreateUserWorkScheduleParam
+		mockFunc func()
+		want     entity.UserWorkSchedule
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name:  "Success",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockWorkScheduleDom.EXPECT().Get(context.Background(), mockWorkScheduleParam).Return(entity.WorkSchedule{ID: 1}, nil)
+				mockUserWorkScheduleDom.EXPECT().Create(
+					context.Background(),
+					mockInputParam.ToUserWorkScheduleInputParam(mockUserID, null.TimeFrom(mockTime), mockLoginUser.ID),
+				).Return(mockUserWorkSchedule, nil)
+			},
+			want:    mockUserWorkSchedule,
+			wantErr: false,
+		},
+		{
+			name:  "Missing Effective Date",
+			input: dto.CreateUserWorkScheduleParam{WorkScheduleID: 1},
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+			},
+			wantErr: true,
+			errCode: codes.CodeBadRequest,
+		},
+		{
+			name:  "User Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Work Schedule Not Found",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockWorkScheduleDom.EXPECT().Get(context.Background(), mockWorkScheduleParam).Return(entity.WorkSchedule{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+		{
+			name:  "Already Assigned On Effective Date",
+			input: mockInputParam,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockWorkScheduleDom.EXPECT().Get(context.Background(), mockWorkScheduleParam).Return(entity.WorkSchedule{ID: 1}, nil)
+				mockUserWorkScheduleDom.EXPECT().Create(context.Background(), gomock.Any()).Return(entity.UserWorkSchedule{}, errors.NewWithCode(codes.CodeSQLUniqueConstraint, "duplicate"))
+			},
+			wantErr: true,
+			errCode: codes.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.CreateUserWorkSchedule(context.Background(), mockUserID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("workSchedule.CreateUserWorkSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
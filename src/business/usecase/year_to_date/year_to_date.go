@@ -0,0 +1,108 @@
+package year_to_date
+
+import (
+	"context"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	userDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/user"
+	yearToDateTotalDom "github.com/reyhanmichies/employee-payroll-service/src/business/domain/year_to_date_total"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	GetMyYearToDate(ctx context.Context, year int) (dto.YearToDate, error)
+	GetUserYearToDate(ctx context.Context, userID int64, year int) (dto.YearToDate, error)
+}
+
+type yearToDate struct {
+	auth               auth.Interface
+	yearToDateTotalDom yearToDateTotalDom.Interface
+	userDom            userDom.Interface
+}
+
+type InitParam struct {
+	Auth            auth.Interface
+	YearToDateTotal yearToDateTotalDom.Interface
+	User            userDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	return &yearToDate{
+		auth:               param.Auth,
+		yearToDateTotalDom: param.YearToDateTotal,
+		userDom:            param.User,
+	}
+}
+
+func (y *yearToDate) GetMyYearToDate(ctx context.Context, year int) (dto.YearToDate, error) {
+	loginUser, err := y.auth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return dto.YearToDate{}, err
+	}
+
+	return y.getYearToDate(ctx, loginUser.ID, year)
+}
+
+func (y *yearToDate) GetUserYearToDate(ctx context.Context, userID int64, year int) (dto.YearToDate, error) {
+	_, err := y.userDom.Get(
+		ctx,
+		entity.UserParam{
+			ID:     userID,
+			RoleID: entity.RoleIDUser,
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil {
+		switch errors.GetCode(err) {
+		case codes.CodeSQLRecordDoesNotExist:
+			return dto.YearToDate{}, errors.NewWithCode(codes.CodeNotFound, "user not found")
+		default:
+			return dto.YearToDate{}, err
+		}
+	}
+
+	return y.getYearToDate(ctx, userID, year)
+}
+
+// getYearToDate returns the year to date totals of an employee for a year, defaults to the current year. The
+// totals are zero until a payslip of the year is generated.
+func (y *yearToDate) getYearToDate(ctx context.Context, userID int64, year int) (dto.YearToDate, error) {
+	if year == 0 {
+		year = Now().Year()
+	}
+
+	total, err := y.yearToDateTotalDom.Get(
+		ctx,
+		entity.YearToDateTotalParam{
+			UserID: userID,
+			Year:   int64(year),
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		},
+	)
+	if err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return dto.YearToDate{}, err
+	} else if err != nil {
+		total = entity.YearToDateTotal{}
+	}
+
+	return dto.YearToDate{
+		Year:                 int64(year),
+		Currency:             entity.ReportingCurrency,
+		GrossEarnings:        total.GrossEarnings,
+		TaxDeduction:         total.TaxDeduction,
+		EmployeeContribution: total.EmployeeContribution,
+		EmployerContribution: total.EmployerContribution,
+		TotalTakeHomePay:     total.TotalTakeHomePay,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package year_to_date
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reyhanmichiels/go-pkg/v2/auth"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/query"
+	mock_auth "github.com/reyhanmichiels/go-pkg/v2/tests/mock/auth"
+	mock_user "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/user"
+	mock_year_to_date_total "github.com/reyhanmichies/employee-payroll-service/src/business/domain/mock/year_to_date_total"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_yearToDate_GetMyYearToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockYearToDateTotalDom := mock_year_to_date_total.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:            mockAuth,
+		YearToDateTotal: mockYearToDateTotalDom,
+		User:            mockUserDom,
+	})
+
+	mockTime := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	Now = func() time.Time {
+		return mockTime
+	}
+	defer func() { Now = time.Now }()
+
+	mockLoginUser := auth.User{
+		ID:     2,
+		Name:   "Employee",
+		RoleID: entity.RoleIDUser,
+	}
+
+	mockYearToDateTotal := entity.YearToDateTotal{
+		ID:                   1,
+		UserID:               mockLoginUser.ID,
+		Year:                 2025,
+		GrossEarnings:        entity.NewMoney(36000000),
+		TaxDeduction:         entity.NewMoney(1800000),
+		EmployeeContribution: entity.NewMoney(720000),
+		EmployerContribution: entity.NewMoney(1440000),
+		TotalTakeHomePay:     entity.NewMoney(33480000),
+	}
+
+	tests := []struct {
+		name     string
+		year     int
+		mockFunc func()
+		want     dto.YearToDate
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			year: 2025,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockYearToDateTotalDom.EXPECT().Get(context.Background(), entity.YearToDateTotalParam{
+					UserID: mockLoginUser.ID,
+					Year:   2025,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(mockYearToDateTotal, nil)
+			},
+			want: dto.YearToDate{
+				Year:                 2025,
+				Currency:             entity.ReportingCurrency,
+				GrossEarnings:        entity.NewMoney(36000000),
+				TaxDeduction:         entity.NewMoney(1800000),
+				EmployeeContribution: entity.NewMoney(720000),
+				EmployerContribution: entity.NewMoney(1440000),
+				TotalTakeHomePay:     entity.NewMoney(33480000),
+			},
+		},
+		{
+			name: "Success Defaults To Current Year Without Payslips",
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockYearToDateTotalDom.EXPECT().Get(context.Background(), entity.YearToDateTotalParam{
+					UserID: mockLoginUser.ID,
+					Year:   2026,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(entity.YearToDateTotal{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			want: dto.YearToDate{
+				Year:     2026,
+				Currency: entity.ReportingCurrency,
+			},
+		},
+		{
+			name: "Failed Get Year To Date Total",
+			year: 2025,
+			mockFunc: func() {
+				mockAuth.EXPECT().GetUserAuthInfo(context.Background()).Return(mockLoginUser, nil)
+				mockYearToDateTotalDom.EXPECT().Get(context.Background(), gomock.Any()).Return(entity.YearToDateTotal{}, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetMyYearToDate(context.Background(), tt.year)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("yearToDate.GetMyYearToDate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errCode != 0 {
+					assert.Equal(t, tt.errCode, errors.GetCode(err))
+				}
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_yearToDate_GetUserYearToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockInterface(ctrl)
+	mockYearToDateTotalDom := mock_year_to_date_total.NewMockInterface(ctrl)
+	mockUserDom := mock_user.NewMockInterface(ctrl)
+
+	uc := Init(InitParam{
+		Auth:            mockAuth,
+		YearToDateTotal: mockYearToDateTotalDom,
+		User:            mockUserDom,
+	})
+
+	mockUserID := int64(2)
+
+	mockUserParam := entity.UserParam{
+		ID:     mockUserID,
+		RoleID: entity.RoleIDUser,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     dto.YearToDate
+		wantErr  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{ID: mockUserID}, nil)
+				mockYearToDateTotalDom.EXPECT().Get(context.Background(), entity.YearToDateTotalParam{
+					UserID: mockUserID,
+					Year:   2025,
+					QueryOption: query.Option{
+						IsActive: true,
+					},
+				}).Return(entity.YearToDateTotal{ID: 1, UserID: mockUserID, Year: 2025, GrossEarnings: entity.NewMoney(5000000)}, nil)
+			},
+			want: dto.YearToDate{
+				Year:          2025,
+				Currency:      entity.ReportingCurrency,
+				GrossEarnings: entity.NewMoney(5000000),
+			},
+		},
+		{
+			name: "User Not Found",
+			mockFunc: func() {
+				mockUserDom.EXPECT().Get(context.Background(), mockUserParam).Return(entity.User{}, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "not found"))
+			},
+			wantErr: true,
+			errCode: codes.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			got, err := uc.GetUserYearToDate(context.Background(), mockUserID, 2025)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("yearToDate.GetUserYearToDate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Equal(t, tt.errCode, errors.GetCode(err))
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
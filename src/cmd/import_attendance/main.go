@@ -0,0 +1,99 @@
+// Command import_attendance imports a daily log exported by a biometric device and prints the import report, e.g.
+//
+//	go run ./src/cmd/import_attendance -file ./logs/2025-06-09.csv -format CSV
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/reyhanmichiels/go-pkg/v2/configreader"
+	"github.com/reyhanmichiels/go-pkg/v2/files"
+	"github.com/reyhanmichiels/go-pkg/v2/log"
+	"github.com/reyhanmichiels/go-pkg/v2/parser"
+	"github.com/reyhanmichiels/go-pkg/v2/redis"
+	"github.com/reyhanmichiels/go-pkg/v2/sql"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/domain"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/usecase/attendance"
+	"github.com/reyhanmichies/employee-payroll-service/src/utils/config"
+)
+
+const (
+	configfile string = "./etc/cfg/conf.json"
+)
+
+func main() {
+	defaultLogger := log.DefaultLogger()
+
+	filePath := flag.String("file", "", "path of the device log to import")
+	format := flag.String("format", "CSV", "format of the device log, CSV or FIXED_WIDTH")
+	flag.Parse()
+
+	if *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if !files.IsExist(configfile) {
+		defaultLogger.Fatal(context.Background(), errors.New("config file doesn't exist"))
+	}
+
+	content, err := os.ReadFile(*filePath)
+	if err != nil {
+		defaultLogger.Fatal(context.Background(), err)
+	}
+
+	// read config from config file
+	cfg := config.Init()
+	configReader := configreader.Init(configreader.Options{
+		ConfigFile: configfile,
+	})
+	configReader.ReadConfig(&cfg)
+
+	// init logger
+	log := log.Init(cfg.Log)
+
+	// init cache
+	cache := redis.Init(cfg.Redis, log)
+
+	// init db
+	db := sql.Init(cfg.SQL, log)
+
+	// init parser
+	parser := parser.InitParser(log, cfg.Parser)
+
+	// init domain
+	dom := domain.Init(domain.InitParam{Log: log, Db: db, Redis: cache, Json: parser.JSONParser()})
+
+	// init usecase, the command imports as the system so it needs no auth
+	uc := attendance.Init(attendance.InitParam{
+		AttendancePeriod: dom.AttendancePeriod,
+		Attendance:       dom.Attendance,
+		Holiday:          dom.Holiday,
+		User:             dom.User,
+		WorkSchedule:     dom.WorkSchedule,
+		UserWorkSchedule: dom.UserWorkSchedule,
+	})
+
+	// a failed import still reports the lines imported before the failure
+	report, importErr := uc.ImportAttendancesCommand(context.Background(), dto.ImportAttendanceParam{
+		Format:  *format,
+		Content: string(content),
+	})
+
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatal(context.Background(), err)
+	}
+
+	fmt.Println(string(output))
+
+	if importErr != nil {
+		log.Fatal(context.Background(), importErr)
+	}
+}
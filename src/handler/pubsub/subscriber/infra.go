@@ -35,6 +35,9 @@ var MQQueue = []Queue{
 	{
 		Name: entity.QueuePayrollCalculation,
 	},
+	{
+		Name: entity.QueuePayrollPreview,
+	},
 }
 
 var MQQueueBind = []QueueBind{
@@ -43,6 +46,11 @@ var MQQueueBind = []QueueBind{
 		QueueName:    entity.QueuePayrollCalculation,
 		RoutingKey:   entity.RoutingKeyPayrollCalculate,
 	},
+	{
+		ExchangeName: entity.ExchangePayrollEvent,
+		QueueName:    entity.QueuePayrollPreview,
+		RoutingKey:   entity.RoutingKeyPayrollPreview,
+	},
 }
 
 func (s *subscriber) setupInfra() error {
@@ -93,6 +101,7 @@ func (s *subscriber) setupInfra() error {
 
 func (s *subscriber) assignEvent() {
 	s.assignEventHandler(pubsub.GetEvent(entity.ExchangePayrollEvent, entity.RoutingKeyPayrollCalculate), s.uc.AttendancePeriod.PubSubGeneratePayroll)
+	s.assignEventHandler(pubsub.GetEvent(entity.ExchangePayrollEvent, entity.RoutingKeyPayrollPreview), s.uc.AttendancePeriod.PubSubPreviewPayroll)
 }
 
 func (s *subscriber) assignEventHandler(event string, handler handlerFunc) {
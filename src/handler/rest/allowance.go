@@ -0,0 +1,173 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetAllowanceTypes godoc
+// @Summary Get Allowance Types
+// @Description Get every active allowance type of the catalogue
+// @Tags Allowance
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.AllowanceType{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/allowance-types [GET]
+func (r *rest) GetAllowanceTypes(ctx *gin.Context) {
+	data, err := r.uc.Allowance.GetAllowanceTypes(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateAllowanceType godoc
+// @Summary Create Allowance Type
+// @Description Add an allowance type to the catalogue
+// @Tags Allowance
+// @Security BearerAuth
+// @Param data body dto.CreateAllowanceTypeParam true "Allowance Type Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.AllowanceType{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/allowance-types [POST]
+func (r *rest) CreateAllowanceType(ctx *gin.Context) {
+	var param dto.CreateAllowanceTypeParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.Allowance.CreateAllowanceType(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// GetUserAllowances godoc
+// @Summary Get User Allowances
+// @Description Get every allowance assigned to an employee, latest effective date first
+// @Tags Allowance
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.UserAllowance{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/allowances [GET]
+func (r *rest) GetUserAllowances(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.Allowance.GetUserAllowances(ctx.Request.Context(), userID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateUserAllowance godoc
+// @Summary Create User Allowance
+// @Description Assign a recurring allowance to an employee from an effective date
+// @Tags Allowance
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param data body dto.CreateUserAllowanceParam true "User Allowance Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.UserAllowance{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/allowances [POST]
+func (r *rest) CreateUserAllowance(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.CreateUserAllowanceParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.Allowance.CreateUserAllowance(ctx.Request.Context(), userID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// EndUserAllowance godoc
+// @Summary End User Allowance
+// @Description Stop paying an allowance to an employee after an end date
+// @Tags Allowance
+// @Security BearerAuth
+// @Param user_allowance_id path int true "User Allowance ID"
+// @Param data body dto.EndUserAllowanceParam true "End Date Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/user-allowances/{user_allowance_id}/end [PUT]
+func (r *rest) EndUserAllowance(ctx *gin.Context) {
+	userAllowanceIDStr := ctx.Param("user_allowance_id")
+	if userAllowanceIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_allowance_id is empty"))
+		return
+	}
+
+	userAllowanceID, err := strconv.ParseInt(userAllowanceIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_allowance_id is not a valid number"))
+		return
+	}
+
+	var param dto.EndUserAllowanceParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err = r.uc.Allowance.EndUserAllowance(ctx.Request.Context(), userAllowanceID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
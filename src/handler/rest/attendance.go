@@ -1,27 +1,138 @@
 package rest
 
 import (
+	"strconv"
+
 	"github.com/gin-gonic/gin"
 	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
 )
 
-// SubmitAttendance godoc
-// @Summary Submit Attendance
-// @Description Submit attendance for a user
+// CheckInAttendance godoc
+// @Summary Check In Attendance
+// @Description Check in the attendance of the logged in user for today, from within an allowed office location once office locations are defined
 // @Tags Attendance
 // @Security BearerAuth
+// @Param data body dto.CheckInParam false "Check-In Attendance Type and Coordinates"
 // @Produce json
-// @Success 201 {object} entity.HTTPResp{}
+// @Success 201 {object} entity.HTTPResp{data=dto.AttendanceRecord{}}
 // @Failure 400 {object} entity.HTTPResp{}
 // @Failure 409 {object} entity.HTTPResp{}
 // @Failure 500 {object} entity.HTTPResp{}
-// @Router /v1/attendances [POST]
-func (r *rest) SubmitAttendance(ctx *gin.Context) {
-	err := r.uc.Attendance.Create(ctx.Request.Context())
+// @Router /v1/attendances/check-in [POST]
+func (r *rest) CheckInAttendance(ctx *gin.Context) {
+	// the coordinates are optional, so an attendance can still be submitted without a body
+	var param dto.CheckInParam
+	if ctx.Request.ContentLength != 0 {
+		if err := r.Bind(ctx, &param); err != nil {
+			r.httpRespError(ctx, err)
+			return
+		}
+	}
+
+	data, err := r.uc.Attendance.CheckIn(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// CheckOutAttendance godoc
+// @Summary Check Out Attendance
+// @Description Check out the attendance of the logged in user for today, recording the hours worked since the check-in
+// @Tags Attendance
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.AttendanceRecord{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/attendances/check-out [POST]
+func (r *rest) CheckOutAttendance(ctx *gin.Context) {
+	data, err := r.uc.Attendance.CheckOut(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// GetMyAttendances godoc
+// @Summary Get My Attendances
+// @Description Get the days the logged in user was recorded as present, the latest first, with how many days they attended in each attendance period
+// @Tags Attendance
+// @Security BearerAuth
+// @Param attendance_period_id query int false "Attendance Period ID"
+// @Param start_date query string false "Start Date (YYYY-MM-DD)"
+// @Param end_date query string false "End Date (YYYY-MM-DD)"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.AttendanceHistory{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/attendances [GET]
+func (r *rest) GetMyAttendances(ctx *gin.Context) {
+	var param dto.GetAttendancesParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, pg, err := r.uc.Attendance.GetMyAttendances(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, pg)
+}
+
+// GetUserAttendances godoc
+// @Summary Get User Attendances
+// @Description Get the days an employee was recorded as present, the latest first, with how many days they attended in each attendance period
+// @Tags Attendance
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param attendance_period_id query int false "Attendance Period ID"
+// @Param start_date query string false "Start Date (YYYY-MM-DD)"
+// @Param end_date query string false "End Date (YYYY-MM-DD)"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.AttendanceHistory{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/attendances [GET]
+func (r *rest) GetUserAttendances(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.GetAttendancesParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, pg, err := r.uc.Attendance.GetUserAttendances(ctx.Request.Context(), userID, param)
 	if err != nil {
 		r.httpRespError(ctx, err)
 		return
 	}
 
-	r.httpRespSuccess(ctx, codes.CodeCreated, nil, nil)
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, pg)
 }
@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// CreateAttendanceCorrection godoc
+// @Summary Create Attendance Correction
+// @Description Request the attendance of a past workday the logged in user did not check in on to be recorded, the attendance period of the workday must not be processed yet
+// @Tags Attendance Correction
+// @Security BearerAuth
+// @Param data body dto.CreateAttendanceCorrectionParam true "Attendance Correction Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.AttendanceCorrection{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/attendance-corrections [POST]
+func (r *rest) CreateAttendanceCorrection(ctx *gin.Context) {
+	var param dto.CreateAttendanceCorrectionParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.Attendance.CreateCorrection(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// GetMyAttendanceCorrections godoc
+// @Summary Get My Attendance Corrections
+// @Description Get the attendance corrections the logged in user requested, the latest workday first
+// @Tags Attendance Correction
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.AttendanceCorrection{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/attendance-corrections [GET]
+func (r *rest) GetMyAttendanceCorrections(ctx *gin.Context) {
+	data, err := r.uc.Attendance.GetMyCorrections(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// GetAttendanceCorrections godoc
+// @Summary Get Attendance Corrections
+// @Description Get the attendance corrections of every employee to review
+// @Tags Attendance Correction
+// @Security BearerAuth
+// @Param status query string false "Correction Status (PENDING, APPROVED or REJECTED)"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.AttendanceCorrection{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-corrections [GET]
+func (r *rest) GetAttendanceCorrections(ctx *gin.Context) {
+	data, err := r.uc.Attendance.GetCorrections(ctx.Request.Context(), ctx.Query("status"))
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// ApproveAttendanceCorrection godoc
+// @Summary Approve Attendance Correction
+// @Description Approve a pending attendance correction, its attendance is recorded on behalf of the employee
+// @Tags Attendance Correction
+// @Security BearerAuth
+// @Param attendance_correction_id path int true "Attendance Correction ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.AttendanceCorrection{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-corrections/{attendance_correction_id}/approve [PUT]
+func (r *rest) ApproveAttendanceCorrection(ctx *gin.Context) {
+	attendanceCorrectionIDStr := ctx.Param("attendance_correction_id")
+	if attendanceCorrectionIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_correction_id is empty"))
+		return
+	}
+
+	attendanceCorrectionID, err := strconv.ParseInt(attendanceCorrectionIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_correction_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.Attendance.ApproveCorrection(ctx.Request.Context(), attendanceCorrectionID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// RejectAttendanceCorrection godoc
+// @Summary Reject Attendance Correction
+// @Description Reject a pending attendance correction with a note for the employee
+// @Tags Attendance Correction
+// @Security BearerAuth
+// @Param attendance_correction_id path int true "Attendance Correction ID"
+// @Param data body dto.RejectAttendanceCorrectionParam true "Rejection Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.AttendanceCorrection{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-corrections/{attendance_correction_id}/reject [PUT]
+func (r *rest) RejectAttendanceCorrection(ctx *gin.Context) {
+	attendanceCorrectionIDStr := ctx.Param("attendance_correction_id")
+	if attendanceCorrectionIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_correction_id is empty"))
+		return
+	}
+
+	attendanceCorrectionID, err := strconv.ParseInt(attendanceCorrectionIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_correction_id is not a valid number"))
+		return
+	}
+
+	var param dto.RejectAttendanceCorrectionParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.Attendance.RejectCorrection(ctx.Request.Context(), attendanceCorrectionID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
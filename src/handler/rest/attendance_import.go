@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// maxAttendanceImportFileSize is the largest device log accepted by the attendance import, 10 MB.
+const maxAttendanceImportFileSize = 10 << 20
+
+// ImportAttendances godoc
+// @Summary Import Attendances
+// @Description Import the daily log exported by a biometric device, every line is the attendance of an employee on a day. The report tells which lines were accepted, which were already recorded and why the other lines were rejected. When the import fails part way, the lines imported before the failure are kept and are reported as duplicates when the same log is imported again
+// @Tags Attendance
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Param file formData file true "Device Log"
+// @Param format formData string false "Device Log Format (CSV or FIXED_WIDTH), CSV by default"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.AttendanceImportReport{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendances/import [POST]
+func (r *rest) ImportAttendances(ctx *gin.Context) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "file is required"))
+		return
+	}
+
+	if fileHeader.Size > maxAttendanceImportFileSize {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "file cannot exceed 10 MB"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "file cannot be opened"))
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "file cannot be read"))
+		return
+	}
+
+	param := dto.ImportAttendanceParam{
+		Format:  ctx.PostForm("format"),
+		Content: string(content),
+	}
+
+	data, err := r.uc.Attendance.ImportAttendances(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// UpdateUserDevice godoc
+// @Summary Update User Device
+// @Description Map an employee to the user ID the biometric devices know them by, so their device logs can be imported
+// @Tags Attendance
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param data body dto.UpdateUserDeviceParam true "Device Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/device [PUT]
+func (r *rest) UpdateUserDevice(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.UpdateUserDeviceParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err = r.uc.Attendance.UpdateUserDevice(ctx.Request.Context(), userID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
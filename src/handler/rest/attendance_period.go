@@ -7,6 +7,7 @@ import (
 	"github.com/reyhanmichiels/go-pkg/v2/codes"
 	"github.com/reyhanmichiels/go-pkg/v2/errors"
 	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/entity"
 )
 
 // CreateAttendancePeriod godoc
@@ -139,3 +140,293 @@ func (r *rest) GeneratePayslipSummary(ctx *gin.Context) {
 
 	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
 }
+
+// PreviewPayroll godoc
+// @Summary Preview Payroll
+// @Description Calculate the payroll of a closed attendance period without writing any payslip.
+// @Description Large payrolls are previewed in the background, the response then only carries the preview id to download the snapshot with.
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param attendance_period_id path int true "Attendance Period ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.PayrollPreview{}}
+// @Success 202 {object} entity.HTTPResp{data=dto.PayrollPreview{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-periods/{attendance_period_id}/payroll/preview [POST]
+func (r *rest) PreviewPayroll(ctx *gin.Context) {
+	attendancePeriodIDStr := ctx.Param("attendance_period_id")
+	if attendancePeriodIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is empty"))
+		return
+	}
+
+	attendancePeriodID, err := strconv.ParseInt(attendancePeriodIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.AttendancePeriod.PreviewPayroll(ctx.Request.Context(), attendancePeriodID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if data.PreviewStatus == entity.PayrollPreviewStatusPending {
+		r.httpRespSuccess(ctx, codes.CodeAccepted, data, nil)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// GetPayrollPreview godoc
+// @Summary Get Payroll Preview
+// @Description Get a stored payroll preview snapshot, the employees are filled in once the preview is completed
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param payroll_preview_id path int true "Payroll Preview ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.PayrollPreview{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/payroll-previews/{payroll_preview_id} [GET]
+func (r *rest) GetPayrollPreview(ctx *gin.Context) {
+	payrollPreviewIDStr := ctx.Param("payroll_preview_id")
+	if payrollPreviewIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_preview_id is empty"))
+		return
+	}
+
+	payrollPreviewID, err := strconv.ParseInt(payrollPreviewIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_preview_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.AttendancePeriod.GetPayrollPreview(ctx.Request.Context(), payrollPreviewID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// RerunPayroll godoc
+// @Summary Re-run Payroll
+// @Description Void the current payroll run of a processed attendance period and generate the next version of its payslips.
+// @Description The payslips of the voided run are kept as history.
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param attendance_period_id path int true "Attendance Period ID"
+// @Param data body dto.RerunPayrollParam true "Re-run Payroll Data"
+// @Produce json
+// @Success 202 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-periods/{attendance_period_id}/payroll/rerun [POST]
+func (r *rest) RerunPayroll(ctx *gin.Context) {
+	attendancePeriodIDStr := ctx.Param("attendance_period_id")
+	if attendancePeriodIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is empty"))
+		return
+	}
+
+	attendancePeriodID, err := strconv.ParseInt(attendancePeriodIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is not a valid number"))
+		return
+	}
+
+	var param dto.RerunPayrollParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err = r.uc.AttendancePeriod.RerunPayroll(ctx.Request.Context(), attendancePeriodID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeAccepted, nil, nil)
+}
+
+// GetPayrollStatus godoc
+// @Summary Get Payroll Status
+// @Description Get the state, progress, start and end time, and failed employees of the latest payroll run of a specific attendance period
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param attendance_period_id path int true "Attendance Period ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.PayrollStatus{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-periods/{attendance_period_id}/payroll/status [GET]
+func (r *rest) GetPayrollStatus(ctx *gin.Context) {
+	attendancePeriodIDStr := ctx.Param("attendance_period_id")
+	if attendancePeriodIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is empty"))
+		return
+	}
+
+	attendancePeriodID, err := strconv.ParseInt(attendancePeriodIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.AttendancePeriod.GetPayrollStatus(ctx.Request.Context(), attendancePeriodID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// GetPayrollRuns godoc
+// @Summary Get Payroll Runs
+// @Description Get every payroll run of a specific attendance period, latest version first
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param attendance_period_id path int true "Attendance Period ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.PayrollRun{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-periods/{attendance_period_id}/payroll-runs [GET]
+func (r *rest) GetPayrollRuns(ctx *gin.Context) {
+	attendancePeriodIDStr := ctx.Param("attendance_period_id")
+	if attendancePeriodIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is empty"))
+		return
+	}
+
+	attendancePeriodID, err := strconv.ParseInt(attendancePeriodIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.AttendancePeriod.GetPayrollRuns(ctx.Request.Context(), attendancePeriodID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// GeneratePayrollRunPayslipSummary godoc
+// @Summary Generate Payroll Run Payslip Summary
+// @Description Generate payslip summary of a specific payroll run, including voided runs
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param payroll_run_id path int true "Payroll Run ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.PayslipSummary{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/payroll-runs/{payroll_run_id}/payslip-summary [GET]
+func (r *rest) GeneratePayrollRunPayslipSummary(ctx *gin.Context) {
+	payrollRunIDStr := ctx.Param("payroll_run_id")
+	if payrollRunIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_run_id is empty"))
+		return
+	}
+
+	payrollRunID, err := strconv.ParseInt(payrollRunIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_run_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.AttendancePeriod.GeneratePayrollRunPayslipSummary(ctx.Request.Context(), payrollRunID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// ResumePayrollRun godoc
+// @Summary Resume Payroll Run
+// @Description Resume a failed payroll run, only the employees without a payslip in the run are calculated again
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param payroll_run_id path int true "Payroll Run ID"
+// @Produce json
+// @Success 202 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/payroll-runs/{payroll_run_id}/resume [POST]
+func (r *rest) ResumePayrollRun(ctx *gin.Context) {
+	payrollRunIDStr := ctx.Param("payroll_run_id")
+	if payrollRunIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_run_id is empty"))
+		return
+	}
+
+	payrollRunID, err := strconv.ParseInt(payrollRunIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_run_id is not a valid number"))
+		return
+	}
+
+	err = r.uc.AttendancePeriod.ResumePayrollRun(ctx.Request.Context(), payrollRunID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeAccepted, nil, nil)
+}
+
+// GetPayrollRunEmployees godoc
+// @Summary Get Payroll Run Employees
+// @Description Get whether the payslip of every employee in a specific payroll run succeeded or failed
+// @Tags Attendance Period
+// @Security BearerAuth
+// @Param payroll_run_id path int true "Payroll Run ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.PayrollRunEmployee{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/payroll-runs/{payroll_run_id}/employees [GET]
+func (r *rest) GetPayrollRunEmployees(ctx *gin.Context) {
+	payrollRunIDStr := ctx.Param("payroll_run_id")
+	if payrollRunIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_run_id is empty"))
+		return
+	}
+
+	payrollRunID, err := strconv.ParseInt(payrollRunIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_run_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.AttendancePeriod.GetPayrollRunEmployees(ctx.Request.Context(), payrollRunID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetAttendanceTypes godoc
+// @Summary Get Attendance Types
+// @Description Get every active attendance type an attendance can be submitted with, along with its pay treatment
+// @Tags Attendance
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.AttendanceType{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/attendance-types [GET]
+func (r *rest) GetAttendanceTypes(ctx *gin.Context) {
+	data, err := r.uc.Attendance.GetAttendanceTypes(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// UpdateAttendanceType godoc
+// @Summary Update Attendance Type
+// @Description Change the daily allowance or the days paid per period of an attendance type, it applies to every payroll run afterwards
+// @Tags Attendance
+// @Security BearerAuth
+// @Param attendance_type_id path int true "Attendance Type ID"
+// @Param data body dto.UpdateAttendanceTypeParam true "Attendance Type Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-types/{attendance_type_id} [PUT]
+func (r *rest) UpdateAttendanceType(ctx *gin.Context) {
+	attendanceTypeIDStr := ctx.Param("attendance_type_id")
+	if attendanceTypeIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_type_id is empty"))
+		return
+	}
+
+	attendanceTypeID, err := strconv.ParseInt(attendanceTypeIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_type_id is not a valid number"))
+		return
+	}
+
+	var param dto.UpdateAttendanceTypeParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err = r.uc.Attendance.UpdateAttendanceType(ctx.Request.Context(), attendanceTypeID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
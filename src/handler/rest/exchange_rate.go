@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetExchangeRates godoc
+// @Summary Get Exchange Rates
+// @Description Get the latest rate of every currency fixed on or before a date, defaults to today
+// @Tags Exchange Rate
+// @Security BearerAuth
+// @Param fixing_date query string false "Fixing Date (YYYY-MM-DD)"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.ExchangeRate{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/exchange-rates [GET]
+func (r *rest) GetExchangeRates(ctx *gin.Context) {
+	var fixingDate null.Date
+	if fixingDateStr := ctx.Query("fixing_date"); fixingDateStr != "" {
+		date, err := time.Parse(time.DateOnly, fixingDateStr)
+		if err != nil {
+			r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "fixing_date must be formatted as YYYY-MM-DD"))
+			return
+		}
+
+		fixingDate = null.DateFrom(date)
+	}
+
+	data, err := r.uc.ExchangeRate.GetExchangeRates(ctx.Request.Context(), fixingDate)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateExchangeRates godoc
+// @Summary Create Exchange Rates
+// @Description Fix the rates of currencies against the reporting currency on a date
+// @Tags Exchange Rate
+// @Security BearerAuth
+// @Param data body dto.CreateExchangeRatesParam true "Exchange Rates Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/exchange-rates [POST]
+func (r *rest) CreateExchangeRates(ctx *gin.Context) {
+	var param dto.CreateExchangeRatesParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err := r.uc.ExchangeRate.CreateExchangeRates(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, nil, nil)
+}
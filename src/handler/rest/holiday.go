@@ -0,0 +1,146 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetHolidays godoc
+// @Summary Get Holidays
+// @Description Get the public holidays of a year, defaults to the current year
+// @Tags Holiday
+// @Security BearerAuth
+// @Param year query int false "Year"
+// @Param region query string false "Region, the nationwide holidays are always included"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Holiday{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/holidays [GET]
+func (r *rest) GetHolidays(ctx *gin.Context) {
+	var year int
+	if yearStr := ctx.Query("year"); yearStr != "" {
+		parsedYear, err := strconv.Atoi(yearStr)
+		if err != nil || parsedYear <= 0 {
+			r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "year is not a valid year"))
+			return
+		}
+
+		year = parsedYear
+	}
+
+	data, err := r.uc.Holiday.GetHolidays(ctx.Request.Context(), year, ctx.Query("region"))
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateHolidays godoc
+// @Summary Create Holidays
+// @Description Import a batch of nationwide or regional public holidays into the holiday calendar
+// @Tags Holiday
+// @Security BearerAuth
+// @Param data body dto.CreateHolidaysParam true "Holidays Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/holidays [POST]
+func (r *rest) CreateHolidays(ctx *gin.Context) {
+	var param dto.CreateHolidaysParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err := r.uc.Holiday.CreateHolidays(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, nil, nil)
+}
+
+// DeleteHoliday godoc
+// @Summary Delete Holiday
+// @Description Remove a public holiday from the holiday calendar
+// @Tags Holiday
+// @Security BearerAuth
+// @Param holiday_id path int true "Holiday ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/holidays/{holiday_id} [DELETE]
+func (r *rest) DeleteHoliday(ctx *gin.Context) {
+	holidayIDStr := ctx.Param("holiday_id")
+	if holidayIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "holiday_id is empty"))
+		return
+	}
+
+	holidayID, err := strconv.ParseInt(holidayIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "holiday_id is not a valid number"))
+		return
+	}
+
+	err = r.uc.Holiday.DeleteHoliday(ctx.Request.Context(), holidayID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// UpdateUserRegion godoc
+// @Summary Update User Region
+// @Description Update the region whose holidays an employee observes on top of the nationwide holidays
+// @Tags Holiday
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param data body dto.UpdateUserRegionParam true "Region Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/region [PUT]
+func (r *rest) UpdateUserRegion(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.UpdateUserRegionParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err = r.uc.Holiday.UpdateUserRegion(ctx.Request.Context(), userID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
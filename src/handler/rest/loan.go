@@ -0,0 +1,118 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetUserLoans godoc
+// @Summary Get User Loans
+// @Description Get every loan and salary advance given to an employee with its remaining balance
+// @Tags Loan
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Loan{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/loans [GET]
+func (r *rest) GetUserLoans(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.Loan.GetUserLoans(ctx.Request.Context(), userID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateLoan godoc
+// @Summary Create Loan
+// @Description Give an employee a loan or salary advance repaid by instalments withheld from their payroll
+// @Tags Loan
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param data body dto.CreateLoanParam true "Loan Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.Loan{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/loans [POST]
+func (r *rest) CreateLoan(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.CreateLoanParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.Loan.CreateLoan(ctx.Request.Context(), userID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// GetLoanRepayments godoc
+// @Summary Get Loan Repayments
+// @Description Get every instalment withheld by payroll for a loan, including the ones reversed by a payroll rerun
+// @Tags Loan
+// @Security BearerAuth
+// @Param loan_id path int true "Loan ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.LoanRepayment{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/loans/{loan_id}/repayments [GET]
+func (r *rest) GetLoanRepayments(ctx *gin.Context) {
+	loanIDStr := ctx.Param("loan_id")
+	if loanIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "loan_id is empty"))
+		return
+	}
+
+	loanID, err := strconv.ParseInt(loanIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "loan_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.Loan.GetLoanRepayments(ctx.Request.Context(), loanID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
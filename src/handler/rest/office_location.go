@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetOfficeLocations godoc
+// @Summary Get Office Locations
+// @Description Get the office locations employees can check in at
+// @Tags Office Location
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.OfficeLocation{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/office-locations [GET]
+func (r *rest) GetOfficeLocations(ctx *gin.Context) {
+	data, err := r.uc.OfficeLocation.GetOfficeLocations(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateOfficeLocation godoc
+// @Summary Create Office Location
+// @Description Create an office location, a check-in is only accepted within the radius of an office location
+// @Tags Office Location
+// @Security BearerAuth
+// @Param data body dto.CreateOfficeLocationParam true "Office Location Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.OfficeLocation{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/office-locations [POST]
+func (r *rest) CreateOfficeLocation(ctx *gin.Context) {
+	var param dto.CreateOfficeLocationParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.OfficeLocation.CreateOfficeLocation(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// GetUserOfficeLocations godoc
+// @Summary Get User Office Locations
+// @Description Get the office locations an employee is allowed to check in at
+// @Tags Office Location
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.UserOfficeLocation{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/office-locations [GET]
+func (r *rest) GetUserOfficeLocations(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.OfficeLocation.GetUserOfficeLocations(ctx.Request.Context(), userID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateUserOfficeLocation godoc
+// @Summary Create User Office Location
+// @Description Allow an employee to check in at an office location, once assigned the employee can only check in at their assigned office locations
+// @Tags Office Location
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param data body dto.CreateUserOfficeLocationParam true "User Office Location Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.UserOfficeLocation{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/office-locations [POST]
+func (r *rest) CreateUserOfficeLocation(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.CreateUserOfficeLocationParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.OfficeLocation.CreateUserOfficeLocation(ctx.Request.Context(), userID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// DeleteUserOfficeLocation godoc
+// @Summary Delete User Office Location
+// @Description Remove an office location from the office locations an employee is allowed to check in at
+// @Tags Office Location
+// @Security BearerAuth
+// @Param user_office_location_id path int true "User Office Location ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/user-office-locations/{user_office_location_id} [DELETE]
+func (r *rest) DeleteUserOfficeLocation(ctx *gin.Context) {
+	userOfficeLocationIDStr := ctx.Param("user_office_location_id")
+	if userOfficeLocationIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_office_location_id is empty"))
+		return
+	}
+
+	userOfficeLocationID, err := strconv.ParseInt(userOfficeLocationIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_office_location_id is not a valid number"))
+		return
+	}
+
+	err = r.uc.OfficeLocation.DeleteUserOfficeLocation(ctx.Request.Context(), userOfficeLocationID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetPayComponentList godoc
+// @Summary Get Pay Component List
+// @Description Get every pay component in payroll evaluation order
+// @Tags Pay Component
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.PayComponent{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/pay-components [GET]
+func (r *rest) GetPayComponentList(ctx *gin.Context) {
+	data, err := r.uc.PayComponent.GetList(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// UpdatePayComponent godoc
+// @Summary Update Pay Component
+// @Description Update the evaluation order, config or status of a pay component
+// @Tags Pay Component
+// @Security BearerAuth
+// @Param pay_component_id path int true "Pay Component ID"
+// @Param data body dto.UpdatePayComponentParam true "Pay Component Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/pay-components/{pay_component_id} [PUT]
+func (r *rest) UpdatePayComponent(ctx *gin.Context) {
+	payComponentIDStr := ctx.Param("pay_component_id")
+	if payComponentIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "pay_component_id is empty"))
+		return
+	}
+
+	payComponentID, err := strconv.ParseInt(payComponentIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "pay_component_id is not a valid number"))
+		return
+	}
+
+	var param dto.UpdatePayComponentParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err = r.uc.PayComponent.Update(ctx.Request.Context(), payComponentID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
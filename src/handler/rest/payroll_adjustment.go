@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetPayrollAdjustments godoc
+// @Summary Get Payroll Adjustments
+// @Description Get every active one-off adjustment attached to an attendance period
+// @Tags Payroll Adjustment
+// @Security BearerAuth
+// @Param attendance_period_id path int true "Attendance Period ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.PayrollAdjustment{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-periods/{attendance_period_id}/adjustments [GET]
+func (r *rest) GetPayrollAdjustments(ctx *gin.Context) {
+	attendancePeriodIDStr := ctx.Param("attendance_period_id")
+	if attendancePeriodIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is empty"))
+		return
+	}
+
+	attendancePeriodID, err := strconv.ParseInt(attendancePeriodIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.PayrollAdjustment.GetPayrollAdjustments(ctx.Request.Context(), attendancePeriodID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreatePayrollAdjustment godoc
+// @Summary Create Payroll Adjustment
+// @Description Attach a one-off earning or deduction of an employee to an attendance period before its payroll is processed
+// @Tags Payroll Adjustment
+// @Security BearerAuth
+// @Param attendance_period_id path int true "Attendance Period ID"
+// @Param data body dto.CreatePayrollAdjustmentParam true "Payroll Adjustment Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.PayrollAdjustment{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/attendance-periods/{attendance_period_id}/adjustments [POST]
+func (r *rest) CreatePayrollAdjustment(ctx *gin.Context) {
+	attendancePeriodIDStr := ctx.Param("attendance_period_id")
+	if attendancePeriodIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is empty"))
+		return
+	}
+
+	attendancePeriodID, err := strconv.ParseInt(attendancePeriodIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "attendance_period_id is not a valid number"))
+		return
+	}
+
+	var param dto.CreatePayrollAdjustmentParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.PayrollAdjustment.CreatePayrollAdjustment(ctx.Request.Context(), attendancePeriodID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// CancelPayrollAdjustment godoc
+// @Summary Cancel Payroll Adjustment
+// @Description Cancel a one-off adjustment before the payroll of its attendance period is processed
+// @Tags Payroll Adjustment
+// @Security BearerAuth
+// @Param payroll_adjustment_id path int true "Payroll Adjustment ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/payroll-adjustments/{payroll_adjustment_id} [DELETE]
+func (r *rest) CancelPayrollAdjustment(ctx *gin.Context) {
+	payrollAdjustmentIDStr := ctx.Param("payroll_adjustment_id")
+	if payrollAdjustmentIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_adjustment_id is empty"))
+		return
+	}
+
+	payrollAdjustmentID, err := strconv.ParseInt(payrollAdjustmentIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "payroll_adjustment_id is not a valid number"))
+		return
+	}
+
+	err = r.uc.PayrollAdjustment.CancelPayrollAdjustment(ctx.Request.Context(), payrollAdjustmentID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
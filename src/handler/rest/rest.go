@@ -136,11 +136,96 @@ func (r *rest) Register() {
 	// attendance period
 	v1.POST("/admin/attendance-periods", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateAttendancePeriod))
 	v1.POST("/admin/attendance-periods/:attendance_period_id/payroll", r.AuthorizeScope(entity.RoleIDAdmin, r.GeneratePayroll))
+	v1.POST("/admin/attendance-periods/:attendance_period_id/payroll/preview", r.AuthorizeScope(entity.RoleIDAdmin, r.PreviewPayroll))
+	v1.POST("/admin/attendance-periods/:attendance_period_id/payroll/rerun", r.AuthorizeScope(entity.RoleIDAdmin, r.RerunPayroll))
+	v1.GET("/admin/attendance-periods/:attendance_period_id/payroll/status", r.AuthorizeScope(entity.RoleIDAdmin, r.GetPayrollStatus))
+	v1.GET("/admin/attendance-periods/:attendance_period_id/payroll-runs", r.AuthorizeScope(entity.RoleIDAdmin, r.GetPayrollRuns))
+	v1.GET("/admin/payroll-runs/:payroll_run_id/payslip-summary", r.AuthorizeScope(entity.RoleIDAdmin, r.GeneratePayrollRunPayslipSummary))
+	v1.GET("/admin/payroll-runs/:payroll_run_id/employees", r.AuthorizeScope(entity.RoleIDAdmin, r.GetPayrollRunEmployees))
+	v1.POST("/admin/payroll-runs/:payroll_run_id/resume", r.AuthorizeScope(entity.RoleIDAdmin, r.ResumePayrollRun))
+	v1.GET("/admin/payroll-previews/:payroll_preview_id", r.AuthorizeScope(entity.RoleIDAdmin, r.GetPayrollPreview))
 	v1.GET("/admin/attendance-periods/:attendance_period_id/payslip-summary", r.AuthorizeScope(entity.RoleIDAdmin, r.GeneratePayslipSummary))
 	v1.GET("/attendance-periods/:attendance_period_id/payslip", r.GeneratePayslip)
 
-	// attendance
-	v1.POST("/attendances", r.SubmitAttendance)
+	// user
+	v1.PUT("/admin/users/:user_id/employment", r.AuthorizeScope(entity.RoleIDAdmin, r.UpdateUserEmployment))
+
+	// salary
+	v1.GET("/admin/users/:user_id/salaries", r.AuthorizeScope(entity.RoleIDAdmin, r.GetUserSalaries))
+	v1.POST("/admin/users/:user_id/salaries", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateUserSalary))
+
+	// year to date
+	v1.GET("/me/ytd", r.GetMyYearToDate)
+	v1.GET("/admin/users/:user_id/ytd", r.AuthorizeScope(entity.RoleIDAdmin, r.GetUserYearToDate))
+
+	// pay component
+	v1.GET("/admin/pay-components", r.AuthorizeScope(entity.RoleIDAdmin, r.GetPayComponentList))
+	v1.PUT("/admin/pay-components/:pay_component_id", r.AuthorizeScope(entity.RoleIDAdmin, r.UpdatePayComponent))
+
+	// tax
+	v1.GET("/admin/tax-brackets", r.AuthorizeScope(entity.RoleIDAdmin, r.GetTaxBrackets))
+	v1.POST("/admin/tax-brackets", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateTaxBrackets))
+	v1.PUT("/admin/users/:user_id/tax-status", r.AuthorizeScope(entity.RoleIDAdmin, r.UpdateUserTaxStatus))
+
+	// exchange rate
+	v1.GET("/admin/exchange-rates", r.AuthorizeScope(entity.RoleIDAdmin, r.GetExchangeRates))
+	v1.POST("/admin/exchange-rates", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateExchangeRates))
+
+	// allowance
+	v1.GET("/admin/allowance-types", r.AuthorizeScope(entity.RoleIDAdmin, r.GetAllowanceTypes))
+	v1.POST("/admin/allowance-types", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateAllowanceType))
+	v1.GET("/admin/users/:user_id/allowances", r.AuthorizeScope(entity.RoleIDAdmin, r.GetUserAllowances))
+	v1.POST("/admin/users/:user_id/allowances", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateUserAllowance))
+	v1.PUT("/admin/user-allowances/:user_allowance_id/end", r.AuthorizeScope(entity.RoleIDAdmin, r.EndUserAllowance))
+
+	// payroll adjustment
+	v1.GET("/admin/attendance-periods/:attendance_period_id/adjustments", r.AuthorizeScope(entity.RoleIDAdmin, r.GetPayrollAdjustments))
+	v1.POST("/admin/attendance-periods/:attendance_period_id/adjustments", r.AuthorizeScope(entity.RoleIDAdmin, r.CreatePayrollAdjustment))
+	v1.DELETE("/admin/payroll-adjustments/:payroll_adjustment_id", r.AuthorizeScope(entity.RoleIDAdmin, r.CancelPayrollAdjustment))
+
+	// loan
+	v1.GET("/admin/users/:user_id/loans", r.AuthorizeScope(entity.RoleIDAdmin, r.GetUserLoans))
+	v1.POST("/admin/users/:user_id/loans", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateLoan))
+	v1.GET("/admin/loans/:loan_id/repayments", r.AuthorizeScope(entity.RoleIDAdmin, r.GetLoanRepayments))
+
+	// holiday
+	v1.GET("/admin/holidays", r.AuthorizeScope(entity.RoleIDAdmin, r.GetHolidays))
+	v1.POST("/admin/holidays", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateHolidays))
+	v1.DELETE("/admin/holidays/:holiday_id", r.AuthorizeScope(entity.RoleIDAdmin, r.DeleteHoliday))
+	v1.PUT("/admin/users/:user_id/region", r.AuthorizeScope(entity.RoleIDAdmin, r.UpdateUserRegion))
+
+	// work schedule
+	v1.GET("/admin/work-schedules", r.AuthorizeScope(entity.RoleIDAdmin, r.GetWorkSchedules))
+	v1.POST("/admin/work-schedules", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateWorkSchedule))
+	v1.GET("/admin/users/:user_id/work-schedules", r.AuthorizeScope(entity.RoleIDAdmin, r.GetUserWorkSchedules))
+	v1.POST("/admin/users/:user_id/work-schedules", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateUserWorkSchedule))
+
+	// office location
+	v1.GET("/admin/office-locations", r.AuthorizeScope(entity.RoleIDAdmin, r.GetOfficeLocations))
+	v1.POST("/admin/office-locations", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateOfficeLocation))
+	v1.GET("/admin/users/:user_id/office-locations", r.AuthorizeScope(entity.RoleIDAdmin, r.GetUserOfficeLocations))
+	v1.POST("/admin/users/:user_id/office-locations", r.AuthorizeScope(entity.RoleIDAdmin, r.CreateUserOfficeLocation))
+	v1.DELETE("/admin/user-office-locations/:user_office_location_id", r.AuthorizeScope(entity.RoleIDAdmin, r.DeleteUserOfficeLocation))
+
+	// attendance, submitting an attendance checks in
+	v1.POST("/attendances", r.CheckInAttendance)
+	v1.POST("/attendances/check-in", r.CheckInAttendance)
+	v1.POST("/attendances/check-out", r.CheckOutAttendance)
+	v1.GET("/attendances", r.GetMyAttendances)
+	v1.GET("/admin/users/:user_id/attendances", r.AuthorizeScope(entity.RoleIDAdmin, r.GetUserAttendances))
+	v1.POST("/admin/attendances/import", r.AuthorizeScope(entity.RoleIDAdmin, r.ImportAttendances))
+	v1.PUT("/admin/users/:user_id/device", r.AuthorizeScope(entity.RoleIDAdmin, r.UpdateUserDevice))
+
+	// attendance type
+	v1.GET("/attendance-types", r.GetAttendanceTypes)
+	v1.PUT("/admin/attendance-types/:attendance_type_id", r.AuthorizeScope(entity.RoleIDAdmin, r.UpdateAttendanceType))
+
+	// attendance correction
+	v1.POST("/attendance-corrections", r.CreateAttendanceCorrection)
+	v1.GET("/attendance-corrections", r.GetMyAttendanceCorrections)
+	v1.GET("/admin/attendance-corrections", r.AuthorizeScope(entity.RoleIDAdmin, r.GetAttendanceCorrections))
+	v1.PUT("/admin/attendance-corrections/:attendance_correction_id/approve", r.AuthorizeScope(entity.RoleIDAdmin, r.ApproveAttendanceCorrection))
+	v1.PUT("/admin/attendance-corrections/:attendance_correction_id/reject", r.AuthorizeScope(entity.RoleIDAdmin, r.RejectAttendanceCorrection))
 
 	// overtime
 	v1.POST("/overtimes", r.VerifyCurrentAttendancePeriod, r.SubmitOvertime)
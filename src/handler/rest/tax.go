@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichiels/go-pkg/v2/null"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetTaxBrackets godoc
+// @Summary Get Tax Brackets
+// @Description Get the version of the income tax brackets in effect on a date, defaults to today
+// @Tags Tax
+// @Security BearerAuth
+// @Param effective_date query string false "Effective Date (YYYY-MM-DD)"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TaxBracket{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/tax-brackets [GET]
+func (r *rest) GetTaxBrackets(ctx *gin.Context) {
+	var effectiveDate null.Date
+	if effectiveDateStr := ctx.Query("effective_date"); effectiveDateStr != "" {
+		date, err := time.Parse(time.DateOnly, effectiveDateStr)
+		if err != nil {
+			r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "effective_date must be formatted as YYYY-MM-DD"))
+			return
+		}
+
+		effectiveDate = null.DateFrom(date)
+	}
+
+	data, err := r.uc.Tax.GetTaxBrackets(ctx.Request.Context(), effectiveDate)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateTaxBrackets godoc
+// @Summary Create Tax Brackets
+// @Description Publish a new version of the income tax brackets, effective from the given date
+// @Tags Tax
+// @Security BearerAuth
+// @Param data body dto.CreateTaxBracketsParam true "Tax Brackets Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/tax-brackets [POST]
+func (r *rest) CreateTaxBrackets(ctx *gin.Context) {
+	var param dto.CreateTaxBracketsParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err := r.uc.Tax.CreateTaxBrackets(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, nil, nil)
+}
+
+// UpdateUserTaxStatus godoc
+// @Summary Update User Tax Status
+// @Description Update the marital status and number of dependants an employee is taxed under
+// @Tags Tax
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param data body dto.UpdateUserTaxStatusParam true "Tax Status Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/tax-status [PUT]
+func (r *rest) UpdateUserTaxStatus(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.UpdateUserTaxStatusParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	err = r.uc.Tax.UpdateUserTaxStatus(ctx.Request.Context(), userID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
@@ -0,0 +1,131 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+	"github.com/reyhanmichies/employee-payroll-service/src/business/dto"
+)
+
+// GetWorkSchedules godoc
+// @Summary Get Work Schedules
+// @Description Get every active work schedule
+// @Tags Work Schedule
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.WorkSchedule{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/work-schedules [GET]
+func (r *rest) GetWorkSchedules(ctx *gin.Context) {
+	data, err := r.uc.WorkSchedule.GetWorkSchedules(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateWorkSchedule godoc
+// @Summary Create Work Schedule
+// @Description Add a work schedule made of the weekdays employees are expected to work
+// @Tags Work Schedule
+// @Security BearerAuth
+// @Param data body dto.CreateWorkScheduleParam true "Work Schedule Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.WorkSchedule{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/work-schedules [POST]
+func (r *rest) CreateWorkSchedule(ctx *gin.Context) {
+	var param dto.CreateWorkScheduleParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.WorkSchedule.CreateWorkSchedule(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
+
+// GetUserWorkSchedules godoc
+// @Summary Get User Work Schedules
+// @Description Get every work schedule assigned to an employee, latest effective date first
+// @Tags Work Schedule
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.UserWorkSchedule{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/work-schedules [GET]
+func (r *rest) GetUserWorkSchedules(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	data, err := r.uc.WorkSchedule.GetUserWorkSchedules(ctx.Request.Context(), userID)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// CreateUserWorkSchedule godoc
+// @Summary Create User Work Schedule
+// @Description Assign a work schedule to an employee from an effective date
+// @Tags Work Schedule
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param data body dto.CreateUserWorkScheduleParam true "User Work Schedule Data"
+// @Produce json
+// @Success 201 {object} entity.HTTPResp{data=entity.UserWorkSchedule{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/work-schedules [POST]
+func (r *rest) CreateUserWorkSchedule(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	var param dto.CreateUserWorkScheduleParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.WorkSchedule.CreateUserWorkSchedule(ctx.Request.Context(), userID, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeCreated, data, nil)
+}
@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/reyhanmichiels/go-pkg/v2/codes"
+	"github.com/reyhanmichiels/go-pkg/v2/errors"
+)
+
+// GetMyYearToDate godoc
+// @Summary Get My Year To Date
+// @Description Get the year to date payroll totals of the logged in employee, defaults to the current year
+// @Tags Year To Date
+// @Security BearerAuth
+// @Param year query int false "Year"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.YearToDate{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/me/ytd [GET]
+func (r *rest) GetMyYearToDate(ctx *gin.Context) {
+	year, err := r.parseYearQuery(ctx)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.YearToDate.GetMyYearToDate(ctx.Request.Context(), year)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// GetUserYearToDate godoc
+// @Summary Get User Year To Date
+// @Description Get the year to date payroll totals of an employee, defaults to the current year
+// @Tags Year To Date
+// @Security BearerAuth
+// @Param user_id path int true "User ID"
+// @Param year query int false "Year"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=dto.YearToDate{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/users/{user_id}/ytd [GET]
+func (r *rest) GetUserYearToDate(ctx *gin.Context) {
+	userIDStr := ctx.Param("user_id")
+	if userIDStr == "" {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is empty"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, "user_id is not a valid number"))
+		return
+	}
+
+	year, err := r.parseYearQuery(ctx)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	data, err := r.uc.YearToDate.GetUserYearToDate(ctx.Request.Context(), userID, year)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, data, nil)
+}
+
+// parseYearQuery reads the optional year query, zero when it is not given.
+func (r *rest) parseYearQuery(ctx *gin.Context) (int, error) {
+	yearStr := ctx.Query("year")
+	if yearStr == "" {
+		return 0, nil
+	}
+
+	year, err := strconv.Atoi(yearStr)
+	if err != nil || year <= 0 {
+		return 0, errors.NewWithCode(codes.CodeBadRequest, "year is not a valid year")
+	}
+
+	return year, nil
+}